@@ -21,12 +21,13 @@
 package forwarding
 
 import (
-	"errors"
-
+	sdkerrors "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorstypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/noble-assets/forwarding/v2/keeper"
@@ -40,6 +41,7 @@ func SigVerificationGasConsumer(
 ) error {
 	switch sig.PubKey.(type) {
 	case *types.ForwardingPubKey:
+		meter.ConsumeGas(types.SignerlessVerificationGas, "ante verify: forwarding")
 		return nil
 	default:
 		return ante.DefaultSigVerificationGasConsumer(meter, sig, params)
@@ -48,6 +50,53 @@ func SigVerificationGasConsumer(
 
 //
 
+var _ sdk.AnteDecorator = ValidateSigCountDecorator{}
+
+// ValidateSigCountDecorator is a replacement for the default provided by the
+// Cosmos SDK that doesn't count forwarding account public keys towards the
+// signature limit, allowing batches of signerless messages. These are instead
+// bounded by MaxSignerlessMessages.
+type ValidateSigCountDecorator struct {
+	ak ante.AccountKeeper
+}
+
+func NewValidateSigCountDecorator(ak ante.AccountKeeper) ValidateSigCountDecorator {
+	return ValidateSigCountDecorator{ak: ak}
+}
+
+func (d ValidateSigCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(errorstypes.ErrTxDecode, "Tx must be a sigTx")
+	}
+
+	params := d.ak.GetParams(ctx)
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return ctx, err
+	}
+
+	sigCount, forwardingCount := 0, 0
+	for _, pk := range pubKeys {
+		if _, ok := pk.(*types.ForwardingPubKey); ok {
+			forwardingCount++
+			if forwardingCount > types.MaxSignerlessMessages {
+				return ctx, sdkerrors.Wrapf(errorstypes.ErrTooManySignatures, "forwarding signatures: %d, limit: %d", forwardingCount, types.MaxSignerlessMessages)
+			}
+			continue
+		}
+
+		sigCount += ante.CountSubKeys(pk)
+		if uint64(sigCount) > params.TxSigLimit {
+			return ctx, sdkerrors.Wrapf(errorstypes.ErrTooManySignatures, "signatures: %d, limit: %d", sigCount, params.TxSigLimit)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+//
+
 var _ sdk.AnteDecorator = SigVerificationDecorator{}
 
 type SigVerificationDecorator struct {
@@ -71,33 +120,275 @@ func NewSigVerificationDecorator(bk types.BankKeeper, fk *keeper.Keeper, underly
 }
 
 func (d SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if msgs := tx.GetMsgs(); len(msgs) == 1 {
-		msg, ok := msgs[0].(*types.MsgRegisterAccount)
+	msgs := tx.GetMsgs()
+
+	var (
+		addresses []sdk.AccAddress
+		ok        bool
+		clearMsg  *types.MsgClearAccount
+	)
+	if len(msgs) == 1 {
+		clearMsg, _ = msgs[0].(*types.MsgClearAccount)
+	}
+
+	if clearMsg != nil {
+		var address sdk.AccAddress
+		address, ok, err = d.getClearAccountSigner(ctx, clearMsg)
+		addresses = []sdk.AccAddress{address}
+	} else {
+		addresses, ok, err = d.getRegisterAccountSigners(ctx, msgs)
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	if !ok {
+		if err := d.rejectForwardingPubKeys(ctx, tx); err != nil {
+			return ctx, err
+		}
+
+		return d.underlying.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if err := validateSignerlessSigners(tx, addresses); err != nil {
+		return ctx, err
+	}
+
+	for _, address := range addresses {
+		if err := d.chargeSignerless(ctx, address); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// rejectForwardingPubKeys rejects transactions that aren't signerless, but are
+// signed by a forwarding account public key, either provided in the
+// transaction or stored on a signer's account. These can't be verified by the
+// underlying decorator, as forwarding account public keys can't verify
+// signatures.
+func (d SigVerificationDecorator) rejectForwardingPubKeys(ctx sdk.Context, tx sdk.Tx) error {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil
+	}
+
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return err
+	}
+	for _, pk := range pubKeys {
+		if _, ok := pk.(*types.ForwardingPubKey); ok {
+			return sdkerrors.Wrapf(types.ErrUnsupportedSignerlessTx, "forwarding public key %s can only sign signerless messages", sdk.AccAddress(pk.Address()))
+		}
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		if d.forwarding.HasForwardingPubKey(ctx, signer) {
+			return sdkerrors.Wrapf(types.ErrUnsupportedSignerlessTx, "forwarding account %s can only sign signerless messages", sdk.AccAddress(signer))
+		}
+	}
+
+	return nil
+}
+
+// validateSignerlessSigners checks that a signerless transaction is signed,
+// paid for, and granted fees by only the forwarding accounts it registers or
+// clears. As their signatures aren't verified, any other signer, fee payer, or
+// fee granter would otherwise be able to be charged without consent.
+func validateSignerlessSigners(tx sdk.Tx, addresses []sdk.AccAddress) error {
+	expected := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		expected[string(address)] = true
+	}
+
+	var signers [][]byte
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		txSigners, err := sigTx.GetSigners()
+		if err != nil {
+			return err
+		}
+		signers = append(signers, txSigners...)
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		signers = append(signers, feeTx.FeePayer())
+		if granter := feeTx.FeeGranter(); len(granter) > 0 {
+			signers = append(signers, granter)
+		}
+	}
+
+	actual := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		if !expected[string(signer)] {
+			return sdkerrors.Wrapf(types.ErrUnsupportedSignerlessTx, "signerless transactions can only be signed and paid for by forwarding accounts, not %s", sdk.AccAddress(signer))
+		}
+		actual[string(signer)] = true
+	}
+	if len(actual) != len(expected) {
+		return sdkerrors.Wrap(types.ErrUnsupportedSignerlessTx, "signerless transactions must be signed by every forwarding account")
+	}
+
+	return nil
+}
+
+// getRegisterAccountSigners returns the addresses of the forwarding accounts
+// being registered, if every message is a registration signed by the account
+// itself. Batches are bounded, and may only register each account once. Any
+// other signer, fee payer, or fee granter is rejected by AnteHandle.
+func (d SigVerificationDecorator) getRegisterAccountSigners(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.AccAddress, bool, error) {
+	if len(msgs) == 0 {
+		return nil, false, nil
+	}
+
+	addresses := make([]sdk.AccAddress, 0, len(msgs))
+	seen := make(map[string]bool, len(msgs))
+
+	for _, rawMsg := range msgs {
+		msg, ok := rawMsg.(*types.MsgRegisterAccount)
 		if !ok {
-			return d.underlying.AnteHandle(ctx, tx, simulate, next)
+			return nil, false, nil
 		}
 
-		address := types.GenerateAddress(msg.Channel, msg.Recipient, msg.Fallback)
+		address, ok, err := d.getRegisterAccountSigner(ctx, msg)
+		if err != nil || !ok {
+			return nil, false, err
+		}
 
-		if msg.Signer != address.String() {
-			return d.underlying.AnteHandle(ctx, tx, simulate, next)
+		if seen[msg.Signer] {
+			return nil, false, sdkerrors.Wrapf(errorstypes.ErrInvalidRequest, "duplicate signerless registration of %s", msg.Signer)
 		}
+		seen[msg.Signer] = true
 
-		hasAllowedDenomBalance := false
-		for _, denom := range d.forwarding.GetAllowedDenoms(ctx) {
-			balance := d.bank.GetBalance(ctx, address, denom)
-			if !balance.IsZero() {
-				hasAllowedDenomBalance = true
-				break
+		addresses = append(addresses, address)
+	}
+
+	if len(addresses) > types.MaxSignerlessMessages {
+		return nil, false, sdkerrors.Wrapf(errorstypes.ErrTooManySignatures, "signerless messages: %d, limit: %d", len(addresses), types.MaxSignerlessMessages)
+	}
+
+	return addresses, true, nil
+}
+
+// getRegisterAccountSigner returns the address of the forwarding account being
+// registered, if the message is signed by the account itself. As the
+// signerless fee is charged even if the message fails, registrations that
+// can't succeed, or whose registration fee can't be paid, are rejected.
+func (d SigVerificationDecorator) getRegisterAccountSigner(ctx sdk.Context, msg *types.MsgRegisterAccount) (sdk.AccAddress, bool, error) {
+	channel := msg.Channel
+	if msg.ChainId != "" {
+		var err error
+		channel, err = d.forwarding.ResolveChainChannel(ctx, msg.ChainId, msg.Channel)
+		if err != nil {
+			return nil, false, nil
+		}
+	}
+
+	if err := types.ValidateAddressVersion(msg.AddressVersion); err != nil {
+		return nil, false, nil
+	}
+	version := types.ResolveAddressVersion(msg.AddressVersion)
+	address := types.GenerateAddressWithVersion(version, channel, msg.Recipient, msg.Fallback)
+	if msg.Signer != address.String() {
+		return nil, false, nil
+	}
+
+	if _, _, err := d.forwarding.ValidateRegistration(ctx, msg); err != nil {
+		return nil, false, err
+	}
+
+	// NOTE: The account pays its registration fee itself, after the
+	// signerless fee, so its balance must cover both.
+	if fee := d.forwarding.GetRegistrationFee(ctx); fee.IsPositive() {
+		required := sdk.NewCoins(fee)
+		if signerlessFee := d.forwarding.GetSignerlessFee(ctx); signerlessFee.IsPositive() {
+			required = required.Add(signerlessFee)
+		}
+
+		for _, coin := range required {
+			if d.bank.GetBalance(ctx, address, coin.Denom).IsLT(coin) {
+				return nil, false, sdkerrors.Wrapf(errorstypes.ErrInsufficientFunds, "account must cover the signerless and registration fees of %s", required)
 			}
 		}
+	}
+
+	return address, true, nil
+}
+
+// getClearAccountSigner returns the address of the forwarding account being
+// cleared, if the message is signed by the account itself. As anyone can sign
+// for a forwarding account, accounts that are already queued for forwarding,
+// can't be forwarded, or would be left with nothing to clear after the fee, are
+// rejected, so that their balance can't be drained by repeated fees.
+func (d SigVerificationDecorator) getClearAccountSigner(ctx sdk.Context, msg *types.MsgClearAccount) (sdk.AccAddress, bool, error) {
+	if msg.Signer != msg.Address {
+		return nil, false, nil
+	}
+
+	account, err := d.forwarding.GetForwardingAccount(ctx, msg.Address)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	balance := sdk.NewCoins()
+	for _, denom := range d.forwarding.GetAllowedDenoms(ctx) {
+		balance = balance.Add(d.bank.GetBalance(ctx, account.GetAddress(), denom))
+	}
+	if fee := d.forwarding.GetSignerlessFee(ctx); fee.IsPositive() {
+		remaining, hasNeg := balance.SafeSub(fee)
+		if hasNeg || remaining.IsZero() {
+			return nil, false, sdkerrors.Wrapf(errorstypes.ErrInsufficientFunds, "account balance must exceed the signerless fee of %s", fee)
+		}
+	}
+
+	if !msg.Fallback || account.Fallback == "" {
+		if found, _ := d.forwarding.PendingForwards.Has(ctx, account.Address); found {
+			return nil, false, sdkerrors.Wrap(errorstypes.ErrInvalidRequest, "account is already queued for forwarding")
+		}
+
+		// NOTE: Accounts can't be forwarded over a channel that isn't open,
+		// so clearing them would only charge the fee.
+		if err := d.forwarding.ValidateChannel(ctx, account.Channel); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return account.GetAddress(), true, nil
+}
+
+// chargeSignerless checks that a forwarding account holds an allowed denom
+// balance, and deducts the signerless fee from it.
+func (d SigVerificationDecorator) chargeSignerless(ctx sdk.Context, address sdk.AccAddress) error {
+	hasAllowedDenomBalance := false
+	for _, denom := range d.forwarding.GetAllowedDenoms(ctx) {
+		balance := d.bank.GetBalance(ctx, address, denom)
+		if !balance.IsZero() {
+			hasAllowedDenomBalance = true
+			break
+		}
+	}
+
+	if !hasAllowedDenomBalance {
+		return sdkerrors.Wrap(errorstypes.ErrInsufficientFunds, "account must have an allowed denom balance")
+	}
 
-		if !hasAllowedDenomBalance {
-			return ctx, errors.New("account must have an allowed denom balance")
+	// NOTE: Signerless transactions are paid for by the forwarding account
+	// itself, which is charged the governance-set signerless fee.
+	if fee := d.forwarding.GetSignerlessFee(ctx); fee.IsPositive() {
+		if err := d.bank.SendCoinsFromAccountToModule(ctx, address, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return sdkerrors.Wrapf(errorstypes.ErrInsufficientFee, "failed to deduct signerless fee of %s: %s", fee, err)
 		}
 
-		return next(ctx, tx, simulate)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, address.String()),
+		))
 	}
 
-	return d.underlying.AnteHandle(ctx, tx, simulate, next)
+	return nil
 }
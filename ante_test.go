@@ -0,0 +1,666 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarding_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorstypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/simapp"
+	"github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestSigVerificationGasConsumer(t *testing.T) {
+	meter := storetypes.NewInfiniteGasMeter()
+	sig := signing.SignatureV2{PubKey: &types.ForwardingPubKey{Key: []byte("address")}}
+
+	require.NoError(t, forwarding.SigVerificationGasConsumer(meter, sig, authtypes.DefaultParams()))
+	require.Equal(t, types.SignerlessVerificationGas, meter.GasConsumed())
+}
+
+func TestSigVerificationDecoratorDeductsSignerlessFee(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.SignerlessFee.Set(ctx, sdk.NewInt64Coin("uusdc", 10)))
+
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "recipient", "")
+	fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+
+	called, err := anteHandle(app, ctx, address)
+	require.NoError(t, err)
+	require.True(t, called)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 90), app.BankKeeper.GetBalance(ctx, address, "uusdc"))
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), app.BankKeeper.GetBalance(ctx, feeCollector, "uusdc"))
+}
+
+func TestSigVerificationDecoratorRejectsInsufficientSignerlessFee(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.SignerlessFee.Set(ctx, sdk.NewInt64Coin("uusdc", 10)))
+
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "recipient", "")
+	fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 5))
+
+	called, err := anteHandle(app, ctx, address)
+	require.ErrorIs(t, err, errorstypes.ErrInsufficientFee)
+	require.False(t, called)
+}
+
+func TestSigVerificationDecoratorWithoutSignerlessFee(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "recipient", "")
+	fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+
+	called, err := anteHandle(app, ctx, address)
+	require.NoError(t, err)
+	require.True(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(ctx, address, "uusdc"))
+}
+
+func TestSigVerificationDecoratorRejectsFailingSignerlessRegistrations(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.SignerlessFee.Set(ctx, sdk.NewInt64Coin("uusdc", 10)))
+
+	// ACT: Register an account on a channel that doesn't exist.
+	closed := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-1", "recipient", "")
+	fund(t, app, ctx, closed, sdk.NewInt64Coin("uusdc", 100))
+	called, err := anteHandleMsg(app, ctx, &types.MsgRegisterAccount{Signer: closed.String(), Recipient: "recipient", Channel: "channel-1"})
+	require.ErrorIs(t, err, types.ErrChannelNotFound)
+	require.False(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(ctx, closed, "uusdc"))
+
+	// ACT: Register an account that is already registered.
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "recipient", "")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, &types.ForwardingAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(address),
+		Channel:     "channel-0",
+		Recipient:   "recipient",
+	}))
+	fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+	called, err = anteHandle(app, ctx, address)
+	require.ErrorIs(t, err, types.ErrAccountAlreadyRegistered)
+	require.False(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(ctx, address, "uusdc"))
+
+	// ACT: Register an account with an unknown address version, which isn't
+	// treated as signerless.
+	called, err = anteHandleMsg(app, ctx, &types.MsgRegisterAccount{Signer: address.String(), Recipient: "recipient", Channel: "channel-0", AddressVersion: 99})
+	require.ErrorContains(t, err, "underlying decorator invoked")
+	require.False(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(ctx, address, "uusdc"))
+
+	// ACT: Register an account that can't cover both the signerless and
+	// registration fees.
+	require.NoError(t, app.ForwardingKeeper.RegistrationFee.Set(ctx, sdk.NewInt64Coin("uusdc", 95)))
+	other := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "other", "")
+	fund(t, app, ctx, other, sdk.NewInt64Coin("uusdc", 100))
+	called, err = anteHandleMsg(app, ctx, &types.MsgRegisterAccount{Signer: other.String(), Recipient: "other", Channel: "channel-0"})
+	require.ErrorIs(t, err, errorstypes.ErrInsufficientFunds)
+	require.False(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(ctx, other, "uusdc"))
+}
+
+func TestSigVerificationDecoratorClearsAccountSignerlessly(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.SignerlessFee.Set(ctx, sdk.NewInt64Coin("uusdc", 10)))
+
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "recipient", "")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, &types.ForwardingAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(address),
+		Channel:     "channel-0",
+		Recipient:   "recipient",
+	}))
+	fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+
+	msg := &types.MsgClearAccount{Signer: address.String(), Address: address.String()}
+
+	// ACT: Clear an account that is already queued for forwarding.
+	called, err := anteHandleMsg(app, ctx, msg)
+	require.ErrorContains(t, err, "account is already queued for forwarding")
+	require.False(t, called)
+
+	// ACT: Clear an account that missed its forward.
+	require.NoError(t, app.ForwardingKeeper.PendingForwards.Remove(ctx, address.String()))
+	called, err = anteHandleMsg(app, ctx, msg)
+	require.NoError(t, err)
+	require.True(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 90), app.BankKeeper.GetBalance(ctx, address, "uusdc"))
+
+	// ACT: Clear an account whose channel isn't open.
+	require.NoError(t, app.ForwardingKeeper.PendingForwards.Remove(ctx, address.String()))
+	channel, _ := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, "channel-0")
+	channel.State = channeltypes.CLOSED
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, "channel-0", channel)
+	called, err = anteHandleMsg(app, ctx, msg)
+	require.ErrorIs(t, err, types.ErrChannelNotOpen)
+	require.False(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 90), app.BankKeeper.GetBalance(ctx, address, "uusdc"))
+
+	// ACT: Clear an account whose balance would be taken by the fee.
+	drained := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "drained", "")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, &types.ForwardingAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(drained),
+		Channel:     "channel-0",
+		Recipient:   "drained",
+	}))
+	fund(t, app, ctx, drained, sdk.NewInt64Coin("uusdc", 10))
+	called, err = anteHandleMsg(app, ctx, &types.MsgClearAccount{Signer: drained.String(), Address: drained.String()})
+	require.ErrorIs(t, err, errorstypes.ErrInsufficientFunds)
+	require.False(t, called)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), app.BankKeeper.GetBalance(ctx, drained, "uusdc"))
+
+	// ACT: Clear an account that isn't a forwarding account.
+	other := sdk.AccAddress([]byte("other"))
+	fund(t, app, ctx, other, sdk.NewInt64Coin("uusdc", 100))
+	called, err = anteHandleMsg(app, ctx, &types.MsgClearAccount{Signer: other.String(), Address: other.String()})
+	require.ErrorContains(t, err, "underlying decorator invoked")
+	require.False(t, called)
+}
+
+func TestSigVerificationDecoratorRegistersBatchSignerlessly(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.SignerlessFee.Set(ctx, sdk.NewInt64Coin("uusdc", 10)))
+
+	var msgs []sdk.Msg
+	var addresses []sdk.AccAddress
+	for i := range 3 {
+		recipient := fmt.Sprintf("recipient%d", i)
+		address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", recipient, "")
+		fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+
+		msgs = append(msgs, &types.MsgRegisterAccount{Signer: address.String(), Recipient: recipient, Channel: "channel-0"})
+		addresses = append(addresses, address)
+	}
+
+	called, err := anteHandleMsg(app, ctx, msgs...)
+	require.NoError(t, err)
+	require.True(t, called)
+	for _, address := range addresses {
+		require.Equal(t, sdk.NewInt64Coin("uusdc", 90), app.BankKeeper.GetBalance(ctx, address, "uusdc"))
+	}
+
+	// ACT: Register the same account twice.
+	called, err = anteHandleMsg(app, ctx, msgs[0], msgs[1], msgs[0])
+	require.ErrorContains(t, err, "duplicate signerless registration")
+	require.False(t, called)
+
+	// ACT: Register alongside a registration that isn't signed by the account.
+	called, err = anteHandleMsg(app, ctx, msgs[0], &types.MsgRegisterAccount{Signer: addresses[0].String(), Recipient: "other", Channel: "channel-0"})
+	require.ErrorContains(t, err, "underlying decorator invoked")
+	require.False(t, called)
+
+	// ACT: Register more accounts than allowed in a single transaction.
+	msgs = nil
+	for i := range types.MaxSignerlessMessages + 1 {
+		recipient := fmt.Sprintf("recipient%d", i)
+		address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", recipient, "")
+		msgs = append(msgs, &types.MsgRegisterAccount{Signer: address.String(), Recipient: recipient, Channel: "channel-0"})
+	}
+	called, err = anteHandleMsg(app, ctx, msgs...)
+	require.ErrorIs(t, err, errorstypes.ErrTooManySignatures)
+	require.False(t, called)
+}
+
+func TestAnteHandlerRegistersBatchSignerlessly(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	require.NoError(t, app.AccountKeeper.Params.Set(ctx, authtypes.DefaultParams()))
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+
+	numOfAccounts := int(app.AccountKeeper.GetParams(ctx).TxSigLimit) + 1
+
+	var msgs []sdk.Msg
+	var sigs []signing.SignatureV2
+	var pubKeys []cryptotypes.PubKey
+	for i := range numOfAccounts {
+		recipient := fmt.Sprintf("recipient%d", i)
+		address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", recipient, "")
+		fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+
+		msgs = append(msgs, &types.MsgRegisterAccount{Signer: address.String(), Recipient: recipient, Channel: "channel-0"})
+		sigs = append(sigs, signing.SignatureV2{
+			PubKey: &types.ForwardingPubKey{Key: address},
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		})
+		pubKeys = append(pubKeys, &types.ForwardingPubKey{Key: address})
+	}
+
+	// ACT: Register the batch with a fee payer that never signed.
+	user := secp256k1.GenPrivKey().PubKey()
+	fund(t, app, ctx, sdk.AccAddress(user.Address()), sdk.NewInt64Coin("uusdc", 100))
+	cachedCtx, _ := ctx.CacheContext()
+	tx := buildTxWithFees(t, app, msgs, append(pubKeys, user), sdk.AccAddress(user.Address()), nil)
+	_, err := app.AnteHandler()(cachedCtx, tx, false)
+	require.ErrorIs(t, err, types.ErrUnsupportedSignerlessTx)
+
+	builder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	require.NoError(t, builder.SetSignatures(sigs...))
+	builder.SetGasLimit(1_000_000)
+
+	newCtx, err := app.AnteHandler()(ctx, builder.GetTx(), false)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, newCtx.GasMeter().GasConsumed(), uint64(numOfAccounts)*types.SignerlessVerificationGas)
+}
+
+func TestAnteHandlerRejectsUnsupportedSignerlessTxs(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	ctx = ctx.WithIsCheckTx(true)
+	require.NoError(t, app.AccountKeeper.Params.Set(ctx, authtypes.DefaultParams()))
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1))
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "recipient", "")
+	fund(t, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+	other := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "other", "")
+	fund(t, app, ctx, other, sdk.NewInt64Coin("uusdc", 100))
+	user := secp256k1.GenPrivKey().PubKey()
+	fund(t, app, ctx, sdk.AccAddress(user.Address()), sdk.NewInt64Coin("uusdc", 100))
+
+	register := &types.MsgRegisterAccount{Signer: address.String(), Recipient: "recipient", Channel: "channel-0"}
+	send := banktypes.NewMsgSend(address, other, coins)
+	userSend := banktypes.NewMsgSend(sdk.AccAddress(user.Address()), address, coins)
+
+	tests := []struct {
+		name     string
+		msgs     []sdk.Msg
+		pubKeys  []cryptotypes.PubKey
+		feePayer sdk.AccAddress
+	}{
+		{
+			name:    "mixed messages",
+			msgs:    []sdk.Msg{register, send},
+			pubKeys: []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}},
+		},
+		{
+			name:    "multiple signers",
+			msgs:    []sdk.Msg{register, userSend},
+			pubKeys: []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}, user},
+		},
+		{
+			name:    "mismatched signer",
+			msgs:    []sdk.Msg{&types.MsgRegisterAccount{Signer: other.String(), Recipient: "recipient", Channel: "channel-0"}},
+			pubKeys: []cryptotypes.PubKey{&types.ForwardingPubKey{Key: other}},
+		},
+		{
+			name:    "clear of another account",
+			msgs:    []sdk.Msg{&types.MsgClearAccount{Signer: other.String(), Address: address.String()}},
+			pubKeys: []cryptotypes.PubKey{&types.ForwardingPubKey{Key: other}},
+		},
+		{
+			name:    "unsupported message",
+			msgs:    []sdk.Msg{send},
+			pubKeys: []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}},
+		},
+		{
+			name:     "separate fee payer",
+			msgs:     []sdk.Msg{register},
+			pubKeys:  []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}, user},
+			feePayer: sdk.AccAddress(user.Address()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cachedCtx, _ := ctx.CacheContext()
+			tx := buildTxWithFees(t, app, tt.msgs, tt.pubKeys, tt.feePayer, nil)
+
+			var err error
+			require.NotPanics(t, func() {
+				_, err = app.AnteHandler()(cachedCtx, tx, false)
+			})
+			require.ErrorIs(t, err, types.ErrUnsupportedSignerlessTx)
+		})
+	}
+
+	// NOTE: The simapp doesn't enable fee grants, so the fee granter is
+	// checked against the signature verification decorator directly.
+	t.Run("separate fee granter", func(t *testing.T) {
+		tx := buildTxWithFees(t, app, []sdk.Msg{register}, []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}}, nil, sdk.AccAddress(user.Address()))
+
+		called := false
+		next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			called = true
+			return ctx, nil
+		}
+
+		cachedCtx, _ := ctx.CacheContext()
+		decorator := forwarding.NewSigVerificationDecorator(app.BankKeeper, app.ForwardingKeeper, underlyingDecorator{})
+		_, err := decorator.AnteHandle(cachedCtx, tx, false, next)
+		require.ErrorIs(t, err, types.ErrUnsupportedSignerlessTx)
+		require.False(t, called)
+		require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(cachedCtx, address, "uusdc"))
+	})
+
+	t.Run("clear with separate fee payer or granter", func(t *testing.T) {
+		cachedCtx, _ := ctx.CacheContext()
+
+		// ARRANGE: Register the account, and clear its pending forward.
+		_, err := app.ForwardingKeeper.RegisterAccount(cachedCtx, register)
+		require.NoError(t, err)
+		require.NoError(t, app.ForwardingKeeper.PendingForwards.Remove(cachedCtx, address.String()))
+
+		clearMsgs := []sdk.Msg{&types.MsgClearAccount{Signer: address.String(), Address: address.String()}}
+		tx := buildTxWithFees(t, app, clearMsgs, []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}, user}, sdk.AccAddress(user.Address()), nil)
+		_, err = app.AnteHandler()(cachedCtx, tx, false)
+		require.ErrorIs(t, err, types.ErrUnsupportedSignerlessTx)
+
+		next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+		decorator := forwarding.NewSigVerificationDecorator(app.BankKeeper, app.ForwardingKeeper, underlyingDecorator{})
+		tx = buildTxWithFees(t, app, clearMsgs, []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}}, nil, sdk.AccAddress(user.Address()))
+		_, err = decorator.AnteHandle(cachedCtx, tx, false, next)
+		require.ErrorIs(t, err, types.ErrUnsupportedSignerlessTx)
+		require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(cachedCtx, address, "uusdc"))
+	})
+
+	t.Run("stored public key", func(t *testing.T) {
+		cachedCtx, _ := ctx.CacheContext()
+
+		// ARRANGE: Register signerlessly, storing the forwarding public key.
+		_, err := app.AnteHandler()(cachedCtx, buildTx(t, app, []sdk.Msg{register}, []cryptotypes.PubKey{&types.ForwardingPubKey{Key: address}}), false)
+		require.NoError(t, err)
+		require.True(t, app.ForwardingKeeper.HasForwardingPubKey(cachedCtx, address))
+
+		tx := buildTx(t, app, []sdk.Msg{send}, []cryptotypes.PubKey{nil})
+		require.NotPanics(t, func() {
+			_, err = app.AnteHandler()(cachedCtx, tx, false)
+		})
+		require.ErrorIs(t, err, types.ErrUnsupportedSignerlessTx)
+	})
+}
+
+func FuzzAnteHandlerSignerless(f *testing.F) {
+	for seed := range 64 {
+		f.Add(int64(seed))
+	}
+
+	app, ctx := setupSimApp(f)
+	ctx = ctx.WithIsCheckTx(true)
+	require.NoError(f, app.AccountKeeper.Params.Set(ctx, authtypes.DefaultParams()))
+	require.NoError(f, app.ForwardingKeeper.AllowedDenoms.Set(ctx, "uusdc"))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1))
+	user := secp256k1.GenPrivKey().PubKey()
+	fund(f, app, ctx, sdk.AccAddress(user.Address()), sdk.NewInt64Coin("uusdc", 100))
+
+	var addresses []sdk.AccAddress
+	for i := range 3 {
+		address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", fmt.Sprintf("recipient%d", i), "")
+		if i > 0 {
+			fund(f, app, ctx, address, sdk.NewInt64Coin("uusdc", 100))
+		}
+		addresses = append(addresses, address)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		randomAddress := func() sdk.AccAddress { return addresses[r.Intn(len(addresses))] }
+
+		var msgs []sdk.Msg
+		for range 1 + r.Intn(4) {
+			switch r.Intn(5) {
+			case 0:
+				i := r.Intn(len(addresses))
+				msgs = append(msgs, &types.MsgRegisterAccount{Signer: addresses[i].String(), Recipient: fmt.Sprintf("recipient%d", i), Channel: "channel-0"})
+			case 1:
+				msgs = append(msgs, &types.MsgRegisterAccount{Signer: randomAddress().String(), Recipient: "mismatched", Channel: "channel-0"})
+			case 2:
+				msgs = append(msgs, &types.MsgClearAccount{Signer: randomAddress().String(), Address: randomAddress().String(), Fallback: r.Intn(2) == 0})
+			case 3:
+				msgs = append(msgs, banktypes.NewMsgSend(randomAddress(), sdk.AccAddress(user.Address()), coins))
+			case 4:
+				msgs = append(msgs, banktypes.NewMsgSend(sdk.AccAddress(user.Address()), randomAddress(), coins))
+			}
+		}
+
+		builder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		signers, err := builder.GetTx().GetSigners()
+		require.NoError(t, err)
+
+		var pubKeys []cryptotypes.PubKey
+		for _, signer := range signers {
+			switch {
+			case r.Intn(4) == 0:
+				pubKeys = append(pubKeys, &types.ForwardingPubKey{Key: randomAddress()})
+			case sdk.AccAddress(signer).Equals(sdk.AccAddress(user.Address())):
+				pubKeys = append(pubKeys, user)
+			default:
+				pubKeys = append(pubKeys, &types.ForwardingPubKey{Key: signer})
+			}
+		}
+
+		var feePayer, feeGranter sdk.AccAddress
+		if r.Intn(4) == 0 {
+			feePayer = sdk.AccAddress(user.Address())
+		}
+		if r.Intn(4) == 0 {
+			feeGranter = sdk.AccAddress(user.Address())
+		}
+
+		cachedCtx, _ := ctx.CacheContext()
+		tx := buildTxWithFees(t, app, msgs, pubKeys, feePayer, feeGranter)
+
+		require.NotPanics(t, func() {
+			_, err = app.AnteHandler()(cachedCtx, tx, false)
+		})
+		if err != nil {
+			codespace, _, _ := sdkerrors.ABCIInfo(err, false)
+			require.NotEqual(t, sdkerrors.UndefinedCodespace, codespace, err.Error())
+		}
+	})
+}
+
+func TestValidateSigCountDecorator(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	params := app.AccountKeeper.GetParams(ctx)
+
+	tests := []struct {
+		name          string
+		numForwarding int
+		numRegular    int
+		err           error
+	}{
+		{"forwarding signatures beyond the signature limit", int(params.TxSigLimit) + 1, 0, nil},
+		{"forwarding signatures beyond the signerless limit", types.MaxSignerlessMessages + 1, 0, errorstypes.ErrTooManySignatures},
+		{"regular signatures beyond the signature limit", 1, int(params.TxSigLimit) + 1, errorstypes.ErrTooManySignatures},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sigs []signing.SignatureV2
+			for i := range tt.numForwarding {
+				sigs = append(sigs, signing.SignatureV2{
+					PubKey: &types.ForwardingPubKey{Key: types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", fmt.Sprintf("recipient%d", i), "")},
+					Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				})
+			}
+			for range tt.numRegular {
+				sigs = append(sigs, signing.SignatureV2{
+					PubKey: secp256k1.GenPrivKey().PubKey(),
+					Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				})
+			}
+
+			builder := app.TxConfig().NewTxBuilder()
+			require.NoError(t, builder.SetSignatures(sigs...))
+
+			called := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+
+			_, err := forwarding.NewValidateSigCountDecorator(app.AccountKeeper).AnteHandle(ctx, builder.GetTx(), false, next)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.False(t, called)
+			} else {
+				require.NoError(t, err)
+				require.True(t, called)
+			}
+		})
+	}
+}
+
+// buildTx returns a transaction containing the given messages, signed by the
+// given public keys with empty signatures.
+func buildTx(t testing.TB, app *simapp.SimApp, msgs []sdk.Msg, pubKeys []cryptotypes.PubKey) sdk.Tx {
+	t.Helper()
+
+	return buildTxWithFees(t, app, msgs, pubKeys, nil, nil)
+}
+
+// buildTxWithFees is buildTx, with an optional fee payer and fee granter.
+func buildTxWithFees(t testing.TB, app *simapp.SimApp, msgs []sdk.Msg, pubKeys []cryptotypes.PubKey, feePayer, feeGranter sdk.AccAddress) sdk.Tx {
+	t.Helper()
+
+	builder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(1_000_000)
+	builder.SetFeePayer(feePayer)
+	builder.SetFeeGranter(feeGranter)
+
+	sigs := make([]signing.SignatureV2, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		sigs[i] = signing.SignatureV2{
+			PubKey: pubKey,
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		}
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+
+	return builder.GetTx()
+}
+
+// underlyingDecorator fails every transaction, as signerless transactions
+// should never reach the underlying signature verification.
+type underlyingDecorator struct{}
+
+func (underlyingDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, errors.New("underlying decorator invoked")
+}
+
+// anteHandle runs a signerless registration of the given forwarding account
+// through the signature verification decorator, reporting whether the next
+// ante handler was invoked.
+func anteHandle(app *simapp.SimApp, ctx sdk.Context, address sdk.AccAddress) (bool, error) {
+	return anteHandleMsg(app, ctx, &types.MsgRegisterAccount{
+		Signer:    address.String(),
+		Recipient: "recipient",
+		Channel:   "channel-0",
+	})
+}
+
+// anteHandleMsg runs a transaction containing the given messages through the
+// signature verification decorator, reporting whether the next ante handler
+// was invoked.
+func anteHandleMsg(app *simapp.SimApp, ctx sdk.Context, msgs ...sdk.Msg) (bool, error) {
+	builder := app.TxConfig().NewTxBuilder()
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return false, err
+	}
+
+	called := false
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		called = true
+		return ctx, nil
+	}
+
+	decorator := forwarding.NewSigVerificationDecorator(app.BankKeeper, app.ForwardingKeeper, underlyingDecorator{})
+	_, err := decorator.AnteHandle(ctx, builder.GetTx(), false, next)
+
+	return called, err
+}
+
+var configureSDKOnce sync.Once
+
+func setupSimApp(t testing.TB) (*simapp.SimApp, sdk.Context) {
+	t.Helper()
+
+	configureSDKOnce.Do(func() {
+		cfg := sdk.GetConfig()
+		cfg.SetBech32PrefixForAccount("noble", "noblepub")
+		cfg.SetBech32PrefixForValidator("noblevaloper", "noblevaloperpub")
+		cfg.SetBech32PrefixForConsensusNode("noblevalcons", "noblevalconspub")
+		cfg.Seal()
+	})
+
+	app, err := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, emptyAppOptions{})
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height: 1,
+		Time:   time.Now().UTC(),
+	})
+
+	// NOTE: Registrations are validated against an open transfer channel.
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, "counter-0"),
+		[]string{"connection-0"},
+		transfertypes.V1,
+	)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, "channel-0", channel)
+
+	return app, ctx
+}
+
+// fund mints the given amount to an account.
+func fund(t testing.TB, app *simapp.SimApp, ctx sdk.Context, address sdk.AccAddress, amount sdk.Coin) {
+	t.Helper()
+
+	coins := sdk.NewCoins(amount)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, address, coins))
+}
+
+type emptyAppOptions struct{}
+
+func (emptyAppOptions) Get(string) interface{} { return nil }
@@ -1,3 +1,23 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
 // Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
 package forwardingv1
 
@@ -16,12 +36,13 @@ import (
 )
 
 var (
-	md_ForwardingAccount              protoreflect.MessageDescriptor
-	fd_ForwardingAccount_base_account protoreflect.FieldDescriptor
-	fd_ForwardingAccount_channel      protoreflect.FieldDescriptor
-	fd_ForwardingAccount_recipient    protoreflect.FieldDescriptor
-	fd_ForwardingAccount_created_at   protoreflect.FieldDescriptor
-	fd_ForwardingAccount_fallback     protoreflect.FieldDescriptor
+	md_ForwardingAccount                 protoreflect.MessageDescriptor
+	fd_ForwardingAccount_base_account    protoreflect.FieldDescriptor
+	fd_ForwardingAccount_channel         protoreflect.FieldDescriptor
+	fd_ForwardingAccount_recipient       protoreflect.FieldDescriptor
+	fd_ForwardingAccount_created_at      protoreflect.FieldDescriptor
+	fd_ForwardingAccount_fallback        protoreflect.FieldDescriptor
+	fd_ForwardingAccount_address_version protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +53,7 @@ func init() {
 	fd_ForwardingAccount_recipient = md_ForwardingAccount.Fields().ByName("recipient")
 	fd_ForwardingAccount_created_at = md_ForwardingAccount.Fields().ByName("created_at")
 	fd_ForwardingAccount_fallback = md_ForwardingAccount.Fields().ByName("fallback")
+	fd_ForwardingAccount_address_version = md_ForwardingAccount.Fields().ByName("address_version")
 }
 
 var _ protoreflect.Message = (*fastReflection_ForwardingAccount)(nil)
@@ -129,6 +151,12 @@ func (x *fastReflection_ForwardingAccount) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.AddressVersion != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AddressVersion))
+		if !f(fd_ForwardingAccount_address_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -154,6 +182,8 @@ func (x *fastReflection_ForwardingAccount) Has(fd protoreflect.FieldDescriptor)
 		return x.CreatedAt != int64(0)
 	case "noble.forwarding.v1.ForwardingAccount.fallback":
 		return x.Fallback != ""
+	case "noble.forwarding.v1.ForwardingAccount.address_version":
+		return x.AddressVersion != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.ForwardingAccount"))
@@ -180,6 +210,8 @@ func (x *fastReflection_ForwardingAccount) Clear(fd protoreflect.FieldDescriptor
 		x.CreatedAt = int64(0)
 	case "noble.forwarding.v1.ForwardingAccount.fallback":
 		x.Fallback = ""
+	case "noble.forwarding.v1.ForwardingAccount.address_version":
+		x.AddressVersion = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.ForwardingAccount"))
@@ -211,6 +243,9 @@ func (x *fastReflection_ForwardingAccount) Get(descriptor protoreflect.FieldDesc
 	case "noble.forwarding.v1.ForwardingAccount.fallback":
 		value := x.Fallback
 		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.ForwardingAccount.address_version":
+		value := x.AddressVersion
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.ForwardingAccount"))
@@ -241,6 +276,8 @@ func (x *fastReflection_ForwardingAccount) Set(fd protoreflect.FieldDescriptor,
 		x.CreatedAt = value.Int()
 	case "noble.forwarding.v1.ForwardingAccount.fallback":
 		x.Fallback = value.Interface().(string)
+	case "noble.forwarding.v1.ForwardingAccount.address_version":
+		x.AddressVersion = (AddressVersion)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.ForwardingAccount"))
@@ -274,6 +311,8 @@ func (x *fastReflection_ForwardingAccount) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field created_at of message noble.forwarding.v1.ForwardingAccount is not mutable"))
 	case "noble.forwarding.v1.ForwardingAccount.fallback":
 		panic(fmt.Errorf("field fallback of message noble.forwarding.v1.ForwardingAccount is not mutable"))
+	case "noble.forwarding.v1.ForwardingAccount.address_version":
+		panic(fmt.Errorf("field address_version of message noble.forwarding.v1.ForwardingAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.ForwardingAccount"))
@@ -298,6 +337,8 @@ func (x *fastReflection_ForwardingAccount) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfInt64(int64(0))
 	case "noble.forwarding.v1.ForwardingAccount.fallback":
 		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.ForwardingAccount.address_version":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.ForwardingAccount"))
@@ -386,6 +427,9 @@ func (x *fastReflection_ForwardingAccount) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.AddressVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddressVersion))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -415,6 +459,11 @@ func (x *fastReflection_ForwardingAccount) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AddressVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddressVersion))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.Fallback) > 0 {
 			i -= len(x.Fallback)
 			copy(dAtA[i:], x.Fallback)
@@ -655,6 +704,25 @@ func (x *fastReflection_ForwardingAccount) ProtoMethods() *protoiface.Methods {
 				}
 				x.Fallback = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+				}
+				x.AddressVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddressVersion |= AddressVersion(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1145,6 +1213,114 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AddressVersion defines the scheme used to derive a forwarding account's
+// address from its channel, recipient, and fallback.
+type AddressVersion int32
+
+const (
+	// ADDRESS_VERSION_UNSPECIFIED is the default value.
+	AddressVersion_ADDRESS_VERSION_UNSPECIFIED AddressVersion = 0
+	// ADDRESS_VERSION_V1 hashes the plain concatenation of the fields.
+	AddressVersion_ADDRESS_VERSION_V1 AddressVersion = 1
+	// ADDRESS_VERSION_V2 hashes the length-prefixed fields.
+	AddressVersion_ADDRESS_VERSION_V2 AddressVersion = 2
+)
+
+// Enum value maps for AddressVersion.
+var (
+	AddressVersion_name = map[int32]string{
+		0: "ADDRESS_VERSION_UNSPECIFIED",
+		1: "ADDRESS_VERSION_V1",
+		2: "ADDRESS_VERSION_V2",
+	}
+	AddressVersion_value = map[string]int32{
+		"ADDRESS_VERSION_UNSPECIFIED": 0,
+		"ADDRESS_VERSION_V1":          1,
+		"ADDRESS_VERSION_V2":          2,
+	}
+)
+
+func (x AddressVersion) Enum() *AddressVersion {
+	p := new(AddressVersion)
+	*p = x
+	return p
+}
+
+func (x AddressVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_noble_forwarding_v1_account_proto_enumTypes[0].Descriptor()
+}
+
+func (AddressVersion) Type() protoreflect.EnumType {
+	return &file_noble_forwarding_v1_account_proto_enumTypes[0]
+}
+
+func (x AddressVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressVersion.Descriptor instead.
+func (AddressVersion) EnumDescriptor() ([]byte, []int) {
+	return file_noble_forwarding_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
+// ForwardMode determines when funds received by forwarding accounts over IBC
+// are forwarded.
+type ForwardMode int32
+
+const (
+	// FORWARD_MODE_UNSPECIFIED is the default value, and behaves as end block.
+	ForwardMode_FORWARD_MODE_UNSPECIFIED ForwardMode = 0
+	// FORWARD_MODE_END_BLOCK forwards received funds at the end of the block.
+	ForwardMode_FORWARD_MODE_END_BLOCK ForwardMode = 1
+	// FORWARD_MODE_IMMEDIATE forwards received funds in the same transaction.
+	ForwardMode_FORWARD_MODE_IMMEDIATE ForwardMode = 2
+)
+
+// Enum value maps for ForwardMode.
+var (
+	ForwardMode_name = map[int32]string{
+		0: "FORWARD_MODE_UNSPECIFIED",
+		1: "FORWARD_MODE_END_BLOCK",
+		2: "FORWARD_MODE_IMMEDIATE",
+	}
+	ForwardMode_value = map[string]int32{
+		"FORWARD_MODE_UNSPECIFIED": 0,
+		"FORWARD_MODE_END_BLOCK":   1,
+		"FORWARD_MODE_IMMEDIATE":   2,
+	}
+)
+
+func (x ForwardMode) Enum() *ForwardMode {
+	p := new(ForwardMode)
+	*p = x
+	return p
+}
+
+func (x ForwardMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForwardMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_noble_forwarding_v1_account_proto_enumTypes[1].Descriptor()
+}
+
+func (ForwardMode) Type() protoreflect.EnumType {
+	return &file_noble_forwarding_v1_account_proto_enumTypes[1]
+}
+
+func (x ForwardMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForwardMode.Descriptor instead.
+func (ForwardMode) EnumDescriptor() ([]byte, []int) {
+	return file_noble_forwarding_v1_account_proto_rawDescGZIP(), []int{1}
+}
+
 type ForwardingAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1155,6 +1331,10 @@ type ForwardingAccount struct {
 	Recipient   string               `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	CreatedAt   int64                `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Fallback    string               `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// address_version is the scheme used to derive the account's address.
+	// Accounts registered before versioning was introduced leave it unspecified
+	// and use v1.
+	AddressVersion AddressVersion `protobuf:"varint,6,opt,name=address_version,json=addressVersion,proto3,enum=noble.forwarding.v1.AddressVersion" json:"address_version,omitempty"`
 }
 
 func (x *ForwardingAccount) Reset() {
@@ -1212,6 +1392,13 @@ func (x *ForwardingAccount) GetFallback() string {
 	return ""
 }
 
+func (x *ForwardingAccount) GetAddressVersion() AddressVersion {
+	if x != nil {
+		return x.AddressVersion
+	}
+	return AddressVersion_ADDRESS_VERSION_UNSPECIFIED
+}
+
 type ForwardingPubKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1258,7 +1445,7 @@ var file_noble_forwarding_v1_account_proto_rawDesc = []byte{
 	0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x01, 0x0a, 0x11, 0x46, 0x6f,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x02, 0x0a, 0x11, 0x46, 0x6f,
 	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
 	0x49, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
@@ -1271,27 +1458,45 @@ var file_noble_forwarding_v1_account_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
 	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x20, 0xca,
-	0xb4, 0x2d, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x22,
-	0x2a, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x42, 0xe1, 0x01, 0x0a, 0x17,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
-	0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
-	0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x46, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
-	0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a,
-	0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x4c, 0x0a,
+	0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0xca, 0xb4, 0x2d,
+	0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x22, 0x2a, 0x0a,
+	0x10, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x2a, 0x67, 0x0a, 0x0e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x41,
+	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x56, 0x31, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x32, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x2a, 0x69, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x45, 0x4e, 0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46,
+	0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45,
+	0x44, 0x49, 0x41, 0x54, 0x45, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe1, 0x01,
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x32,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x46, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c,
+	0x65, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1306,19 +1511,23 @@ func file_noble_forwarding_v1_account_proto_rawDescGZIP() []byte {
 	return file_noble_forwarding_v1_account_proto_rawDescData
 }
 
+var file_noble_forwarding_v1_account_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_noble_forwarding_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_noble_forwarding_v1_account_proto_goTypes = []interface{}{
-	(*ForwardingAccount)(nil),   // 0: noble.forwarding.v1.ForwardingAccount
-	(*ForwardingPubKey)(nil),    // 1: noble.forwarding.v1.ForwardingPubKey
-	(*v1beta1.BaseAccount)(nil), // 2: cosmos.auth.v1beta1.BaseAccount
+	(AddressVersion)(0),         // 0: noble.forwarding.v1.AddressVersion
+	(ForwardMode)(0),            // 1: noble.forwarding.v1.ForwardMode
+	(*ForwardingAccount)(nil),   // 2: noble.forwarding.v1.ForwardingAccount
+	(*ForwardingPubKey)(nil),    // 3: noble.forwarding.v1.ForwardingPubKey
+	(*v1beta1.BaseAccount)(nil), // 4: cosmos.auth.v1beta1.BaseAccount
 }
 var file_noble_forwarding_v1_account_proto_depIdxs = []int32{
-	2, // 0: noble.forwarding.v1.ForwardingAccount.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4, // 0: noble.forwarding.v1.ForwardingAccount.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
+	0, // 1: noble.forwarding.v1.ForwardingAccount.address_version:type_name -> noble.forwarding.v1.AddressVersion
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_noble_forwarding_v1_account_proto_init() }
@@ -1357,13 +1566,14 @@ func file_noble_forwarding_v1_account_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_forwarding_v1_account_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_noble_forwarding_v1_account_proto_goTypes,
 		DependencyIndexes: file_noble_forwarding_v1_account_proto_depIdxs,
+		EnumInfos:         file_noble_forwarding_v1_account_proto_enumTypes,
 		MessageInfos:      file_noble_forwarding_v1_account_proto_msgTypes,
 	}.Build()
 	File_noble_forwarding_v1_account_proto = out.File
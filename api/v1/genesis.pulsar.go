@@ -1,9 +1,31 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
 // Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
 package forwardingv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -305,659 +327,2686 @@ func (x *_GenesisState_4_map) IsValid() bool {
 	return x.m != nil
 }
 
-var (
-	md_GenesisState                 protoreflect.MessageDescriptor
-	fd_GenesisState_allowed_denoms  protoreflect.FieldDescriptor
-	fd_GenesisState_num_of_accounts protoreflect.FieldDescriptor
-	fd_GenesisState_num_of_forwards protoreflect.FieldDescriptor
-	fd_GenesisState_total_forwarded protoreflect.FieldDescriptor
-)
+var _ protoreflect.Map = (*_GenesisState_6_map)(nil)
 
-func init() {
-	file_noble_forwarding_v1_genesis_proto_init()
-	md_GenesisState = File_noble_forwarding_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_allowed_denoms = md_GenesisState.Fields().ByName("allowed_denoms")
-	fd_GenesisState_num_of_accounts = md_GenesisState.Fields().ByName("num_of_accounts")
-	fd_GenesisState_num_of_forwards = md_GenesisState.Fields().ByName("num_of_forwards")
-	fd_GenesisState_total_forwarded = md_GenesisState.Fields().ByName("total_forwarded")
+type _GenesisState_6_map struct {
+	m *map[string]*AccountStats
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_6_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_6_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_6_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_forwarding_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *_GenesisState_6_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
 	}
-	return mi.MessageOf(x)
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_6_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_6_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountStats)
+	(*x.m)[concreteKey] = concreteValue
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_6_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(AccountStats)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_6_map) NewValue() protoreflect.Value {
+	v := new(AccountStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *_GenesisState_6_map) IsValid() bool {
+	return x.m != nil
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*StatsBucket
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StatsBucket)
+	(*x.list)[i] = concreteValue
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AllowedDenoms) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.AllowedDenoms})
-		if !f(fd_GenesisState_allowed_denoms, value) {
-			return
-		}
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StatsBucket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(StatsBucket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.NumOfAccounts) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_2_map{m: &x.NumOfAccounts})
-		if !f(fd_GenesisState_num_of_accounts, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(StatsBucket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_9_map)(nil)
+
+type _GenesisState_9_map struct {
+	m *map[string]string
+}
+
+func (x *_GenesisState_9_map) Len() int {
+	if x.m == nil {
+		return 0
 	}
-	if len(x.NumOfForwards) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_3_map{m: &x.NumOfForwards})
-		if !f(fd_GenesisState_num_of_forwards, value) {
-			return
-		}
+	return len(*x.m)
+}
+
+func (x *_GenesisState_9_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
 	}
-	if len(x.TotalForwarded) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_4_map{m: &x.TotalForwarded})
-		if !f(fd_GenesisState_total_forwarded, value) {
-			return
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
 		}
 	}
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "noble.forwarding.v1.GenesisState.allowed_denoms":
-		return len(x.AllowedDenoms) != 0
-	case "noble.forwarding.v1.GenesisState.num_of_accounts":
-		return len(x.NumOfAccounts) != 0
-	case "noble.forwarding.v1.GenesisState.num_of_forwards":
-		return len(x.NumOfForwards) != 0
-	case "noble.forwarding.v1.GenesisState.total_forwarded":
-		return len(x.TotalForwarded) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
 	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "noble.forwarding.v1.GenesisState.allowed_denoms":
-		x.AllowedDenoms = nil
-	case "noble.forwarding.v1.GenesisState.num_of_accounts":
-		x.NumOfAccounts = nil
-	case "noble.forwarding.v1.GenesisState.num_of_forwards":
-		x.NumOfForwards = nil
-	case "noble.forwarding.v1.GenesisState.total_forwarded":
-		x.TotalForwarded = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
 	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "noble.forwarding.v1.GenesisState.allowed_denoms":
-		if len(x.AllowedDenoms) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_1_list{})
-		}
-		listValue := &_GenesisState_1_list{list: &x.AllowedDenoms}
-		return protoreflect.ValueOfList(listValue)
-	case "noble.forwarding.v1.GenesisState.num_of_accounts":
-		if len(x.NumOfAccounts) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_2_map{})
-		}
-		mapValue := &_GenesisState_2_map{m: &x.NumOfAccounts}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.forwarding.v1.GenesisState.num_of_forwards":
-		if len(x.NumOfForwards) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_3_map{})
-		}
-		mapValue := &_GenesisState_3_map{m: &x.NumOfForwards}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.forwarding.v1.GenesisState.total_forwarded":
-		if len(x.TotalForwarded) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_4_map{})
-		}
-		mapValue := &_GenesisState_4_map{m: &x.TotalForwarded}
-		return protoreflect.ValueOfMap(mapValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_9_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
 	}
+	return protoreflect.ValueOfString(v)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "noble.forwarding.v1.GenesisState.allowed_denoms":
-		lv := value.List()
-		clv := lv.(*_GenesisState_1_list)
-		x.AllowedDenoms = *clv.list
-	case "noble.forwarding.v1.GenesisState.num_of_accounts":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_2_map)
-		x.NumOfAccounts = *cmv.m
-	case "noble.forwarding.v1.GenesisState.num_of_forwards":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_3_map)
-		x.NumOfForwards = *cmv.m
-	case "noble.forwarding.v1.GenesisState.total_forwarded":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_4_map)
-		x.TotalForwarded = *cmv.m
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
 	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "noble.forwarding.v1.GenesisState.allowed_denoms":
-		if x.AllowedDenoms == nil {
-			x.AllowedDenoms = []string{}
-		}
-		value := &_GenesisState_1_list{list: &x.AllowedDenoms}
-		return protoreflect.ValueOfList(value)
-	case "noble.forwarding.v1.GenesisState.num_of_accounts":
-		if x.NumOfAccounts == nil {
-			x.NumOfAccounts = make(map[string]uint64)
-		}
-		value := &_GenesisState_2_map{m: &x.NumOfAccounts}
-		return protoreflect.ValueOfMap(value)
-	case "noble.forwarding.v1.GenesisState.num_of_forwards":
-		if x.NumOfForwards == nil {
-			x.NumOfForwards = make(map[string]uint64)
-		}
-		value := &_GenesisState_3_map{m: &x.NumOfForwards}
-		return protoreflect.ValueOfMap(value)
-	case "noble.forwarding.v1.GenesisState.total_forwarded":
-		if x.TotalForwarded == nil {
-			x.TotalForwarded = make(map[string]string)
-		}
-		value := &_GenesisState_4_map{m: &x.TotalForwarded}
-		return protoreflect.ValueOfMap(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_GenesisState_9_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_9_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_11_map)(nil)
+
+type _GenesisState_11_map struct {
+	m *map[string]uint64
+}
+
+func (x *_GenesisState_11_map) Len() int {
+	if x.m == nil {
+		return 0
 	}
+	return len(*x.m)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "noble.forwarding.v1.GenesisState.allowed_denoms":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
-	case "noble.forwarding.v1.GenesisState.num_of_accounts":
-		m := make(map[string]uint64)
-		return protoreflect.ValueOfMap(&_GenesisState_2_map{m: &m})
-	case "noble.forwarding.v1.GenesisState.num_of_forwards":
-		m := make(map[string]uint64)
-		return protoreflect.ValueOfMap(&_GenesisState_3_map{m: &m})
-	case "noble.forwarding.v1.GenesisState.total_forwarded":
-		m := make(map[string]string)
-		return protoreflect.ValueOfMap(&_GenesisState_4_map{m: &m})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
+func (x *_GenesisState_11_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfUint64(v)
+		if !f(mapKey, mapValue) {
+			break
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
 	}
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_11_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
 	}
-	panic("unreachable")
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_11_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_11_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfUint64(v)
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_11_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.AllowedDenoms) > 0 {
-			for _, s := range x.AllowedDenoms {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.NumOfAccounts) > 0 {
-			SiZeMaP := func(k string, v uint64) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.NumOfAccounts))
-				for k := range x.NumOfAccounts {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.NumOfAccounts[k]
-					SiZeMaP(k, v)
-				}
+func (x *_GenesisState_11_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_GenesisState_11_map) NewValue() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_11_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*InFlightPacket
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InFlightPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InFlightPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(InFlightPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(InFlightPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_16_map)(nil)
+
+type _GenesisState_16_map struct {
+	m *map[string]*v1beta1.Coin
+}
+
+func (x *_GenesisState_16_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_GenesisState_16_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_GenesisState_16_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_GenesisState_16_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_GenesisState_16_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_GenesisState_16_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(v1beta1.Coin)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_GenesisState_16_map) NewValue() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_17_map)(nil)
+
+type _GenesisState_17_map struct {
+	m *map[string]uint64
+}
+
+func (x *_GenesisState_17_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_GenesisState_17_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfUint64(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_GenesisState_17_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_GenesisState_17_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_GenesisState_17_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_17_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_GenesisState_17_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_GenesisState_17_map) NewValue() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_17_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*ForwardRecordEntry
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardRecordEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardRecordEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(ForwardRecordEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(ForwardRecordEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                              protoreflect.MessageDescriptor
+	fd_GenesisState_allowed_denoms               protoreflect.FieldDescriptor
+	fd_GenesisState_num_of_accounts              protoreflect.FieldDescriptor
+	fd_GenesisState_num_of_forwards              protoreflect.FieldDescriptor
+	fd_GenesisState_total_forwarded              protoreflect.FieldDescriptor
+	fd_GenesisState_history_retention            protoreflect.FieldDescriptor
+	fd_GenesisState_account_stats                protoreflect.FieldDescriptor
+	fd_GenesisState_stats_retention              protoreflect.FieldDescriptor
+	fd_GenesisState_stats_buckets                protoreflect.FieldDescriptor
+	fd_GenesisState_chain_channels               protoreflect.FieldDescriptor
+	fd_GenesisState_registration_mode            protoreflect.FieldDescriptor
+	fd_GenesisState_num_of_registration_failures protoreflect.FieldDescriptor
+	fd_GenesisState_in_flight_packets            protoreflect.FieldDescriptor
+	fd_GenesisState_forward_mode                 protoreflect.FieldDescriptor
+	fd_GenesisState_signerless_fee               protoreflect.FieldDescriptor
+	fd_GenesisState_registration_fee             protoreflect.FieldDescriptor
+	fd_GenesisState_unpaid_registration_fees     protoreflect.FieldDescriptor
+	fd_GenesisState_history_sequence             protoreflect.FieldDescriptor
+	fd_GenesisState_forward_records              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_forwarding_v1_genesis_proto_init()
+	md_GenesisState = File_noble_forwarding_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_allowed_denoms = md_GenesisState.Fields().ByName("allowed_denoms")
+	fd_GenesisState_num_of_accounts = md_GenesisState.Fields().ByName("num_of_accounts")
+	fd_GenesisState_num_of_forwards = md_GenesisState.Fields().ByName("num_of_forwards")
+	fd_GenesisState_total_forwarded = md_GenesisState.Fields().ByName("total_forwarded")
+	fd_GenesisState_history_retention = md_GenesisState.Fields().ByName("history_retention")
+	fd_GenesisState_account_stats = md_GenesisState.Fields().ByName("account_stats")
+	fd_GenesisState_stats_retention = md_GenesisState.Fields().ByName("stats_retention")
+	fd_GenesisState_stats_buckets = md_GenesisState.Fields().ByName("stats_buckets")
+	fd_GenesisState_chain_channels = md_GenesisState.Fields().ByName("chain_channels")
+	fd_GenesisState_registration_mode = md_GenesisState.Fields().ByName("registration_mode")
+	fd_GenesisState_num_of_registration_failures = md_GenesisState.Fields().ByName("num_of_registration_failures")
+	fd_GenesisState_in_flight_packets = md_GenesisState.Fields().ByName("in_flight_packets")
+	fd_GenesisState_forward_mode = md_GenesisState.Fields().ByName("forward_mode")
+	fd_GenesisState_signerless_fee = md_GenesisState.Fields().ByName("signerless_fee")
+	fd_GenesisState_registration_fee = md_GenesisState.Fields().ByName("registration_fee")
+	fd_GenesisState_unpaid_registration_fees = md_GenesisState.Fields().ByName("unpaid_registration_fees")
+	fd_GenesisState_history_sequence = md_GenesisState.Fields().ByName("history_sequence")
+	fd_GenesisState_forward_records = md_GenesisState.Fields().ByName("forward_records")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_forwarding_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.AllowedDenoms})
+		if !f(fd_GenesisState_allowed_denoms, value) {
+			return
+		}
+	}
+	if len(x.NumOfAccounts) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_2_map{m: &x.NumOfAccounts})
+		if !f(fd_GenesisState_num_of_accounts, value) {
+			return
+		}
+	}
+	if len(x.NumOfForwards) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_3_map{m: &x.NumOfForwards})
+		if !f(fd_GenesisState_num_of_forwards, value) {
+			return
+		}
+	}
+	if len(x.TotalForwarded) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_4_map{m: &x.TotalForwarded})
+		if !f(fd_GenesisState_total_forwarded, value) {
+			return
+		}
+	}
+	if x.HistoryRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HistoryRetention)
+		if !f(fd_GenesisState_history_retention, value) {
+			return
+		}
+	}
+	if len(x.AccountStats) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_6_map{m: &x.AccountStats})
+		if !f(fd_GenesisState_account_stats, value) {
+			return
+		}
+	}
+	if x.StatsRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StatsRetention)
+		if !f(fd_GenesisState_stats_retention, value) {
+			return
+		}
+	}
+	if len(x.StatsBuckets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.StatsBuckets})
+		if !f(fd_GenesisState_stats_buckets, value) {
+			return
+		}
+	}
+	if len(x.ChainChannels) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_9_map{m: &x.ChainChannels})
+		if !f(fd_GenesisState_chain_channels, value) {
+			return
+		}
+	}
+	if x.RegistrationMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.RegistrationMode))
+		if !f(fd_GenesisState_registration_mode, value) {
+			return
+		}
+	}
+	if len(x.NumOfRegistrationFailures) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_11_map{m: &x.NumOfRegistrationFailures})
+		if !f(fd_GenesisState_num_of_registration_failures, value) {
+			return
+		}
+	}
+	if len(x.InFlightPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.InFlightPackets})
+		if !f(fd_GenesisState_in_flight_packets, value) {
+			return
+		}
+	}
+	if x.ForwardMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ForwardMode))
+		if !f(fd_GenesisState_forward_mode, value) {
+			return
+		}
+	}
+	if x.SignerlessFee != nil {
+		value := protoreflect.ValueOfMessage(x.SignerlessFee.ProtoReflect())
+		if !f(fd_GenesisState_signerless_fee, value) {
+			return
+		}
+	}
+	if x.RegistrationFee != nil {
+		value := protoreflect.ValueOfMessage(x.RegistrationFee.ProtoReflect())
+		if !f(fd_GenesisState_registration_fee, value) {
+			return
+		}
+	}
+	if len(x.UnpaidRegistrationFees) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_16_map{m: &x.UnpaidRegistrationFees})
+		if !f(fd_GenesisState_unpaid_registration_fees, value) {
+			return
+		}
+	}
+	if len(x.HistorySequence) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_17_map{m: &x.HistorySequence})
+		if !f(fd_GenesisState_history_sequence, value) {
+			return
+		}
+	}
+	if len(x.ForwardRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.ForwardRecords})
+		if !f(fd_GenesisState_forward_records, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.GenesisState.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "noble.forwarding.v1.GenesisState.num_of_accounts":
+		return len(x.NumOfAccounts) != 0
+	case "noble.forwarding.v1.GenesisState.num_of_forwards":
+		return len(x.NumOfForwards) != 0
+	case "noble.forwarding.v1.GenesisState.total_forwarded":
+		return len(x.TotalForwarded) != 0
+	case "noble.forwarding.v1.GenesisState.history_retention":
+		return x.HistoryRetention != uint64(0)
+	case "noble.forwarding.v1.GenesisState.account_stats":
+		return len(x.AccountStats) != 0
+	case "noble.forwarding.v1.GenesisState.stats_retention":
+		return x.StatsRetention != uint64(0)
+	case "noble.forwarding.v1.GenesisState.stats_buckets":
+		return len(x.StatsBuckets) != 0
+	case "noble.forwarding.v1.GenesisState.chain_channels":
+		return len(x.ChainChannels) != 0
+	case "noble.forwarding.v1.GenesisState.registration_mode":
+		return x.RegistrationMode != 0
+	case "noble.forwarding.v1.GenesisState.num_of_registration_failures":
+		return len(x.NumOfRegistrationFailures) != 0
+	case "noble.forwarding.v1.GenesisState.in_flight_packets":
+		return len(x.InFlightPackets) != 0
+	case "noble.forwarding.v1.GenesisState.forward_mode":
+		return x.ForwardMode != 0
+	case "noble.forwarding.v1.GenesisState.signerless_fee":
+		return x.SignerlessFee != nil
+	case "noble.forwarding.v1.GenesisState.registration_fee":
+		return x.RegistrationFee != nil
+	case "noble.forwarding.v1.GenesisState.unpaid_registration_fees":
+		return len(x.UnpaidRegistrationFees) != 0
+	case "noble.forwarding.v1.GenesisState.history_sequence":
+		return len(x.HistorySequence) != 0
+	case "noble.forwarding.v1.GenesisState.forward_records":
+		return len(x.ForwardRecords) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.GenesisState.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "noble.forwarding.v1.GenesisState.num_of_accounts":
+		x.NumOfAccounts = nil
+	case "noble.forwarding.v1.GenesisState.num_of_forwards":
+		x.NumOfForwards = nil
+	case "noble.forwarding.v1.GenesisState.total_forwarded":
+		x.TotalForwarded = nil
+	case "noble.forwarding.v1.GenesisState.history_retention":
+		x.HistoryRetention = uint64(0)
+	case "noble.forwarding.v1.GenesisState.account_stats":
+		x.AccountStats = nil
+	case "noble.forwarding.v1.GenesisState.stats_retention":
+		x.StatsRetention = uint64(0)
+	case "noble.forwarding.v1.GenesisState.stats_buckets":
+		x.StatsBuckets = nil
+	case "noble.forwarding.v1.GenesisState.chain_channels":
+		x.ChainChannels = nil
+	case "noble.forwarding.v1.GenesisState.registration_mode":
+		x.RegistrationMode = 0
+	case "noble.forwarding.v1.GenesisState.num_of_registration_failures":
+		x.NumOfRegistrationFailures = nil
+	case "noble.forwarding.v1.GenesisState.in_flight_packets":
+		x.InFlightPackets = nil
+	case "noble.forwarding.v1.GenesisState.forward_mode":
+		x.ForwardMode = 0
+	case "noble.forwarding.v1.GenesisState.signerless_fee":
+		x.SignerlessFee = nil
+	case "noble.forwarding.v1.GenesisState.registration_fee":
+		x.RegistrationFee = nil
+	case "noble.forwarding.v1.GenesisState.unpaid_registration_fees":
+		x.UnpaidRegistrationFees = nil
+	case "noble.forwarding.v1.GenesisState.history_sequence":
+		x.HistorySequence = nil
+	case "noble.forwarding.v1.GenesisState.forward_records":
+		x.ForwardRecords = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.forwarding.v1.GenesisState.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_1_list{})
+		}
+		listValue := &_GenesisState_1_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.forwarding.v1.GenesisState.num_of_accounts":
+		if len(x.NumOfAccounts) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_2_map{})
+		}
+		mapValue := &_GenesisState_2_map{m: &x.NumOfAccounts}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.num_of_forwards":
+		if len(x.NumOfForwards) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_3_map{})
+		}
+		mapValue := &_GenesisState_3_map{m: &x.NumOfForwards}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.total_forwarded":
+		if len(x.TotalForwarded) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_4_map{})
+		}
+		mapValue := &_GenesisState_4_map{m: &x.TotalForwarded}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.history_retention":
+		value := x.HistoryRetention
+		return protoreflect.ValueOfUint64(value)
+	case "noble.forwarding.v1.GenesisState.account_stats":
+		if len(x.AccountStats) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_6_map{})
+		}
+		mapValue := &_GenesisState_6_map{m: &x.AccountStats}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.stats_retention":
+		value := x.StatsRetention
+		return protoreflect.ValueOfUint64(value)
+	case "noble.forwarding.v1.GenesisState.stats_buckets":
+		if len(x.StatsBuckets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.StatsBuckets}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.forwarding.v1.GenesisState.chain_channels":
+		if len(x.ChainChannels) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_9_map{})
+		}
+		mapValue := &_GenesisState_9_map{m: &x.ChainChannels}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.registration_mode":
+		value := x.RegistrationMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "noble.forwarding.v1.GenesisState.num_of_registration_failures":
+		if len(x.NumOfRegistrationFailures) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_11_map{})
+		}
+		mapValue := &_GenesisState_11_map{m: &x.NumOfRegistrationFailures}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.in_flight_packets":
+		if len(x.InFlightPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.InFlightPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.forwarding.v1.GenesisState.forward_mode":
+		value := x.ForwardMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "noble.forwarding.v1.GenesisState.signerless_fee":
+		value := x.SignerlessFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.forwarding.v1.GenesisState.registration_fee":
+		value := x.RegistrationFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.forwarding.v1.GenesisState.unpaid_registration_fees":
+		if len(x.UnpaidRegistrationFees) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_16_map{})
+		}
+		mapValue := &_GenesisState_16_map{m: &x.UnpaidRegistrationFees}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.history_sequence":
+		if len(x.HistorySequence) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_17_map{})
+		}
+		mapValue := &_GenesisState_17_map{m: &x.HistorySequence}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.forwarding.v1.GenesisState.forward_records":
+		if len(x.ForwardRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.ForwardRecords}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.GenesisState.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_GenesisState_1_list)
+		x.AllowedDenoms = *clv.list
+	case "noble.forwarding.v1.GenesisState.num_of_accounts":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_2_map)
+		x.NumOfAccounts = *cmv.m
+	case "noble.forwarding.v1.GenesisState.num_of_forwards":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_3_map)
+		x.NumOfForwards = *cmv.m
+	case "noble.forwarding.v1.GenesisState.total_forwarded":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_4_map)
+		x.TotalForwarded = *cmv.m
+	case "noble.forwarding.v1.GenesisState.history_retention":
+		x.HistoryRetention = value.Uint()
+	case "noble.forwarding.v1.GenesisState.account_stats":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_6_map)
+		x.AccountStats = *cmv.m
+	case "noble.forwarding.v1.GenesisState.stats_retention":
+		x.StatsRetention = value.Uint()
+	case "noble.forwarding.v1.GenesisState.stats_buckets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.StatsBuckets = *clv.list
+	case "noble.forwarding.v1.GenesisState.chain_channels":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_9_map)
+		x.ChainChannels = *cmv.m
+	case "noble.forwarding.v1.GenesisState.registration_mode":
+		x.RegistrationMode = (RegistrationMode)(value.Enum())
+	case "noble.forwarding.v1.GenesisState.num_of_registration_failures":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_11_map)
+		x.NumOfRegistrationFailures = *cmv.m
+	case "noble.forwarding.v1.GenesisState.in_flight_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.InFlightPackets = *clv.list
+	case "noble.forwarding.v1.GenesisState.forward_mode":
+		x.ForwardMode = (ForwardMode)(value.Enum())
+	case "noble.forwarding.v1.GenesisState.signerless_fee":
+		x.SignerlessFee = value.Message().Interface().(*v1beta1.Coin)
+	case "noble.forwarding.v1.GenesisState.registration_fee":
+		x.RegistrationFee = value.Message().Interface().(*v1beta1.Coin)
+	case "noble.forwarding.v1.GenesisState.unpaid_registration_fees":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_16_map)
+		x.UnpaidRegistrationFees = *cmv.m
+	case "noble.forwarding.v1.GenesisState.history_sequence":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_17_map)
+		x.HistorySequence = *cmv.m
+	case "noble.forwarding.v1.GenesisState.forward_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.ForwardRecords = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.GenesisState.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_GenesisState_1_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "noble.forwarding.v1.GenesisState.num_of_accounts":
+		if x.NumOfAccounts == nil {
+			x.NumOfAccounts = make(map[string]uint64)
+		}
+		value := &_GenesisState_2_map{m: &x.NumOfAccounts}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.num_of_forwards":
+		if x.NumOfForwards == nil {
+			x.NumOfForwards = make(map[string]uint64)
+		}
+		value := &_GenesisState_3_map{m: &x.NumOfForwards}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.total_forwarded":
+		if x.TotalForwarded == nil {
+			x.TotalForwarded = make(map[string]string)
+		}
+		value := &_GenesisState_4_map{m: &x.TotalForwarded}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.account_stats":
+		if x.AccountStats == nil {
+			x.AccountStats = make(map[string]*AccountStats)
+		}
+		value := &_GenesisState_6_map{m: &x.AccountStats}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.stats_buckets":
+		if x.StatsBuckets == nil {
+			x.StatsBuckets = []*StatsBucket{}
+		}
+		value := &_GenesisState_8_list{list: &x.StatsBuckets}
+		return protoreflect.ValueOfList(value)
+	case "noble.forwarding.v1.GenesisState.chain_channels":
+		if x.ChainChannels == nil {
+			x.ChainChannels = make(map[string]string)
+		}
+		value := &_GenesisState_9_map{m: &x.ChainChannels}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.num_of_registration_failures":
+		if x.NumOfRegistrationFailures == nil {
+			x.NumOfRegistrationFailures = make(map[string]uint64)
+		}
+		value := &_GenesisState_11_map{m: &x.NumOfRegistrationFailures}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.in_flight_packets":
+		if x.InFlightPackets == nil {
+			x.InFlightPackets = []*InFlightPacket{}
+		}
+		value := &_GenesisState_12_list{list: &x.InFlightPackets}
+		return protoreflect.ValueOfList(value)
+	case "noble.forwarding.v1.GenesisState.signerless_fee":
+		if x.SignerlessFee == nil {
+			x.SignerlessFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SignerlessFee.ProtoReflect())
+	case "noble.forwarding.v1.GenesisState.registration_fee":
+		if x.RegistrationFee == nil {
+			x.RegistrationFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RegistrationFee.ProtoReflect())
+	case "noble.forwarding.v1.GenesisState.unpaid_registration_fees":
+		if x.UnpaidRegistrationFees == nil {
+			x.UnpaidRegistrationFees = make(map[string]*v1beta1.Coin)
+		}
+		value := &_GenesisState_16_map{m: &x.UnpaidRegistrationFees}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.history_sequence":
+		if x.HistorySequence == nil {
+			x.HistorySequence = make(map[string]uint64)
+		}
+		value := &_GenesisState_17_map{m: &x.HistorySequence}
+		return protoreflect.ValueOfMap(value)
+	case "noble.forwarding.v1.GenesisState.forward_records":
+		if x.ForwardRecords == nil {
+			x.ForwardRecords = []*ForwardRecordEntry{}
+		}
+		value := &_GenesisState_18_list{list: &x.ForwardRecords}
+		return protoreflect.ValueOfList(value)
+	case "noble.forwarding.v1.GenesisState.history_retention":
+		panic(fmt.Errorf("field history_retention of message noble.forwarding.v1.GenesisState is not mutable"))
+	case "noble.forwarding.v1.GenesisState.stats_retention":
+		panic(fmt.Errorf("field stats_retention of message noble.forwarding.v1.GenesisState is not mutable"))
+	case "noble.forwarding.v1.GenesisState.registration_mode":
+		panic(fmt.Errorf("field registration_mode of message noble.forwarding.v1.GenesisState is not mutable"))
+	case "noble.forwarding.v1.GenesisState.forward_mode":
+		panic(fmt.Errorf("field forward_mode of message noble.forwarding.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.GenesisState.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
+	case "noble.forwarding.v1.GenesisState.num_of_accounts":
+		m := make(map[string]uint64)
+		return protoreflect.ValueOfMap(&_GenesisState_2_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.num_of_forwards":
+		m := make(map[string]uint64)
+		return protoreflect.ValueOfMap(&_GenesisState_3_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.total_forwarded":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_4_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.history_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.forwarding.v1.GenesisState.account_stats":
+		m := make(map[string]*AccountStats)
+		return protoreflect.ValueOfMap(&_GenesisState_6_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.stats_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.forwarding.v1.GenesisState.stats_buckets":
+		list := []*StatsBucket{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "noble.forwarding.v1.GenesisState.chain_channels":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_9_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.registration_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "noble.forwarding.v1.GenesisState.num_of_registration_failures":
+		m := make(map[string]uint64)
+		return protoreflect.ValueOfMap(&_GenesisState_11_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.in_flight_packets":
+		list := []*InFlightPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "noble.forwarding.v1.GenesisState.forward_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "noble.forwarding.v1.GenesisState.signerless_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.forwarding.v1.GenesisState.registration_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.forwarding.v1.GenesisState.unpaid_registration_fees":
+		m := make(map[string]*v1beta1.Coin)
+		return protoreflect.ValueOfMap(&_GenesisState_16_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.history_sequence":
+		m := make(map[string]uint64)
+		return protoreflect.ValueOfMap(&_GenesisState_17_map{m: &m})
+	case "noble.forwarding.v1.GenesisState.forward_records":
+		list := []*ForwardRecordEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NumOfAccounts) > 0 {
+			SiZeMaP := func(k string, v uint64) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.NumOfAccounts))
+				for k := range x.NumOfAccounts {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.NumOfAccounts[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.NumOfAccounts {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.NumOfForwards) > 0 {
+			SiZeMaP := func(k string, v uint64) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.NumOfForwards))
+				for k := range x.NumOfForwards {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.NumOfForwards[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.NumOfForwards {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.TotalForwarded) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.TotalForwarded))
+				for k := range x.TotalForwarded {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.TotalForwarded[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.TotalForwarded {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.HistoryRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistoryRetention))
+		}
+		if len(x.AccountStats) > 0 {
+			SiZeMaP := func(k string, v *AccountStats) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.AccountStats))
+				for k := range x.AccountStats {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.AccountStats[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.AccountStats {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.StatsRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.StatsRetention))
+		}
+		if len(x.StatsBuckets) > 0 {
+			for _, e := range x.StatsBuckets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChainChannels) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.ChainChannels))
+				for k := range x.ChainChannels {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.ChainChannels[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.ChainChannels {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.RegistrationMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.RegistrationMode))
+		}
+		if len(x.NumOfRegistrationFailures) > 0 {
+			SiZeMaP := func(k string, v uint64) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.NumOfRegistrationFailures))
+				for k := range x.NumOfRegistrationFailures {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.NumOfRegistrationFailures[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.NumOfRegistrationFailures {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.InFlightPackets) > 0 {
+			for _, e := range x.InFlightPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ForwardMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ForwardMode))
+		}
+		if x.SignerlessFee != nil {
+			l = options.Size(x.SignerlessFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RegistrationFee != nil {
+			l = options.Size(x.RegistrationFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.UnpaidRegistrationFees) > 0 {
+			SiZeMaP := func(k string, v *v1beta1.Coin) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.UnpaidRegistrationFees))
+				for k := range x.UnpaidRegistrationFees {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.UnpaidRegistrationFees[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.UnpaidRegistrationFees {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.HistorySequence) > 0 {
+			SiZeMaP := func(k string, v uint64) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.HistorySequence))
+				for k := range x.HistorySequence {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.HistorySequence[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.HistorySequence {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.ForwardRecords) > 0 {
+			for _, e := range x.ForwardRecords {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ForwardRecords) > 0 {
+			for iNdEx := len(x.ForwardRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForwardRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.HistorySequence) > 0 {
+			MaRsHaLmAp := func(k string, v uint64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForHistorySequence := make([]string, 0, len(x.HistorySequence))
+				for k := range x.HistorySequence {
+					keysForHistorySequence = append(keysForHistorySequence, string(k))
+				}
+				sort.Slice(keysForHistorySequence, func(i, j int) bool {
+					return keysForHistorySequence[i] < keysForHistorySequence[j]
+				})
+				for iNdEx := len(keysForHistorySequence) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.HistorySequence[string(keysForHistorySequence[iNdEx])]
+					out, err := MaRsHaLmAp(keysForHistorySequence[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.HistorySequence {
+					v := x.HistorySequence[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.UnpaidRegistrationFees) > 0 {
+			MaRsHaLmAp := func(k string, v *v1beta1.Coin) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForUnpaidRegistrationFees := make([]string, 0, len(x.UnpaidRegistrationFees))
+				for k := range x.UnpaidRegistrationFees {
+					keysForUnpaidRegistrationFees = append(keysForUnpaidRegistrationFees, string(k))
+				}
+				sort.Slice(keysForUnpaidRegistrationFees, func(i, j int) bool {
+					return keysForUnpaidRegistrationFees[i] < keysForUnpaidRegistrationFees[j]
+				})
+				for iNdEx := len(keysForUnpaidRegistrationFees) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.UnpaidRegistrationFees[string(keysForUnpaidRegistrationFees[iNdEx])]
+					out, err := MaRsHaLmAp(keysForUnpaidRegistrationFees[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.UnpaidRegistrationFees {
+					v := x.UnpaidRegistrationFees[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.RegistrationFee != nil {
+			encoded, err := options.Marshal(x.RegistrationFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.SignerlessFee != nil {
+			encoded, err := options.Marshal(x.SignerlessFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.ForwardMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ForwardMode))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.InFlightPackets) > 0 {
+			for iNdEx := len(x.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InFlightPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.NumOfRegistrationFailures) > 0 {
+			MaRsHaLmAp := func(k string, v uint64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x5a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForNumOfRegistrationFailures := make([]string, 0, len(x.NumOfRegistrationFailures))
+				for k := range x.NumOfRegistrationFailures {
+					keysForNumOfRegistrationFailures = append(keysForNumOfRegistrationFailures, string(k))
+				}
+				sort.Slice(keysForNumOfRegistrationFailures, func(i, j int) bool {
+					return keysForNumOfRegistrationFailures[i] < keysForNumOfRegistrationFailures[j]
+				})
+				for iNdEx := len(keysForNumOfRegistrationFailures) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.NumOfRegistrationFailures[string(keysForNumOfRegistrationFailures[iNdEx])]
+					out, err := MaRsHaLmAp(keysForNumOfRegistrationFailures[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.NumOfRegistrationFailures {
+					v := x.NumOfRegistrationFailures[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.RegistrationMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RegistrationMode))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.ChainChannels) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x4a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForChainChannels := make([]string, 0, len(x.ChainChannels))
+				for k := range x.ChainChannels {
+					keysForChainChannels = append(keysForChainChannels, string(k))
+				}
+				sort.Slice(keysForChainChannels, func(i, j int) bool {
+					return keysForChainChannels[i] < keysForChainChannels[j]
+				})
+				for iNdEx := len(keysForChainChannels) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.ChainChannels[string(keysForChainChannels[iNdEx])]
+					out, err := MaRsHaLmAp(keysForChainChannels[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.ChainChannels {
+					v := x.ChainChannels[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.StatsBuckets) > 0 {
+			for iNdEx := len(x.StatsBuckets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StatsBuckets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.StatsRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StatsRetention))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.AccountStats) > 0 {
+			MaRsHaLmAp := func(k string, v *AccountStats) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x32
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForAccountStats := make([]string, 0, len(x.AccountStats))
+				for k := range x.AccountStats {
+					keysForAccountStats = append(keysForAccountStats, string(k))
+				}
+				sort.Slice(keysForAccountStats, func(i, j int) bool {
+					return keysForAccountStats[i] < keysForAccountStats[j]
+				})
+				for iNdEx := len(keysForAccountStats) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.AccountStats[string(keysForAccountStats[iNdEx])]
+					out, err := MaRsHaLmAp(keysForAccountStats[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.AccountStats {
+					v := x.AccountStats[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.HistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoryRetention))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.TotalForwarded) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x22
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForTotalForwarded := make([]string, 0, len(x.TotalForwarded))
+				for k := range x.TotalForwarded {
+					keysForTotalForwarded = append(keysForTotalForwarded, string(k))
+				}
+				sort.Slice(keysForTotalForwarded, func(i, j int) bool {
+					return keysForTotalForwarded[i] < keysForTotalForwarded[j]
+				})
+				for iNdEx := len(keysForTotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.TotalForwarded[string(keysForTotalForwarded[iNdEx])]
+					out, err := MaRsHaLmAp(keysForTotalForwarded[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.TotalForwarded {
+					v := x.TotalForwarded[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.NumOfForwards) > 0 {
+			MaRsHaLmAp := func(k string, v uint64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForNumOfForwards := make([]string, 0, len(x.NumOfForwards))
+				for k := range x.NumOfForwards {
+					keysForNumOfForwards = append(keysForNumOfForwards, string(k))
+				}
+				sort.Slice(keysForNumOfForwards, func(i, j int) bool {
+					return keysForNumOfForwards[i] < keysForNumOfForwards[j]
+				})
+				for iNdEx := len(keysForNumOfForwards) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.NumOfForwards[string(keysForNumOfForwards[iNdEx])]
+					out, err := MaRsHaLmAp(keysForNumOfForwards[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
 			} else {
-				for k, v := range x.NumOfAccounts {
-					SiZeMaP(k, v)
+				for k := range x.NumOfForwards {
+					v := x.NumOfForwards[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.NumOfAccounts) > 0 {
+			MaRsHaLmAp := func(k string, v uint64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x12
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForNumOfAccounts := make([]string, 0, len(x.NumOfAccounts))
+				for k := range x.NumOfAccounts {
+					keysForNumOfAccounts = append(keysForNumOfAccounts, string(k))
+				}
+				sort.Slice(keysForNumOfAccounts, func(i, j int) bool {
+					return keysForNumOfAccounts[i] < keysForNumOfAccounts[j]
+				})
+				for iNdEx := len(keysForNumOfAccounts) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.NumOfAccounts[string(keysForNumOfAccounts[iNdEx])]
+					out, err := MaRsHaLmAp(keysForNumOfAccounts[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.NumOfAccounts {
+					v := x.NumOfAccounts[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NumOfAccounts == nil {
+					x.NumOfAccounts = make(map[string]uint64)
+				}
+				var mapkey string
+				var mapvalue uint64
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.NumOfAccounts[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NumOfForwards == nil {
+					x.NumOfForwards = make(map[string]uint64)
+				}
+				var mapkey string
+				var mapvalue uint64
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.NumOfForwards[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalForwarded == nil {
+					x.TotalForwarded = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
 				}
-			}
-		}
-		if len(x.NumOfForwards) > 0 {
-			SiZeMaP := func(k string, v uint64) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.NumOfForwards))
-				for k := range x.NumOfForwards {
-					sortme = append(sortme, k)
+				x.TotalForwarded[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
 				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.NumOfForwards[k]
-					SiZeMaP(k, v)
+				x.HistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoryRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			} else {
-				for k, v := range x.NumOfForwards {
-					SiZeMaP(k, v)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountStats", wireType)
 				}
-			}
-		}
-		if len(x.TotalForwarded) > 0 {
-			SiZeMaP := func(k string, v string) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.TotalForwarded))
-				for k := range x.TotalForwarded {
-					sortme = append(sortme, k)
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.TotalForwarded[k]
-					SiZeMaP(k, v)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			} else {
-				for k, v := range x.TotalForwarded {
-					SiZeMaP(k, v)
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.TotalForwarded) > 0 {
-			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x22
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForTotalForwarded := make([]string, 0, len(x.TotalForwarded))
-				for k := range x.TotalForwarded {
-					keysForTotalForwarded = append(keysForTotalForwarded, string(k))
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				sort.Slice(keysForTotalForwarded, func(i, j int) bool {
-					return keysForTotalForwarded[i] < keysForTotalForwarded[j]
-				})
-				for iNdEx := len(keysForTotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.TotalForwarded[string(keysForTotalForwarded[iNdEx])]
-					out, err := MaRsHaLmAp(keysForTotalForwarded[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
+				if x.AccountStats == nil {
+					x.AccountStats = make(map[string]*AccountStats)
 				}
-			} else {
-				for k := range x.TotalForwarded {
-					v := x.TotalForwarded[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+				var mapkey string
+				var mapvalue *AccountStats
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-				}
-			}
-		}
-		if len(x.NumOfForwards) > 0 {
-			MaRsHaLmAp := func(k string, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x1a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForNumOfForwards := make([]string, 0, len(x.NumOfForwards))
-				for k := range x.NumOfForwards {
-					keysForNumOfForwards = append(keysForNumOfForwards, string(k))
-				}
-				sort.Slice(keysForNumOfForwards, func(i, j int) bool {
-					return keysForNumOfForwards[i] < keysForNumOfForwards[j]
-				})
-				for iNdEx := len(keysForNumOfForwards) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.NumOfForwards[string(keysForNumOfForwards[iNdEx])]
-					out, err := MaRsHaLmAp(keysForNumOfForwards[iNdEx], v)
-					if err != nil {
-						return out, err
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &AccountStats{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
 					}
 				}
-			} else {
-				for k := range x.NumOfForwards {
-					v := x.NumOfForwards[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+				x.AccountStats[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatsRetention", wireType)
+				}
+				x.StatsRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StatsRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			}
-		}
-		if len(x.NumOfAccounts) > 0 {
-			MaRsHaLmAp := func(k string, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x12
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForNumOfAccounts := make([]string, 0, len(x.NumOfAccounts))
-				for k := range x.NumOfAccounts {
-					keysForNumOfAccounts = append(keysForNumOfAccounts, string(k))
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatsBuckets", wireType)
 				}
-				sort.Slice(keysForNumOfAccounts, func(i, j int) bool {
-					return keysForNumOfAccounts[i] < keysForNumOfAccounts[j]
-				})
-				for iNdEx := len(keysForNumOfAccounts) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.NumOfAccounts[string(keysForNumOfAccounts[iNdEx])]
-					out, err := MaRsHaLmAp(keysForNumOfAccounts[iNdEx], v)
-					if err != nil {
-						return out, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-				}
-			} else {
-				for k := range x.NumOfAccounts {
-					v := x.NumOfAccounts[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			}
-		}
-		if len(x.AllowedDenoms) > 0 {
-			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AllowedDenoms[iNdEx])
-				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.StatsBuckets = append(x.StatsBuckets, &StatsBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StatsBuckets[len(x.StatsBuckets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainChannels", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -967,27 +3016,141 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 2:
+				if x.ChainChannels == nil {
+					x.ChainChannels = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.ChainChannels[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationMode", wireType)
+				}
+				x.RegistrationMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RegistrationMode |= RegistrationMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfRegistrationFailures", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1014,8 +3177,8 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.NumOfAccounts == nil {
-					x.NumOfAccounts = make(map[string]uint64)
+				if x.NumOfRegistrationFailures == nil {
+					x.NumOfRegistrationFailures = make(map[string]uint64)
 				}
 				var mapkey string
 				var mapvalue uint64
@@ -1096,11 +3259,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						iNdEx += skippy
 					}
 				}
-				x.NumOfAccounts[mapkey] = mapvalue
+				x.NumOfRegistrationFailures[mapkey] = mapvalue
 				iNdEx = postIndex
-			case 3:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1127,11 +3290,136 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.NumOfForwards == nil {
-					x.NumOfForwards = make(map[string]uint64)
+				x.InFlightPackets = append(x.InFlightPackets, &InFlightPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InFlightPackets[len(x.InFlightPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardMode", wireType)
+				}
+				x.ForwardMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ForwardMode |= ForwardMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignerlessFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SignerlessFee == nil {
+					x.SignerlessFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignerlessFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RegistrationFee == nil {
+					x.RegistrationFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RegistrationFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnpaidRegistrationFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnpaidRegistrationFees == nil {
+					x.UnpaidRegistrationFees = make(map[string]*v1beta1.Coin)
 				}
 				var mapkey string
-				var mapvalue uint64
+				var mapvalue *v1beta1.Coin
 				for iNdEx < postIndex {
 					entryPreIndex := iNdEx
 					var wire uint64
@@ -1180,6 +3468,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 						iNdEx = postStringIndexmapkey
 					} else if fieldNum == 2 {
+						var mapmsglen int
 						for shift := uint(0); ; shift += 7 {
 							if shift >= 64 {
 								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1189,11 +3478,26 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 							b := dAtA[iNdEx]
 							iNdEx++
-							mapvalue |= uint64(b&0x7F) << shift
+							mapmsglen |= int(b&0x7F) << shift
 							if b < 0x80 {
 								break
 							}
 						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &v1beta1.Coin{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
 					} else {
 						iNdEx = entryPreIndex
 						skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1209,11 +3513,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						iNdEx += skippy
 					}
 				}
-				x.NumOfForwards[mapkey] = mapvalue
+				x.UnpaidRegistrationFees[mapkey] = mapvalue
 				iNdEx = postIndex
-			case 4:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistorySequence", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1240,11 +3544,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TotalForwarded == nil {
-					x.TotalForwarded = make(map[string]string)
+				if x.HistorySequence == nil {
+					x.HistorySequence = make(map[string]uint64)
 				}
 				var mapkey string
-				var mapvalue string
+				var mapvalue uint64
 				for iNdEx < postIndex {
 					entryPreIndex := iNdEx
 					var wire uint64
@@ -1293,7 +3597,6 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 						iNdEx = postStringIndexmapkey
 					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
 						for shift := uint(0); ; shift += 7 {
 							if shift >= 64 {
 								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1303,24 +3606,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 							b := dAtA[iNdEx]
 							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
+							mapvalue |= uint64(b&0x7F) << shift
 							if b < 0x80 {
 								break
 							}
 						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
 					} else {
 						iNdEx = entryPreIndex
 						skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1336,7 +3626,41 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						iNdEx += skippy
 					}
 				}
-				x.TotalForwarded[mapkey] = mapvalue
+				x.HistorySequence[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForwardRecords = append(x.ForwardRecords, &ForwardRecordEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForwardRecords[len(x.ForwardRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1411,10 +3735,24 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllowedDenoms  []string          `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
-	NumOfAccounts  map[string]uint64 `protobuf:"bytes,2,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	NumOfForwards  map[string]uint64 `protobuf:"bytes,3,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	TotalForwarded map[string]string `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AllowedDenoms             []string                 `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	NumOfAccounts             map[string]uint64        `protobuf:"bytes,2,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfForwards             map[string]uint64        `protobuf:"bytes,3,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TotalForwarded            map[string]string        `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistoryRetention          uint64                   `protobuf:"varint,5,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	AccountStats              map[string]*AccountStats `protobuf:"bytes,6,rep,name=account_stats,json=accountStats,proto3" json:"account_stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StatsRetention            uint64                   `protobuf:"varint,7,opt,name=stats_retention,json=statsRetention,proto3" json:"stats_retention,omitempty"`
+	StatsBuckets              []*StatsBucket           `protobuf:"bytes,8,rep,name=stats_buckets,json=statsBuckets,proto3" json:"stats_buckets,omitempty"`
+	ChainChannels             map[string]string        `protobuf:"bytes,9,rep,name=chain_channels,json=chainChannels,proto3" json:"chain_channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RegistrationMode          RegistrationMode         `protobuf:"varint,10,opt,name=registration_mode,json=registrationMode,proto3,enum=noble.forwarding.v1.RegistrationMode" json:"registration_mode,omitempty"`
+	NumOfRegistrationFailures map[string]uint64        `protobuf:"bytes,11,rep,name=num_of_registration_failures,json=numOfRegistrationFailures,proto3" json:"num_of_registration_failures,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	InFlightPackets           []*InFlightPacket        `protobuf:"bytes,12,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets,omitempty"`
+	ForwardMode               ForwardMode              `protobuf:"varint,13,opt,name=forward_mode,json=forwardMode,proto3,enum=noble.forwarding.v1.ForwardMode" json:"forward_mode,omitempty"`
+	SignerlessFee             *v1beta1.Coin            `protobuf:"bytes,14,opt,name=signerless_fee,json=signerlessFee,proto3" json:"signerless_fee,omitempty"`
+	RegistrationFee           *v1beta1.Coin            `protobuf:"bytes,15,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
+	UnpaidRegistrationFees    map[string]*v1beta1.Coin `protobuf:"bytes,16,rep,name=unpaid_registration_fees,json=unpaidRegistrationFees,proto3" json:"unpaid_registration_fees,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySequence           map[string]uint64        `protobuf:"bytes,17,rep,name=history_sequence,json=historySequence,proto3" json:"history_sequence,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ForwardRecords            []*ForwardRecordEntry    `protobuf:"bytes,18,rep,name=forward_records,json=forwardRecords,proto3" json:"forward_records,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1465,61 +3803,271 @@ func (x *GenesisState) GetTotalForwarded() map[string]string {
 	return nil
 }
 
+func (x *GenesisState) GetHistoryRetention() uint64 {
+	if x != nil {
+		return x.HistoryRetention
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAccountStats() map[string]*AccountStats {
+	if x != nil {
+		return x.AccountStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStatsRetention() uint64 {
+	if x != nil {
+		return x.StatsRetention
+	}
+	return 0
+}
+
+func (x *GenesisState) GetStatsBuckets() []*StatsBucket {
+	if x != nil {
+		return x.StatsBuckets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChainChannels() map[string]string {
+	if x != nil {
+		return x.ChainChannels
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRegistrationMode() RegistrationMode {
+	if x != nil {
+		return x.RegistrationMode
+	}
+	return RegistrationMode_REGISTRATION_MODE_UNSPECIFIED
+}
+
+func (x *GenesisState) GetNumOfRegistrationFailures() map[string]uint64 {
+	if x != nil {
+		return x.NumOfRegistrationFailures
+	}
+	return nil
+}
+
+func (x *GenesisState) GetInFlightPackets() []*InFlightPacket {
+	if x != nil {
+		return x.InFlightPackets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetForwardMode() ForwardMode {
+	if x != nil {
+		return x.ForwardMode
+	}
+	return ForwardMode_FORWARD_MODE_UNSPECIFIED
+}
+
+func (x *GenesisState) GetSignerlessFee() *v1beta1.Coin {
+	if x != nil {
+		return x.SignerlessFee
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRegistrationFee() *v1beta1.Coin {
+	if x != nil {
+		return x.RegistrationFee
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUnpaidRegistrationFees() map[string]*v1beta1.Coin {
+	if x != nil {
+		return x.UnpaidRegistrationFees
+	}
+	return nil
+}
+
+func (x *GenesisState) GetHistorySequence() map[string]uint64 {
+	if x != nil {
+		return x.HistorySequence
+	}
+	return nil
+}
+
+func (x *GenesisState) GetForwardRecords() []*ForwardRecordEntry {
+	if x != nil {
+		return x.ForwardRecords
+	}
+	return nil
+}
+
 var File_noble_forwarding_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_noble_forwarding_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
 	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x22, 0x98, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73,
-	0x12, 0x5c, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4e, 0x75, 0x6d,
-	0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0d, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x5c,
-	0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x4f, 0x66,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6e,
-	0x75, 0x6d, 0x4f, 0x66, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5e, 0x0a, 0x0f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x21, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x10, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12,
+	0x5c, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x4f,
+	0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x5c, 0x0a,
+	0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x4f, 0x66, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5e, 0x0a, 0x0f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x5b,
+	0x0a, 0x0e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x11, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x10, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x81, 0x01, 0x0a, 0x1c, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x19, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x11, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x46, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x6c, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x6c, 0x65, 0x73, 0x73, 0x46, 0x65, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x12, 0x7d, 0x0a, 0x18, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f,
 	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
-	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x1a, 0x40, 0x0a, 0x12,
-	0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40,
-	0x0a, 0x12, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x41, 0x0a, 0x13, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x42, 0xe1, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
-	0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x46,
-	0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f,
-	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x75, 0x6e, 0x70, 0x61,
+	0x69, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x61, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x40, 0x0a,
+	0x12, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x40, 0x0a, 0x12, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4c, 0x0a, 0x1e, 0x4e, 0x75,
+	0x6d, 0x4f, 0x66, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x64, 0x0a, 0x1b, 0x55, 0x6e, 0x70, 0x61,
+	0x69, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42,
+	0x0a, 0x14, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0xe1, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x46, 0x58,
+	0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e,
+	0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1534,22 +4082,48 @@ func file_noble_forwarding_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_noble_forwarding_v1_genesis_proto_rawDescData
 }
 
-var file_noble_forwarding_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_noble_forwarding_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_noble_forwarding_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: noble.forwarding.v1.GenesisState
-	nil,                  // 1: noble.forwarding.v1.GenesisState.NumOfAccountsEntry
-	nil,                  // 2: noble.forwarding.v1.GenesisState.NumOfForwardsEntry
-	nil,                  // 3: noble.forwarding.v1.GenesisState.TotalForwardedEntry
+	(*GenesisState)(nil),       // 0: noble.forwarding.v1.GenesisState
+	nil,                        // 1: noble.forwarding.v1.GenesisState.NumOfAccountsEntry
+	nil,                        // 2: noble.forwarding.v1.GenesisState.NumOfForwardsEntry
+	nil,                        // 3: noble.forwarding.v1.GenesisState.TotalForwardedEntry
+	nil,                        // 4: noble.forwarding.v1.GenesisState.AccountStatsEntry
+	nil,                        // 5: noble.forwarding.v1.GenesisState.ChainChannelsEntry
+	nil,                        // 6: noble.forwarding.v1.GenesisState.NumOfRegistrationFailuresEntry
+	nil,                        // 7: noble.forwarding.v1.GenesisState.UnpaidRegistrationFeesEntry
+	nil,                        // 8: noble.forwarding.v1.GenesisState.HistorySequenceEntry
+	(*StatsBucket)(nil),        // 9: noble.forwarding.v1.StatsBucket
+	(RegistrationMode)(0),      // 10: noble.forwarding.v1.RegistrationMode
+	(*InFlightPacket)(nil),     // 11: noble.forwarding.v1.InFlightPacket
+	(ForwardMode)(0),           // 12: noble.forwarding.v1.ForwardMode
+	(*v1beta1.Coin)(nil),       // 13: cosmos.base.v1beta1.Coin
+	(*ForwardRecordEntry)(nil), // 14: noble.forwarding.v1.ForwardRecordEntry
+	(*AccountStats)(nil),       // 15: noble.forwarding.v1.AccountStats
 }
 var file_noble_forwarding_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: noble.forwarding.v1.GenesisState.num_of_accounts:type_name -> noble.forwarding.v1.GenesisState.NumOfAccountsEntry
-	2, // 1: noble.forwarding.v1.GenesisState.num_of_forwards:type_name -> noble.forwarding.v1.GenesisState.NumOfForwardsEntry
-	3, // 2: noble.forwarding.v1.GenesisState.total_forwarded:type_name -> noble.forwarding.v1.GenesisState.TotalForwardedEntry
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: noble.forwarding.v1.GenesisState.num_of_accounts:type_name -> noble.forwarding.v1.GenesisState.NumOfAccountsEntry
+	2,  // 1: noble.forwarding.v1.GenesisState.num_of_forwards:type_name -> noble.forwarding.v1.GenesisState.NumOfForwardsEntry
+	3,  // 2: noble.forwarding.v1.GenesisState.total_forwarded:type_name -> noble.forwarding.v1.GenesisState.TotalForwardedEntry
+	4,  // 3: noble.forwarding.v1.GenesisState.account_stats:type_name -> noble.forwarding.v1.GenesisState.AccountStatsEntry
+	9,  // 4: noble.forwarding.v1.GenesisState.stats_buckets:type_name -> noble.forwarding.v1.StatsBucket
+	5,  // 5: noble.forwarding.v1.GenesisState.chain_channels:type_name -> noble.forwarding.v1.GenesisState.ChainChannelsEntry
+	10, // 6: noble.forwarding.v1.GenesisState.registration_mode:type_name -> noble.forwarding.v1.RegistrationMode
+	6,  // 7: noble.forwarding.v1.GenesisState.num_of_registration_failures:type_name -> noble.forwarding.v1.GenesisState.NumOfRegistrationFailuresEntry
+	11, // 8: noble.forwarding.v1.GenesisState.in_flight_packets:type_name -> noble.forwarding.v1.InFlightPacket
+	12, // 9: noble.forwarding.v1.GenesisState.forward_mode:type_name -> noble.forwarding.v1.ForwardMode
+	13, // 10: noble.forwarding.v1.GenesisState.signerless_fee:type_name -> cosmos.base.v1beta1.Coin
+	13, // 11: noble.forwarding.v1.GenesisState.registration_fee:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 12: noble.forwarding.v1.GenesisState.unpaid_registration_fees:type_name -> noble.forwarding.v1.GenesisState.UnpaidRegistrationFeesEntry
+	8,  // 13: noble.forwarding.v1.GenesisState.history_sequence:type_name -> noble.forwarding.v1.GenesisState.HistorySequenceEntry
+	14, // 14: noble.forwarding.v1.GenesisState.forward_records:type_name -> noble.forwarding.v1.ForwardRecordEntry
+	15, // 15: noble.forwarding.v1.GenesisState.AccountStatsEntry.value:type_name -> noble.forwarding.v1.AccountStats
+	13, // 16: noble.forwarding.v1.GenesisState.UnpaidRegistrationFeesEntry.value:type_name -> cosmos.base.v1beta1.Coin
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_noble_forwarding_v1_genesis_proto_init() }
@@ -1557,6 +4131,10 @@ func file_noble_forwarding_v1_genesis_proto_init() {
 	if File_noble_forwarding_v1_genesis_proto != nil {
 		return
 	}
+	file_noble_forwarding_v1_account_proto_init()
+	file_noble_forwarding_v1_history_proto_init()
+	file_noble_forwarding_v1_packet_proto_init()
+	file_noble_forwarding_v1_stats_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_forwarding_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1577,7 +4155,7 @@ func file_noble_forwarding_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_forwarding_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,9 +1,31 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
 // Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
 package forwardingv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -12,11 +34,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_RegisterAccountData_4_list)(nil)
+
+type _RegisterAccountData_4_list struct {
+	list *[]*MemoEntry
+}
+
+func (x *_RegisterAccountData_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RegisterAccountData_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RegisterAccountData_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MemoEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RegisterAccountData_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MemoEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RegisterAccountData_4_list) AppendMutable() protoreflect.Value {
+	v := new(MemoEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RegisterAccountData_4_list) NewElement() protoreflect.Value {
+	v := new(MemoEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_RegisterAccountData           protoreflect.MessageDescriptor
 	fd_RegisterAccountData_recipient protoreflect.FieldDescriptor
 	fd_RegisterAccountData_channel   protoreflect.FieldDescriptor
 	fd_RegisterAccountData_fallback  protoreflect.FieldDescriptor
+	fd_RegisterAccountData_memos     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +99,7 @@ func init() {
 	fd_RegisterAccountData_recipient = md_RegisterAccountData.Fields().ByName("recipient")
 	fd_RegisterAccountData_channel = md_RegisterAccountData.Fields().ByName("channel")
 	fd_RegisterAccountData_fallback = md_RegisterAccountData.Fields().ByName("fallback")
+	fd_RegisterAccountData_memos = md_RegisterAccountData.Fields().ByName("memos")
 }
 
 var _ protoreflect.Message = (*fastReflection_RegisterAccountData)(nil)
@@ -110,6 +185,12 @@ func (x *fastReflection_RegisterAccountData) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.Memos) != 0 {
+		value := protoreflect.ValueOfList(&_RegisterAccountData_4_list{list: &x.Memos})
+		if !f(fd_RegisterAccountData_memos, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -131,6 +212,8 @@ func (x *fastReflection_RegisterAccountData) Has(fd protoreflect.FieldDescriptor
 		return x.Channel != ""
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		return x.Fallback != ""
+	case "noble.forwarding.v1.RegisterAccountData.memos":
+		return len(x.Memos) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -153,6 +236,8 @@ func (x *fastReflection_RegisterAccountData) Clear(fd protoreflect.FieldDescript
 		x.Channel = ""
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		x.Fallback = ""
+	case "noble.forwarding.v1.RegisterAccountData.memos":
+		x.Memos = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -178,6 +263,12 @@ func (x *fastReflection_RegisterAccountData) Get(descriptor protoreflect.FieldDe
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		value := x.Fallback
 		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.RegisterAccountData.memos":
+		if len(x.Memos) == 0 {
+			return protoreflect.ValueOfList(&_RegisterAccountData_4_list{})
+		}
+		listValue := &_RegisterAccountData_4_list{list: &x.Memos}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -204,6 +295,10 @@ func (x *fastReflection_RegisterAccountData) Set(fd protoreflect.FieldDescriptor
 		x.Channel = value.Interface().(string)
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		x.Fallback = value.Interface().(string)
+	case "noble.forwarding.v1.RegisterAccountData.memos":
+		lv := value.List()
+		clv := lv.(*_RegisterAccountData_4_list)
+		x.Memos = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -224,6 +319,12 @@ func (x *fastReflection_RegisterAccountData) Set(fd protoreflect.FieldDescriptor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_RegisterAccountData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "noble.forwarding.v1.RegisterAccountData.memos":
+		if x.Memos == nil {
+			x.Memos = []*MemoEntry{}
+		}
+		value := &_RegisterAccountData_4_list{list: &x.Memos}
+		return protoreflect.ValueOfList(value)
 	case "noble.forwarding.v1.RegisterAccountData.recipient":
 		panic(fmt.Errorf("field recipient of message noble.forwarding.v1.RegisterAccountData is not mutable"))
 	case "noble.forwarding.v1.RegisterAccountData.channel":
@@ -249,6 +350,9 @@ func (x *fastReflection_RegisterAccountData) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.RegisterAccountData.memos":
+		list := []*MemoEntry{}
+		return protoreflect.ValueOfList(&_RegisterAccountData_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -330,6 +434,12 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Memos) > 0 {
+			for _, e := range x.Memos {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -359,6 +469,22 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Memos) > 0 {
+			for iNdEx := len(x.Memos) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Memos[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.Fallback) > 0 {
 			i -= len(x.Fallback)
 			copy(dAtA[i:], x.Fallback)
@@ -525,6 +651,40 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 				}
 				x.Fallback = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memos = append(x.Memos, &MemoEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Memos[len(x.Memos)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -561,25 +721,33 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_RegisterAccountMemo       protoreflect.MessageDescriptor
-	fd_RegisterAccountMemo_noble protoreflect.FieldDescriptor
+	md_RegisterAccountAcknowledgement          protoreflect.MessageDescriptor
+	fd_RegisterAccountAcknowledgement_version  protoreflect.FieldDescriptor
+	fd_RegisterAccountAcknowledgement_address  protoreflect.FieldDescriptor
+	fd_RegisterAccountAcknowledgement_created  protoreflect.FieldDescriptor
+	fd_RegisterAccountAcknowledgement_channel  protoreflect.FieldDescriptor
+	fd_RegisterAccountAcknowledgement_fallback protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_forwarding_v1_packet_proto_init()
-	md_RegisterAccountMemo = File_noble_forwarding_v1_packet_proto.Messages().ByName("RegisterAccountMemo")
-	fd_RegisterAccountMemo_noble = md_RegisterAccountMemo.Fields().ByName("noble")
+	md_RegisterAccountAcknowledgement = File_noble_forwarding_v1_packet_proto.Messages().ByName("RegisterAccountAcknowledgement")
+	fd_RegisterAccountAcknowledgement_version = md_RegisterAccountAcknowledgement.Fields().ByName("version")
+	fd_RegisterAccountAcknowledgement_address = md_RegisterAccountAcknowledgement.Fields().ByName("address")
+	fd_RegisterAccountAcknowledgement_created = md_RegisterAccountAcknowledgement.Fields().ByName("created")
+	fd_RegisterAccountAcknowledgement_channel = md_RegisterAccountAcknowledgement.Fields().ByName("channel")
+	fd_RegisterAccountAcknowledgement_fallback = md_RegisterAccountAcknowledgement.Fields().ByName("fallback")
 }
 
-var _ protoreflect.Message = (*fastReflection_RegisterAccountMemo)(nil)
+var _ protoreflect.Message = (*fastReflection_RegisterAccountAcknowledgement)(nil)
 
-type fastReflection_RegisterAccountMemo RegisterAccountMemo
+type fastReflection_RegisterAccountAcknowledgement RegisterAccountAcknowledgement
 
-func (x *RegisterAccountMemo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RegisterAccountMemo)(x)
+func (x *RegisterAccountAcknowledgement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RegisterAccountAcknowledgement)(x)
 }
 
-func (x *RegisterAccountMemo) slowProtoReflect() protoreflect.Message {
+func (x *RegisterAccountAcknowledgement) slowProtoReflect() protoreflect.Message {
 	mi := &file_noble_forwarding_v1_packet_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,43 +759,43 @@ func (x *RegisterAccountMemo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RegisterAccountMemo_messageType fastReflection_RegisterAccountMemo_messageType
-var _ protoreflect.MessageType = fastReflection_RegisterAccountMemo_messageType{}
+var _fastReflection_RegisterAccountAcknowledgement_messageType fastReflection_RegisterAccountAcknowledgement_messageType
+var _ protoreflect.MessageType = fastReflection_RegisterAccountAcknowledgement_messageType{}
 
-type fastReflection_RegisterAccountMemo_messageType struct{}
+type fastReflection_RegisterAccountAcknowledgement_messageType struct{}
 
-func (x fastReflection_RegisterAccountMemo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RegisterAccountMemo)(nil)
+func (x fastReflection_RegisterAccountAcknowledgement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RegisterAccountAcknowledgement)(nil)
 }
-func (x fastReflection_RegisterAccountMemo_messageType) New() protoreflect.Message {
-	return new(fastReflection_RegisterAccountMemo)
+func (x fastReflection_RegisterAccountAcknowledgement_messageType) New() protoreflect.Message {
+	return new(fastReflection_RegisterAccountAcknowledgement)
 }
-func (x fastReflection_RegisterAccountMemo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RegisterAccountMemo
+func (x fastReflection_RegisterAccountAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RegisterAccountAcknowledgement
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RegisterAccountMemo) Descriptor() protoreflect.MessageDescriptor {
-	return md_RegisterAccountMemo
+func (x *fastReflection_RegisterAccountAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
+	return md_RegisterAccountAcknowledgement
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RegisterAccountMemo) Type() protoreflect.MessageType {
-	return _fastReflection_RegisterAccountMemo_messageType
+func (x *fastReflection_RegisterAccountAcknowledgement) Type() protoreflect.MessageType {
+	return _fastReflection_RegisterAccountAcknowledgement_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RegisterAccountMemo) New() protoreflect.Message {
-	return new(fastReflection_RegisterAccountMemo)
+func (x *fastReflection_RegisterAccountAcknowledgement) New() protoreflect.Message {
+	return new(fastReflection_RegisterAccountAcknowledgement)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RegisterAccountMemo) Interface() protoreflect.ProtoMessage {
-	return (*RegisterAccountMemo)(x)
+func (x *fastReflection_RegisterAccountAcknowledgement) Interface() protoreflect.ProtoMessage {
+	return (*RegisterAccountAcknowledgement)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -635,10 +803,34 @@ func (x *fastReflection_RegisterAccountMemo) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RegisterAccountMemo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Noble != nil {
-		value := protoreflect.ValueOfMessage(x.Noble.ProtoReflect())
-		if !f(fd_RegisterAccountMemo_noble, value) {
+func (x *fastReflection_RegisterAccountAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Version != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Version)
+		if !f(fd_RegisterAccountAcknowledgement_version, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_RegisterAccountAcknowledgement_address, value) {
+			return
+		}
+	}
+	if x.Created != false {
+		value := protoreflect.ValueOfBool(x.Created)
+		if !f(fd_RegisterAccountAcknowledgement_created, value) {
+			return
+		}
+	}
+	if x.Channel != "" {
+		value := protoreflect.ValueOfString(x.Channel)
+		if !f(fd_RegisterAccountAcknowledgement_channel, value) {
+			return
+		}
+	}
+	if x.Fallback != "" {
+		value := protoreflect.ValueOfString(x.Fallback)
+		if !f(fd_RegisterAccountAcknowledgement_fallback, value) {
 			return
 		}
 	}
@@ -655,15 +847,23 @@ func (x *fastReflection_RegisterAccountMemo) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RegisterAccountMemo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RegisterAccountAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.noble":
-		return x.Noble != nil
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.version":
+		return x.Version != uint32(0)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.address":
+		return x.Address != ""
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.created":
+		return x.Created != false
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.channel":
+		return x.Channel != ""
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.fallback":
+		return x.Fallback != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountAcknowledgement"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -673,15 +873,23 @@ func (x *fastReflection_RegisterAccountMemo) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RegisterAccountAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.noble":
-		x.Noble = nil
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.version":
+		x.Version = uint32(0)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.address":
+		x.Address = ""
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.created":
+		x.Created = false
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.channel":
+		x.Channel = ""
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.fallback":
+		x.Fallback = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountAcknowledgement"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -691,16 +899,28 @@ func (x *fastReflection_RegisterAccountMemo) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RegisterAccountMemo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RegisterAccountAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.noble":
-		value := x.Noble
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.version":
+		value := x.Version
+		return protoreflect.ValueOfUint32(value)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.created":
+		value := x.Created
+		return protoreflect.ValueOfBool(value)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.channel":
+		value := x.Channel
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.fallback":
+		value := x.Fallback
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountAcknowledgement"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountAcknowledgement does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -714,15 +934,23 @@ func (x *fastReflection_RegisterAccountMemo) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RegisterAccountAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.noble":
-		x.Noble = value.Message().Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.version":
+		x.Version = uint32(value.Uint())
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.address":
+		x.Address = value.Interface().(string)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.created":
+		x.Created = value.Bool()
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.channel":
+		x.Channel = value.Interface().(string)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.fallback":
+		x.Fallback = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountAcknowledgement"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -736,44 +964,56 @@ func (x *fastReflection_RegisterAccountMemo) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RegisterAccountAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.noble":
-		if x.Noble == nil {
-			x.Noble = new(RegisterAccountMemo_RegisterAccountDataWrapper)
-		}
-		return protoreflect.ValueOfMessage(x.Noble.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.version":
+		panic(fmt.Errorf("field version of message noble.forwarding.v1.RegisterAccountAcknowledgement is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.address":
+		panic(fmt.Errorf("field address of message noble.forwarding.v1.RegisterAccountAcknowledgement is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.created":
+		panic(fmt.Errorf("field created of message noble.forwarding.v1.RegisterAccountAcknowledgement is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.channel":
+		panic(fmt.Errorf("field channel of message noble.forwarding.v1.RegisterAccountAcknowledgement is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.fallback":
+		panic(fmt.Errorf("field fallback of message noble.forwarding.v1.RegisterAccountAcknowledgement is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountAcknowledgement"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RegisterAccountMemo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RegisterAccountAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.noble":
-		m := new(RegisterAccountMemo_RegisterAccountDataWrapper)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.address":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.created":
+		return protoreflect.ValueOfBool(false)
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.channel":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.RegisterAccountAcknowledgement.fallback":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountAcknowledgement"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RegisterAccountMemo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RegisterAccountAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.RegisterAccountMemo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.RegisterAccountAcknowledgement", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -781,7 +1021,7 @@ func (x *fastReflection_RegisterAccountMemo) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RegisterAccountMemo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RegisterAccountAcknowledgement) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -792,7 +1032,7 @@ func (x *fastReflection_RegisterAccountMemo) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RegisterAccountAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -804,7 +1044,7 @@ func (x *fastReflection_RegisterAccountMemo) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RegisterAccountMemo) IsValid() bool {
+func (x *fastReflection_RegisterAccountAcknowledgement) IsValid() bool {
 	return x != nil
 }
 
@@ -814,9 +1054,9 @@ func (x *fastReflection_RegisterAccountMemo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RegisterAccountAcknowledgement) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RegisterAccountMemo)
+		x := input.Message.Interface().(*RegisterAccountAcknowledgement)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -828,8 +1068,22 @@ func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Noble != nil {
-			l = options.Size(x.Noble)
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Created {
+			n += 2
+		}
+		l = len(x.Channel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fallback)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -842,7 +1096,7 @@ func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RegisterAccountMemo)
+		x := input.Message.Interface().(*RegisterAccountAcknowledgement)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -861,19 +1115,41 @@ func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Noble != nil {
-			encoded, err := options.Marshal(x.Noble)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Fallback) > 0 {
+			i -= len(x.Fallback)
+			copy(dAtA[i:], x.Fallback)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fallback)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Channel) > 0 {
+			i -= len(x.Channel)
+			copy(dAtA[i:], x.Channel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Channel)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Created {
+			i--
+			if x.Created {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x18
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -886,7 +1162,7 @@ func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RegisterAccountMemo)
+		x := input.Message.Interface().(*RegisterAccountAcknowledgement)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -918,17 +1194,36 @@ func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountAcknowledgement: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Noble", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -938,27 +1233,107 @@ func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Noble == nil {
-					x.Noble = &RegisterAccountMemo_RegisterAccountDataWrapper{}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Noble); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Created = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Fallback = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -996,25 +1371,25 @@ func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_RegisterAccountMemo_RegisterAccountDataWrapper            protoreflect.MessageDescriptor
-	fd_RegisterAccountMemo_RegisterAccountDataWrapper_forwarding protoreflect.FieldDescriptor
+	md_RegisterAccountMemo       protoreflect.MessageDescriptor
+	fd_RegisterAccountMemo_noble protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_forwarding_v1_packet_proto_init()
-	md_RegisterAccountMemo_RegisterAccountDataWrapper = File_noble_forwarding_v1_packet_proto.Messages().ByName("RegisterAccountMemo").Messages().ByName("RegisterAccountDataWrapper")
-	fd_RegisterAccountMemo_RegisterAccountDataWrapper_forwarding = md_RegisterAccountMemo_RegisterAccountDataWrapper.Fields().ByName("forwarding")
+	md_RegisterAccountMemo = File_noble_forwarding_v1_packet_proto.Messages().ByName("RegisterAccountMemo")
+	fd_RegisterAccountMemo_noble = md_RegisterAccountMemo.Fields().ByName("noble")
 }
 
-var _ protoreflect.Message = (*fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)(nil)
+var _ protoreflect.Message = (*fastReflection_RegisterAccountMemo)(nil)
 
-type fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper RegisterAccountMemo_RegisterAccountDataWrapper
+type fastReflection_RegisterAccountMemo RegisterAccountMemo
 
-func (x *RegisterAccountMemo_RegisterAccountDataWrapper) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)(x)
+func (x *RegisterAccountMemo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RegisterAccountMemo)(x)
 }
 
-func (x *RegisterAccountMemo_RegisterAccountDataWrapper) slowProtoReflect() protoreflect.Message {
+func (x *RegisterAccountMemo) slowProtoReflect() protoreflect.Message {
 	mi := &file_noble_forwarding_v1_packet_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1026,43 +1401,43 @@ func (x *RegisterAccountMemo_RegisterAccountDataWrapper) slowProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType
-var _ protoreflect.MessageType = fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType{}
+var _fastReflection_RegisterAccountMemo_messageType fastReflection_RegisterAccountMemo_messageType
+var _ protoreflect.MessageType = fastReflection_RegisterAccountMemo_messageType{}
 
-type fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType struct{}
+type fastReflection_RegisterAccountMemo_messageType struct{}
 
-func (x fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)(nil)
+func (x fastReflection_RegisterAccountMemo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RegisterAccountMemo)(nil)
 }
-func (x fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType) New() protoreflect.Message {
-	return new(fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)
+func (x fastReflection_RegisterAccountMemo_messageType) New() protoreflect.Message {
+	return new(fastReflection_RegisterAccountMemo)
 }
-func (x fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RegisterAccountMemo_RegisterAccountDataWrapper
+func (x fastReflection_RegisterAccountMemo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RegisterAccountMemo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Descriptor() protoreflect.MessageDescriptor {
-	return md_RegisterAccountMemo_RegisterAccountDataWrapper
+func (x *fastReflection_RegisterAccountMemo) Descriptor() protoreflect.MessageDescriptor {
+	return md_RegisterAccountMemo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Type() protoreflect.MessageType {
-	return _fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType
+func (x *fastReflection_RegisterAccountMemo) Type() protoreflect.MessageType {
+	return _fastReflection_RegisterAccountMemo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) New() protoreflect.Message {
-	return new(fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)
+func (x *fastReflection_RegisterAccountMemo) New() protoreflect.Message {
+	return new(fastReflection_RegisterAccountMemo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Interface() protoreflect.ProtoMessage {
-	return (*RegisterAccountMemo_RegisterAccountDataWrapper)(x)
+func (x *fastReflection_RegisterAccountMemo) Interface() protoreflect.ProtoMessage {
+	return (*RegisterAccountMemo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1070,10 +1445,10 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Interfac
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Forwarding != nil {
-		value := protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
-		if !f(fd_RegisterAccountMemo_RegisterAccountDataWrapper_forwarding, value) {
+func (x *fastReflection_RegisterAccountMemo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Noble != nil {
+		value := protoreflect.ValueOfMessage(x.Noble.ProtoReflect())
+		if !f(fd_RegisterAccountMemo_noble, value) {
 			return
 		}
 	}
@@ -1090,15 +1465,15 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Range(f
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RegisterAccountMemo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
-		return x.Forwarding != nil
+	case "noble.forwarding.v1.RegisterAccountMemo.noble":
+		return x.Noble != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1108,15 +1483,15 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Has(fd p
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RegisterAccountMemo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
-		x.Forwarding = nil
+	case "noble.forwarding.v1.RegisterAccountMemo.noble":
+		x.Noble = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1126,16 +1501,16 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Clear(fd
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RegisterAccountMemo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
-		value := x.Forwarding
+	case "noble.forwarding.v1.RegisterAccountMemo.noble":
+		value := x.Noble
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1149,15 +1524,15 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Get(desc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RegisterAccountMemo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
-		x.Forwarding = value.Message().Interface().(*RegisterAccountData)
+	case "noble.forwarding.v1.RegisterAccountMemo.noble":
+		x.Noble = value.Message().Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1171,44 +1546,44 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Set(fd p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RegisterAccountMemo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
-		if x.Forwarding == nil {
-			x.Forwarding = new(RegisterAccountData)
+	case "noble.forwarding.v1.RegisterAccountMemo.noble":
+		if x.Noble == nil {
+			x.Noble = new(RegisterAccountMemo_RegisterAccountDataWrapper)
 		}
-		return protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Noble.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RegisterAccountMemo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
-		m := new(RegisterAccountData)
+	case "noble.forwarding.v1.RegisterAccountMemo.noble":
+		m := new(RegisterAccountMemo_RegisterAccountDataWrapper)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo"))
 		}
-		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RegisterAccountMemo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.RegisterAccountMemo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1216,7 +1591,7 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) WhichOne
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RegisterAccountMemo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1227,7 +1602,7 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) GetUnkno
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RegisterAccountMemo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1239,7 +1614,7 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) SetUnkno
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) IsValid() bool {
+func (x *fastReflection_RegisterAccountMemo) IsValid() bool {
 	return x != nil
 }
 
@@ -1249,9 +1624,9 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) IsValid(
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RegisterAccountMemo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
+		x := input.Message.Interface().(*RegisterAccountMemo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1263,8 +1638,8 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMet
 		var n int
 		var l int
 		_ = l
-		if x.Forwarding != nil {
-			l = options.Size(x.Forwarding)
+		if x.Noble != nil {
+			l = options.Size(x.Noble)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1277,7 +1652,7 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMet
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
+		x := input.Message.Interface().(*RegisterAccountMemo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1296,8 +1671,8 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMet
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Forwarding != nil {
-			encoded, err := options.Marshal(x.Forwarding)
+		if x.Noble != nil {
+			encoded, err := options.Marshal(x.Noble)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1321,7 +1696,7 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMet
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
+		x := input.Message.Interface().(*RegisterAccountMemo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1353,15 +1728,15 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMet
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo_RegisterAccountDataWrapper: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo_RegisterAccountDataWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Noble", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1388,10 +1763,10 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMet
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Forwarding == nil {
-					x.Forwarding = &RegisterAccountData{}
+				if x.Noble == nil {
+					x.Noble = &RegisterAccountMemo_RegisterAccountDataWrapper{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forwarding); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Noble); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1430,24 +1805,1837 @@ func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMet
 	}
 }
 
-// SPDX-License-Identifier: BUSL-1.1
-//
-// Copyright (C) 2025, NASD Inc. All rights reserved.
-// Use of this software is governed by the Business Source License included
-// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
-//
-// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
-// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
-// VERSIONS OF THE LICENSED WORK.
-//
-// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
-// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
-// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
-//
-// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
-// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
-// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
-// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+var (
+	md_RegisterAccountMemo_RegisterAccountDataWrapper                protoreflect.MessageDescriptor
+	fd_RegisterAccountMemo_RegisterAccountDataWrapper_forwarding     protoreflect.FieldDescriptor
+	fd_RegisterAccountMemo_RegisterAccountDataWrapper_atomic_forward protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_forwarding_v1_packet_proto_init()
+	md_RegisterAccountMemo_RegisterAccountDataWrapper = File_noble_forwarding_v1_packet_proto.Messages().ByName("RegisterAccountMemo").Messages().ByName("RegisterAccountDataWrapper")
+	fd_RegisterAccountMemo_RegisterAccountDataWrapper_forwarding = md_RegisterAccountMemo_RegisterAccountDataWrapper.Fields().ByName("forwarding")
+	fd_RegisterAccountMemo_RegisterAccountDataWrapper_atomic_forward = md_RegisterAccountMemo_RegisterAccountDataWrapper.Fields().ByName("atomic_forward")
+}
+
+var _ protoreflect.Message = (*fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)(nil)
+
+type fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper RegisterAccountMemo_RegisterAccountDataWrapper
+
+func (x *RegisterAccountMemo_RegisterAccountDataWrapper) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)(x)
+}
+
+func (x *RegisterAccountMemo_RegisterAccountDataWrapper) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_forwarding_v1_packet_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType
+var _ protoreflect.MessageType = fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType{}
+
+type fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType struct{}
+
+func (x fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)(nil)
+}
+func (x fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType) New() protoreflect.Message {
+	return new(fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)
+}
+func (x fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RegisterAccountMemo_RegisterAccountDataWrapper
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Descriptor() protoreflect.MessageDescriptor {
+	return md_RegisterAccountMemo_RegisterAccountDataWrapper
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Type() protoreflect.MessageType {
+	return _fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) New() protoreflect.Message {
+	return new(fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Interface() protoreflect.ProtoMessage {
+	return (*RegisterAccountMemo_RegisterAccountDataWrapper)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Forwarding != nil {
+		value := protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
+		if !f(fd_RegisterAccountMemo_RegisterAccountDataWrapper_forwarding, value) {
+			return
+		}
+	}
+	if x.AtomicForward != false {
+		value := protoreflect.ValueOfBool(x.AtomicForward)
+		if !f(fd_RegisterAccountMemo_RegisterAccountDataWrapper_atomic_forward, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
+		return x.Forwarding != nil
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.atomic_forward":
+		return x.AtomicForward != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
+		x.Forwarding = nil
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.atomic_forward":
+		x.AtomicForward = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
+		value := x.Forwarding
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.atomic_forward":
+		value := x.AtomicForward
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
+		x.Forwarding = value.Message().Interface().(*RegisterAccountData)
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.atomic_forward":
+		x.AtomicForward = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
+		if x.Forwarding == nil {
+			x.Forwarding = new(RegisterAccountData)
+		}
+		return protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.atomic_forward":
+		panic(fmt.Errorf("field atomic_forward of message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding":
+		m := new(RegisterAccountData)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.atomic_forward":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Forwarding != nil {
+			l = options.Size(x.Forwarding)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AtomicForward {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AtomicForward {
+			i--
+			if x.AtomicForward {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Forwarding != nil {
+			encoded, err := options.Marshal(x.Forwarding)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RegisterAccountMemo_RegisterAccountDataWrapper)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo_RegisterAccountDataWrapper: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RegisterAccountMemo_RegisterAccountDataWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Forwarding == nil {
+					x.Forwarding = &RegisterAccountData{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forwarding); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AtomicForward", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AtomicForward = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InFlightPacket                         protoreflect.MessageDescriptor
+	fd_InFlightPacket_address                 protoreflect.FieldDescriptor
+	fd_InFlightPacket_forward_channel         protoreflect.FieldDescriptor
+	fd_InFlightPacket_forward_sequence        protoreflect.FieldDescriptor
+	fd_InFlightPacket_source_port             protoreflect.FieldDescriptor
+	fd_InFlightPacket_source_channel          protoreflect.FieldDescriptor
+	fd_InFlightPacket_destination_port        protoreflect.FieldDescriptor
+	fd_InFlightPacket_destination_channel     protoreflect.FieldDescriptor
+	fd_InFlightPacket_sequence                protoreflect.FieldDescriptor
+	fd_InFlightPacket_data                    protoreflect.FieldDescriptor
+	fd_InFlightPacket_timeout_revision_number protoreflect.FieldDescriptor
+	fd_InFlightPacket_timeout_revision_height protoreflect.FieldDescriptor
+	fd_InFlightPacket_timeout_timestamp       protoreflect.FieldDescriptor
+	fd_InFlightPacket_amount                  protoreflect.FieldDescriptor
+	fd_InFlightPacket_unescrowed              protoreflect.FieldDescriptor
+	fd_InFlightPacket_memo                    protoreflect.FieldDescriptor
+	fd_InFlightPacket_registration_fee        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_forwarding_v1_packet_proto_init()
+	md_InFlightPacket = File_noble_forwarding_v1_packet_proto.Messages().ByName("InFlightPacket")
+	fd_InFlightPacket_address = md_InFlightPacket.Fields().ByName("address")
+	fd_InFlightPacket_forward_channel = md_InFlightPacket.Fields().ByName("forward_channel")
+	fd_InFlightPacket_forward_sequence = md_InFlightPacket.Fields().ByName("forward_sequence")
+	fd_InFlightPacket_source_port = md_InFlightPacket.Fields().ByName("source_port")
+	fd_InFlightPacket_source_channel = md_InFlightPacket.Fields().ByName("source_channel")
+	fd_InFlightPacket_destination_port = md_InFlightPacket.Fields().ByName("destination_port")
+	fd_InFlightPacket_destination_channel = md_InFlightPacket.Fields().ByName("destination_channel")
+	fd_InFlightPacket_sequence = md_InFlightPacket.Fields().ByName("sequence")
+	fd_InFlightPacket_data = md_InFlightPacket.Fields().ByName("data")
+	fd_InFlightPacket_timeout_revision_number = md_InFlightPacket.Fields().ByName("timeout_revision_number")
+	fd_InFlightPacket_timeout_revision_height = md_InFlightPacket.Fields().ByName("timeout_revision_height")
+	fd_InFlightPacket_timeout_timestamp = md_InFlightPacket.Fields().ByName("timeout_timestamp")
+	fd_InFlightPacket_amount = md_InFlightPacket.Fields().ByName("amount")
+	fd_InFlightPacket_unescrowed = md_InFlightPacket.Fields().ByName("unescrowed")
+	fd_InFlightPacket_memo = md_InFlightPacket.Fields().ByName("memo")
+	fd_InFlightPacket_registration_fee = md_InFlightPacket.Fields().ByName("registration_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_InFlightPacket)(nil)
+
+type fastReflection_InFlightPacket InFlightPacket
+
+func (x *InFlightPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InFlightPacket)(x)
+}
+
+func (x *InFlightPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_forwarding_v1_packet_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InFlightPacket_messageType fastReflection_InFlightPacket_messageType
+var _ protoreflect.MessageType = fastReflection_InFlightPacket_messageType{}
+
+type fastReflection_InFlightPacket_messageType struct{}
+
+func (x fastReflection_InFlightPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InFlightPacket)(nil)
+}
+func (x fastReflection_InFlightPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_InFlightPacket)
+}
+func (x fastReflection_InFlightPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InFlightPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InFlightPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_InFlightPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InFlightPacket) Type() protoreflect.MessageType {
+	return _fastReflection_InFlightPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InFlightPacket) New() protoreflect.Message {
+	return new(fastReflection_InFlightPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InFlightPacket) Interface() protoreflect.ProtoMessage {
+	return (*InFlightPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InFlightPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_InFlightPacket_address, value) {
+			return
+		}
+	}
+	if x.ForwardChannel != "" {
+		value := protoreflect.ValueOfString(x.ForwardChannel)
+		if !f(fd_InFlightPacket_forward_channel, value) {
+			return
+		}
+	}
+	if x.ForwardSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ForwardSequence)
+		if !f(fd_InFlightPacket_forward_sequence, value) {
+			return
+		}
+	}
+	if x.SourcePort != "" {
+		value := protoreflect.ValueOfString(x.SourcePort)
+		if !f(fd_InFlightPacket_source_port, value) {
+			return
+		}
+	}
+	if x.SourceChannel != "" {
+		value := protoreflect.ValueOfString(x.SourceChannel)
+		if !f(fd_InFlightPacket_source_channel, value) {
+			return
+		}
+	}
+	if x.DestinationPort != "" {
+		value := protoreflect.ValueOfString(x.DestinationPort)
+		if !f(fd_InFlightPacket_destination_port, value) {
+			return
+		}
+	}
+	if x.DestinationChannel != "" {
+		value := protoreflect.ValueOfString(x.DestinationChannel)
+		if !f(fd_InFlightPacket_destination_channel, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_InFlightPacket_sequence, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_InFlightPacket_data, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionNumber)
+		if !f(fd_InFlightPacket_timeout_revision_number, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionHeight)
+		if !f(fd_InFlightPacket_timeout_revision_height, value) {
+			return
+		}
+	}
+	if x.TimeoutTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutTimestamp)
+		if !f(fd_InFlightPacket_timeout_timestamp, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_InFlightPacket_amount, value) {
+			return
+		}
+	}
+	if x.Unescrowed != false {
+		value := protoreflect.ValueOfBool(x.Unescrowed)
+		if !f(fd_InFlightPacket_unescrowed, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_InFlightPacket_memo, value) {
+			return
+		}
+	}
+	if x.RegistrationFee != nil {
+		value := protoreflect.ValueOfMessage(x.RegistrationFee.ProtoReflect())
+		if !f(fd_InFlightPacket_registration_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InFlightPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.InFlightPacket.address":
+		return x.Address != ""
+	case "noble.forwarding.v1.InFlightPacket.forward_channel":
+		return x.ForwardChannel != ""
+	case "noble.forwarding.v1.InFlightPacket.forward_sequence":
+		return x.ForwardSequence != uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.source_port":
+		return x.SourcePort != ""
+	case "noble.forwarding.v1.InFlightPacket.source_channel":
+		return x.SourceChannel != ""
+	case "noble.forwarding.v1.InFlightPacket.destination_port":
+		return x.DestinationPort != ""
+	case "noble.forwarding.v1.InFlightPacket.destination_channel":
+		return x.DestinationChannel != ""
+	case "noble.forwarding.v1.InFlightPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.data":
+		return len(x.Data) != 0
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_number":
+		return x.TimeoutRevisionNumber != uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_height":
+		return x.TimeoutRevisionHeight != uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.timeout_timestamp":
+		return x.TimeoutTimestamp != uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.amount":
+		return x.Amount != nil
+	case "noble.forwarding.v1.InFlightPacket.unescrowed":
+		return x.Unescrowed != false
+	case "noble.forwarding.v1.InFlightPacket.memo":
+		return x.Memo != ""
+	case "noble.forwarding.v1.InFlightPacket.registration_fee":
+		return x.RegistrationFee != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.InFlightPacket.address":
+		x.Address = ""
+	case "noble.forwarding.v1.InFlightPacket.forward_channel":
+		x.ForwardChannel = ""
+	case "noble.forwarding.v1.InFlightPacket.forward_sequence":
+		x.ForwardSequence = uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.source_port":
+		x.SourcePort = ""
+	case "noble.forwarding.v1.InFlightPacket.source_channel":
+		x.SourceChannel = ""
+	case "noble.forwarding.v1.InFlightPacket.destination_port":
+		x.DestinationPort = ""
+	case "noble.forwarding.v1.InFlightPacket.destination_channel":
+		x.DestinationChannel = ""
+	case "noble.forwarding.v1.InFlightPacket.sequence":
+		x.Sequence = uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.data":
+		x.Data = nil
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_number":
+		x.TimeoutRevisionNumber = uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_height":
+		x.TimeoutRevisionHeight = uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.timeout_timestamp":
+		x.TimeoutTimestamp = uint64(0)
+	case "noble.forwarding.v1.InFlightPacket.amount":
+		x.Amount = nil
+	case "noble.forwarding.v1.InFlightPacket.unescrowed":
+		x.Unescrowed = false
+	case "noble.forwarding.v1.InFlightPacket.memo":
+		x.Memo = ""
+	case "noble.forwarding.v1.InFlightPacket.registration_fee":
+		x.RegistrationFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InFlightPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.forwarding.v1.InFlightPacket.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.InFlightPacket.forward_channel":
+		value := x.ForwardChannel
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.InFlightPacket.forward_sequence":
+		value := x.ForwardSequence
+		return protoreflect.ValueOfUint64(value)
+	case "noble.forwarding.v1.InFlightPacket.source_port":
+		value := x.SourcePort
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.InFlightPacket.source_channel":
+		value := x.SourceChannel
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.InFlightPacket.destination_port":
+		value := x.DestinationPort
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.InFlightPacket.destination_channel":
+		value := x.DestinationChannel
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.InFlightPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "noble.forwarding.v1.InFlightPacket.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_number":
+		value := x.TimeoutRevisionNumber
+		return protoreflect.ValueOfUint64(value)
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_height":
+		value := x.TimeoutRevisionHeight
+		return protoreflect.ValueOfUint64(value)
+	case "noble.forwarding.v1.InFlightPacket.timeout_timestamp":
+		value := x.TimeoutTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "noble.forwarding.v1.InFlightPacket.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.forwarding.v1.InFlightPacket.unescrowed":
+		value := x.Unescrowed
+		return protoreflect.ValueOfBool(value)
+	case "noble.forwarding.v1.InFlightPacket.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.InFlightPacket.registration_fee":
+		value := x.RegistrationFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.InFlightPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.InFlightPacket.address":
+		x.Address = value.Interface().(string)
+	case "noble.forwarding.v1.InFlightPacket.forward_channel":
+		x.ForwardChannel = value.Interface().(string)
+	case "noble.forwarding.v1.InFlightPacket.forward_sequence":
+		x.ForwardSequence = value.Uint()
+	case "noble.forwarding.v1.InFlightPacket.source_port":
+		x.SourcePort = value.Interface().(string)
+	case "noble.forwarding.v1.InFlightPacket.source_channel":
+		x.SourceChannel = value.Interface().(string)
+	case "noble.forwarding.v1.InFlightPacket.destination_port":
+		x.DestinationPort = value.Interface().(string)
+	case "noble.forwarding.v1.InFlightPacket.destination_channel":
+		x.DestinationChannel = value.Interface().(string)
+	case "noble.forwarding.v1.InFlightPacket.sequence":
+		x.Sequence = value.Uint()
+	case "noble.forwarding.v1.InFlightPacket.data":
+		x.Data = value.Bytes()
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_number":
+		x.TimeoutRevisionNumber = value.Uint()
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_height":
+		x.TimeoutRevisionHeight = value.Uint()
+	case "noble.forwarding.v1.InFlightPacket.timeout_timestamp":
+		x.TimeoutTimestamp = value.Uint()
+	case "noble.forwarding.v1.InFlightPacket.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "noble.forwarding.v1.InFlightPacket.unescrowed":
+		x.Unescrowed = value.Bool()
+	case "noble.forwarding.v1.InFlightPacket.memo":
+		x.Memo = value.Interface().(string)
+	case "noble.forwarding.v1.InFlightPacket.registration_fee":
+		x.RegistrationFee = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.InFlightPacket.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "noble.forwarding.v1.InFlightPacket.registration_fee":
+		if x.RegistrationFee == nil {
+			x.RegistrationFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RegistrationFee.ProtoReflect())
+	case "noble.forwarding.v1.InFlightPacket.address":
+		panic(fmt.Errorf("field address of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.forward_channel":
+		panic(fmt.Errorf("field forward_channel of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.forward_sequence":
+		panic(fmt.Errorf("field forward_sequence of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.source_port":
+		panic(fmt.Errorf("field source_port of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.source_channel":
+		panic(fmt.Errorf("field source_channel of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.destination_port":
+		panic(fmt.Errorf("field destination_port of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.destination_channel":
+		panic(fmt.Errorf("field destination_channel of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.sequence":
+		panic(fmt.Errorf("field sequence of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.data":
+		panic(fmt.Errorf("field data of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_number":
+		panic(fmt.Errorf("field timeout_revision_number of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_height":
+		panic(fmt.Errorf("field timeout_revision_height of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.timeout_timestamp":
+		panic(fmt.Errorf("field timeout_timestamp of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.unescrowed":
+		panic(fmt.Errorf("field unescrowed of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	case "noble.forwarding.v1.InFlightPacket.memo":
+		panic(fmt.Errorf("field memo of message noble.forwarding.v1.InFlightPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InFlightPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.forwarding.v1.InFlightPacket.address":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.InFlightPacket.forward_channel":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.InFlightPacket.forward_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.forwarding.v1.InFlightPacket.source_port":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.InFlightPacket.source_channel":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.InFlightPacket.destination_port":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.InFlightPacket.destination_channel":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.InFlightPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.forwarding.v1.InFlightPacket.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.forwarding.v1.InFlightPacket.timeout_revision_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.forwarding.v1.InFlightPacket.timeout_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.forwarding.v1.InFlightPacket.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.forwarding.v1.InFlightPacket.unescrowed":
+		return protoreflect.ValueOfBool(false)
+	case "noble.forwarding.v1.InFlightPacket.memo":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.InFlightPacket.registration_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message noble.forwarding.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InFlightPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.forwarding.v1.InFlightPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InFlightPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InFlightPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InFlightPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InFlightPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ForwardChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ForwardSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.ForwardSequence))
+		}
+		l = len(x.SourcePort)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationPort)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionNumber))
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionHeight))
+		}
+		if x.TimeoutTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutTimestamp))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Unescrowed {
+			n += 2
+		}
+		l = len(x.Memo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RegistrationFee != nil {
+			l = options.Size(x.RegistrationFee)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InFlightPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RegistrationFee != nil {
+			encoded, err := options.Marshal(x.RegistrationFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.Unescrowed {
+			i--
+			if x.Unescrowed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.TimeoutTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutTimestamp))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionNumber))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.DestinationChannel) > 0 {
+			i -= len(x.DestinationChannel)
+			copy(dAtA[i:], x.DestinationChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChannel)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.DestinationPort) > 0 {
+			i -= len(x.DestinationPort)
+			copy(dAtA[i:], x.DestinationPort)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationPort)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SourceChannel) > 0 {
+			i -= len(x.SourceChannel)
+			copy(dAtA[i:], x.SourceChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceChannel)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SourcePort) > 0 {
+			i -= len(x.SourcePort)
+			copy(dAtA[i:], x.SourcePort)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourcePort)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ForwardSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ForwardSequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ForwardChannel) > 0 {
+			i -= len(x.ForwardChannel)
+			copy(dAtA[i:], x.ForwardChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ForwardChannel)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InFlightPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForwardChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+				}
+				x.ForwardSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ForwardSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourcePort = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationPort = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+				}
+				x.TimeoutRevisionNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+				}
+				x.TimeoutRevisionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				}
+				x.TimeoutTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unescrowed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Unescrowed = bool(v != 0)
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RegistrationFee == nil {
+					x.RegistrationFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RegistrationFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
@@ -1463,6 +3651,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RegistrationMode determines how failed registrations through transfer memos
+// are handled.
+type RegistrationMode int32
+
+const (
+	// REGISTRATION_MODE_UNSPECIFIED is the default value, and behaves as strict.
+	RegistrationMode_REGISTRATION_MODE_UNSPECIFIED RegistrationMode = 0
+	// REGISTRATION_MODE_STRICT rejects the incoming transfer with an error acknowledgement.
+	RegistrationMode_REGISTRATION_MODE_STRICT RegistrationMode = 1
+	// REGISTRATION_MODE_SOFT_FAIL emits an event and lets the incoming transfer proceed.
+	RegistrationMode_REGISTRATION_MODE_SOFT_FAIL RegistrationMode = 2
+)
+
+// Enum value maps for RegistrationMode.
+var (
+	RegistrationMode_name = map[int32]string{
+		0: "REGISTRATION_MODE_UNSPECIFIED",
+		1: "REGISTRATION_MODE_STRICT",
+		2: "REGISTRATION_MODE_SOFT_FAIL",
+	}
+	RegistrationMode_value = map[string]int32{
+		"REGISTRATION_MODE_UNSPECIFIED": 0,
+		"REGISTRATION_MODE_STRICT":      1,
+		"REGISTRATION_MODE_SOFT_FAIL":   2,
+	}
+)
+
+func (x RegistrationMode) Enum() *RegistrationMode {
+	p := new(RegistrationMode)
+	*p = x
+	return p
+}
+
+func (x RegistrationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegistrationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_noble_forwarding_v1_packet_proto_enumTypes[0].Descriptor()
+}
+
+func (RegistrationMode) Type() protoreflect.EnumType {
+	return &file_noble_forwarding_v1_packet_proto_enumTypes[0]
+}
+
+func (x RegistrationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegistrationMode.Descriptor instead.
+func (RegistrationMode) EnumDescriptor() ([]byte, []int) {
+	return file_noble_forwarding_v1_packet_proto_rawDescGZIP(), []int{0}
+}
+
 type RegisterAccountData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1471,6 +3713,8 @@ type RegisterAccountData struct {
 	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Channel   string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 	Fallback  string `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// memos are the initial per-denom memos of the forwarding account.
+	Memos []*MemoEntry `protobuf:"bytes,4,rep,name=memos,proto3" json:"memos,omitempty"`
 }
 
 func (x *RegisterAccountData) Reset() {
@@ -1514,6 +3758,87 @@ func (x *RegisterAccountData) GetFallback() string {
 	return ""
 }
 
+func (x *RegisterAccountData) GetMemos() []*MemoEntry {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+// RegisterAccountAcknowledgement is the JSON encoded result of a successful
+// RegisterAccountData packet.
+type RegisterAccountAcknowledgement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version is the version of the acknowledgement format.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// created is false if the forwarding account already existed.
+	Created bool `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// channel is the channel that the forwarding account forwards to.
+	Channel string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	// fallback is the fallback address of the forwarding account.
+	Fallback string `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+}
+
+func (x *RegisterAccountAcknowledgement) Reset() {
+	*x = RegisterAccountAcknowledgement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_forwarding_v1_packet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterAccountAcknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAccountAcknowledgement) ProtoMessage() {}
+
+// Deprecated: Use RegisterAccountAcknowledgement.ProtoReflect.Descriptor instead.
+func (*RegisterAccountAcknowledgement) Descriptor() ([]byte, []int) {
+	return file_noble_forwarding_v1_packet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterAccountAcknowledgement) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RegisterAccountAcknowledgement) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RegisterAccountAcknowledgement) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *RegisterAccountAcknowledgement) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RegisterAccountAcknowledgement) GetFallback() string {
+	if x != nil {
+		return x.Fallback
+	}
+	return ""
+}
+
 type RegisterAccountMemo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1525,7 +3850,7 @@ type RegisterAccountMemo struct {
 func (x *RegisterAccountMemo) Reset() {
 	*x = RegisterAccountMemo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_forwarding_v1_packet_proto_msgTypes[1]
+		mi := &file_noble_forwarding_v1_packet_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1539,7 +3864,7 @@ func (*RegisterAccountMemo) ProtoMessage() {}
 
 // Deprecated: Use RegisterAccountMemo.ProtoReflect.Descriptor instead.
 func (*RegisterAccountMemo) Descriptor() ([]byte, []int) {
-	return file_noble_forwarding_v1_packet_proto_rawDescGZIP(), []int{1}
+	return file_noble_forwarding_v1_packet_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RegisterAccountMemo) GetNoble() *RegisterAccountMemo_RegisterAccountDataWrapper {
@@ -1549,18 +3874,198 @@ func (x *RegisterAccountMemo) GetNoble() *RegisterAccountMemo_RegisterAccountDat
 	return nil
 }
 
+// InFlightPacket is an incoming transfer that was forwarded atomically, whose
+// acknowledgement is pending on the acknowledgement of the forward.
+type InFlightPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the forwarding account that received the transfer.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// forward_channel is the channel that the transfer was forwarded through.
+	ForwardChannel string `protobuf:"bytes,2,opt,name=forward_channel,json=forwardChannel,proto3" json:"forward_channel,omitempty"`
+	// forward_sequence is the sequence of the forward packet.
+	ForwardSequence uint64 `protobuf:"varint,3,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// source_port is the source port of the incoming packet.
+	SourcePort string `protobuf:"bytes,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// source_channel is the source channel of the incoming packet.
+	SourceChannel string `protobuf:"bytes,5,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// destination_port is the destination port of the incoming packet.
+	DestinationPort string `protobuf:"bytes,6,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	// destination_channel is the destination channel of the incoming packet.
+	DestinationChannel string `protobuf:"bytes,7,opt,name=destination_channel,json=destinationChannel,proto3" json:"destination_channel,omitempty"`
+	// sequence is the sequence of the incoming packet.
+	Sequence uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the data of the incoming packet.
+	Data []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	// timeout_revision_number is the timeout height revision of the incoming packet.
+	TimeoutRevisionNumber uint64 `protobuf:"varint,10,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	// timeout_revision_height is the timeout height of the incoming packet.
+	TimeoutRevisionHeight uint64 `protobuf:"varint,11,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	// timeout_timestamp is the timeout timestamp of the incoming packet.
+	TimeoutTimestamp uint64 `protobuf:"varint,12,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// amount is the amount that was received, and forwarded.
+	Amount *v1beta1.Coin `protobuf:"bytes,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	// unescrowed is true if the amount was unescrowed when received, rather
+	// than minted as a voucher.
+	Unescrowed bool `protobuf:"varint,14,opt,name=unescrowed,proto3" json:"unescrowed,omitempty"`
+	// memo is the memo of the forward, recorded once it's acknowledged.
+	Memo string `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	// registration_fee is the part of the amount that was deducted for the
+	// registration fee, which is held by the module until the forward is
+	// acknowledged.
+	RegistrationFee *v1beta1.Coin `protobuf:"bytes,16,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
+}
+
+func (x *InFlightPacket) Reset() {
+	*x = InFlightPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_forwarding_v1_packet_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InFlightPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightPacket) ProtoMessage() {}
+
+// Deprecated: Use InFlightPacket.ProtoReflect.Descriptor instead.
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return file_noble_forwarding_v1_packet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InFlightPacket) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetForwardChannel() string {
+	if x != nil {
+		return x.ForwardChannel
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetForwardSequence() uint64 {
+	if x != nil {
+		return x.ForwardSequence
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetSourcePort() string {
+	if x != nil {
+		return x.SourcePort
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetSourceChannel() string {
+	if x != nil {
+		return x.SourceChannel
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetDestinationPort() string {
+	if x != nil {
+		return x.DestinationPort
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetDestinationChannel() string {
+	if x != nil {
+		return x.DestinationChannel
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InFlightPacket) GetTimeoutRevisionNumber() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetTimeoutRevisionHeight() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetTimeoutTimestamp() uint64 {
+	if x != nil {
+		return x.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *InFlightPacket) GetUnescrowed() bool {
+	if x != nil {
+		return x.Unescrowed
+	}
+	return false
+}
+
+func (x *InFlightPacket) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetRegistrationFee() *v1beta1.Coin {
+	if x != nil {
+		return x.RegistrationFee
+	}
+	return nil
+}
+
 type RegisterAccountMemo_RegisterAccountDataWrapper struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Forwarding *RegisterAccountData `protobuf:"bytes,1,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// atomic_forward opts into forwarding the transfer synchronously, where
+	// the transfer is only acknowledged once the forward is acknowledged, and
+	// refunded if the forward fails or times out.
+	AtomicForward bool `protobuf:"varint,2,opt,name=atomic_forward,json=atomicForward,proto3" json:"atomic_forward,omitempty"`
 }
 
 func (x *RegisterAccountMemo_RegisterAccountDataWrapper) Reset() {
 	*x = RegisterAccountMemo_RegisterAccountDataWrapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_forwarding_v1_packet_proto_msgTypes[2]
+		mi := &file_noble_forwarding_v1_packet_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1574,7 +4079,7 @@ func (*RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMessage() {}
 
 // Deprecated: Use RegisterAccountMemo_RegisterAccountDataWrapper.ProtoReflect.Descriptor instead.
 func (*RegisterAccountMemo_RegisterAccountDataWrapper) Descriptor() ([]byte, []int) {
-	return file_noble_forwarding_v1_packet_proto_rawDescGZIP(), []int{1, 0}
+	return file_noble_forwarding_v1_packet_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *RegisterAccountMemo_RegisterAccountDataWrapper) GetForwarding() *RegisterAccountData {
@@ -1584,48 +4089,128 @@ func (x *RegisterAccountMemo_RegisterAccountDataWrapper) GetForwarding() *Regist
 	return nil
 }
 
+func (x *RegisterAccountMemo_RegisterAccountDataWrapper) GetAtomicForward() bool {
+	if x != nil {
+		return x.AtomicForward
+	}
+	return false
+}
+
 var File_noble_forwarding_v1_packet_proto protoreflect.FileDescriptor
 
 var file_noble_forwarding_v1_packet_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
 	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x13, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x22, 0x69, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x22, 0xd8, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f,
-	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x65, 0x6d, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x05,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x1a, 0x66, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x01,
+	0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x3a, 0x0a, 0x05, 0x6d, 0x65, 0x6d,
+	0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x6d, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
+	0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x80, 0x02, 0x0a,
+	0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x52, 0x65,
 	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x42, 0xe0, 0x01,
-	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x32, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
-	0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x46, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
-	0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x05, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x1a,
+	0x8d, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x48,
+	0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x74, 0x6f, 0x6d,
+	0x69, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22,
+	0xa8, 0x05, 0x0a, 0x0e, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
+	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x75, 0x6e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
+	0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x4a,
+	0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x2a, 0x7a, 0x0a, 0x10, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x1d, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x01, 0x12,
+	0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x02,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe0, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4e, 0x46, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c,
+	0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1640,20 +4225,29 @@ func file_noble_forwarding_v1_packet_proto_rawDescGZIP() []byte {
 	return file_noble_forwarding_v1_packet_proto_rawDescData
 }
 
-var file_noble_forwarding_v1_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_noble_forwarding_v1_packet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_noble_forwarding_v1_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_noble_forwarding_v1_packet_proto_goTypes = []interface{}{
-	(*RegisterAccountData)(nil),                            // 0: noble.forwarding.v1.RegisterAccountData
-	(*RegisterAccountMemo)(nil),                            // 1: noble.forwarding.v1.RegisterAccountMemo
-	(*RegisterAccountMemo_RegisterAccountDataWrapper)(nil), // 2: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper
+	(RegistrationMode)(0),                                  // 0: noble.forwarding.v1.RegistrationMode
+	(*RegisterAccountData)(nil),                            // 1: noble.forwarding.v1.RegisterAccountData
+	(*RegisterAccountAcknowledgement)(nil),                 // 2: noble.forwarding.v1.RegisterAccountAcknowledgement
+	(*RegisterAccountMemo)(nil),                            // 3: noble.forwarding.v1.RegisterAccountMemo
+	(*InFlightPacket)(nil),                                 // 4: noble.forwarding.v1.InFlightPacket
+	(*RegisterAccountMemo_RegisterAccountDataWrapper)(nil), // 5: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper
+	(*MemoEntry)(nil),                                      // 6: noble.forwarding.v1.MemoEntry
+	(*v1beta1.Coin)(nil),                                   // 7: cosmos.base.v1beta1.Coin
 }
 var file_noble_forwarding_v1_packet_proto_depIdxs = []int32{
-	2, // 0: noble.forwarding.v1.RegisterAccountMemo.noble:type_name -> noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper
-	0, // 1: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding:type_name -> noble.forwarding.v1.RegisterAccountData
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: noble.forwarding.v1.RegisterAccountData.memos:type_name -> noble.forwarding.v1.MemoEntry
+	5, // 1: noble.forwarding.v1.RegisterAccountMemo.noble:type_name -> noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper
+	7, // 2: noble.forwarding.v1.InFlightPacket.amount:type_name -> cosmos.base.v1beta1.Coin
+	7, // 3: noble.forwarding.v1.InFlightPacket.registration_fee:type_name -> cosmos.base.v1beta1.Coin
+	1, // 4: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding:type_name -> noble.forwarding.v1.RegisterAccountData
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_noble_forwarding_v1_packet_proto_init() }
@@ -1661,6 +4255,7 @@ func file_noble_forwarding_v1_packet_proto_init() {
 	if File_noble_forwarding_v1_packet_proto != nil {
 		return
 	}
+	file_noble_forwarding_v1_memo_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_forwarding_v1_packet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterAccountData); i {
@@ -1675,7 +4270,7 @@ func file_noble_forwarding_v1_packet_proto_init() {
 			}
 		}
 		file_noble_forwarding_v1_packet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterAccountMemo); i {
+			switch v := v.(*RegisterAccountAcknowledgement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1687,6 +4282,30 @@ func file_noble_forwarding_v1_packet_proto_init() {
 			}
 		}
 		file_noble_forwarding_v1_packet_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterAccountMemo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_forwarding_v1_packet_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InFlightPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_forwarding_v1_packet_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterAccountMemo_RegisterAccountDataWrapper); i {
 			case 0:
 				return &v.state
@@ -1704,13 +4323,14 @@ func file_noble_forwarding_v1_packet_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_forwarding_v1_packet_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_noble_forwarding_v1_packet_proto_goTypes,
 		DependencyIndexes: file_noble_forwarding_v1_packet_proto_depIdxs,
+		EnumInfos:         file_noble_forwarding_v1_packet_proto_enumTypes,
 		MessageInfos:      file_noble_forwarding_v1_packet_proto_msgTypes,
 	}.Build()
 	File_noble_forwarding_v1_packet_proto = out.File
@@ -18,6 +18,26 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
@@ -39,12 +59,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Denoms_FullMethodName         = "/noble.forwarding.v1.Query/Denoms"
-	Query_Address_FullMethodName        = "/noble.forwarding.v1.Query/Address"
-	Query_Stats_FullMethodName          = "/noble.forwarding.v1.Query/Stats"
-	Query_StatsByChannel_FullMethodName = "/noble.forwarding.v1.Query/StatsByChannel"
-	Query_GetMemo_FullMethodName        = "/noble.forwarding.v1.Query/GetMemo"
-	Query_GetMemos_FullMethodName       = "/noble.forwarding.v1.Query/GetMemos"
+	Query_Denoms_FullMethodName              = "/noble.forwarding.v1.Query/Denoms"
+	Query_Address_FullMethodName             = "/noble.forwarding.v1.Query/Address"
+	Query_Account_FullMethodName             = "/noble.forwarding.v1.Query/Account"
+	Query_Stats_FullMethodName               = "/noble.forwarding.v1.Query/Stats"
+	Query_StatsByChannel_FullMethodName      = "/noble.forwarding.v1.Query/StatsByChannel"
+	Query_StatsByAccount_FullMethodName      = "/noble.forwarding.v1.Query/StatsByAccount"
+	Query_StatsByChain_FullMethodName        = "/noble.forwarding.v1.Query/StatsByChain"
+	Query_StatsHistory_FullMethodName        = "/noble.forwarding.v1.Query/StatsHistory"
+	Query_GetMemo_FullMethodName             = "/noble.forwarding.v1.Query/GetMemo"
+	Query_GetMemos_FullMethodName            = "/noble.forwarding.v1.Query/GetMemos"
+	Query_Accounts_FullMethodName            = "/noble.forwarding.v1.Query/Accounts"
+	Query_AccountsByChannel_FullMethodName   = "/noble.forwarding.v1.Query/AccountsByChannel"
+	Query_AccountsByRecipient_FullMethodName = "/noble.forwarding.v1.Query/AccountsByRecipient"
+	Query_AccountsByFallback_FullMethodName  = "/noble.forwarding.v1.Query/AccountsByFallback"
+	Query_ForwardHistory_FullMethodName      = "/noble.forwarding.v1.Query/ForwardHistory"
+	Query_Chains_FullMethodName              = "/noble.forwarding.v1.Query/Chains"
+	Query_Audit_FullMethodName               = "/noble.forwarding.v1.Query/Audit"
 )
 
 // QueryClient is the client API for Query service.
@@ -53,10 +84,21 @@ const (
 type QueryClient interface {
 	Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
 	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error)
 	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
 	StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error)
+	StatsByAccount(ctx context.Context, in *QueryStatsByAccount, opts ...grpc.CallOption) (*QueryStatsByAccountResponse, error)
+	StatsByChain(ctx context.Context, in *QueryStatsByChain, opts ...grpc.CallOption) (*QueryStatsByChainResponse, error)
+	StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error)
 	GetMemo(ctx context.Context, in *QueryMemo, opts ...grpc.CallOption) (*QueryMemoResponse, error)
 	GetMemos(ctx context.Context, in *QueryMemos, opts ...grpc.CallOption) (*QueryMemosResponse, error)
+	Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	AccountsByChannel(ctx context.Context, in *QueryAccountsByChannel, opts ...grpc.CallOption) (*QueryAccountsByChannelResponse, error)
+	AccountsByRecipient(ctx context.Context, in *QueryAccountsByRecipient, opts ...grpc.CallOption) (*QueryAccountsByRecipientResponse, error)
+	AccountsByFallback(ctx context.Context, in *QueryAccountsByFallback, opts ...grpc.CallOption) (*QueryAccountsByFallbackResponse, error)
+	ForwardHistory(ctx context.Context, in *QueryForwardHistory, opts ...grpc.CallOption) (*QueryForwardHistoryResponse, error)
+	Chains(ctx context.Context, in *QueryChains, opts ...grpc.CallOption) (*QueryChainsResponse, error)
+	Audit(ctx context.Context, in *QueryAudit, opts ...grpc.CallOption) (*QueryAuditResponse, error)
 }
 
 type queryClient struct {
@@ -87,6 +129,16 @@ func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, Query_Account_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryStatsResponse)
@@ -107,6 +159,36 @@ func (c *queryClient) StatsByChannel(ctx context.Context, in *QueryStatsByChanne
 	return out, nil
 }
 
+func (c *queryClient) StatsByAccount(ctx context.Context, in *QueryStatsByAccount, opts ...grpc.CallOption) (*QueryStatsByAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStatsByAccountResponse)
+	err := c.cc.Invoke(ctx, Query_StatsByAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsByChain(ctx context.Context, in *QueryStatsByChain, opts ...grpc.CallOption) (*QueryStatsByChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStatsByChainResponse)
+	err := c.cc.Invoke(ctx, Query_StatsByChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_StatsHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetMemo(ctx context.Context, in *QueryMemo, opts ...grpc.CallOption) (*QueryMemoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryMemoResponse)
@@ -127,16 +209,97 @@ func (c *queryClient) GetMemos(ctx context.Context, in *QueryMemos, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_Accounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByChannel(ctx context.Context, in *QueryAccountsByChannel, opts ...grpc.CallOption) (*QueryAccountsByChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountsByChannelResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByRecipient(ctx context.Context, in *QueryAccountsByRecipient, opts ...grpc.CallOption) (*QueryAccountsByRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountsByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByFallback(ctx context.Context, in *QueryAccountsByFallback, opts ...grpc.CallOption) (*QueryAccountsByFallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountsByFallbackResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByFallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardHistory(ctx context.Context, in *QueryForwardHistory, opts ...grpc.CallOption) (*QueryForwardHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryForwardHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ForwardHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Chains(ctx context.Context, in *QueryChains, opts ...grpc.CallOption) (*QueryChainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryChainsResponse)
+	err := c.cc.Invoke(ctx, Query_Chains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Audit(ctx context.Context, in *QueryAudit, opts ...grpc.CallOption) (*QueryAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditResponse)
+	err := c.cc.Invoke(ctx, Query_Audit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
 type QueryServer interface {
 	Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error)
 	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
+	Account(context.Context, *QueryAccount) (*QueryAccountResponse, error)
 	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
 	StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error)
+	StatsByAccount(context.Context, *QueryStatsByAccount) (*QueryStatsByAccountResponse, error)
+	StatsByChain(context.Context, *QueryStatsByChain) (*QueryStatsByChainResponse, error)
+	StatsHistory(context.Context, *QueryStatsHistory) (*QueryStatsHistoryResponse, error)
 	GetMemo(context.Context, *QueryMemo) (*QueryMemoResponse, error)
 	GetMemos(context.Context, *QueryMemos) (*QueryMemosResponse, error)
+	Accounts(context.Context, *QueryAccounts) (*QueryAccountsResponse, error)
+	AccountsByChannel(context.Context, *QueryAccountsByChannel) (*QueryAccountsByChannelResponse, error)
+	AccountsByRecipient(context.Context, *QueryAccountsByRecipient) (*QueryAccountsByRecipientResponse, error)
+	AccountsByFallback(context.Context, *QueryAccountsByFallback) (*QueryAccountsByFallbackResponse, error)
+	ForwardHistory(context.Context, *QueryForwardHistory) (*QueryForwardHistoryResponse, error)
+	Chains(context.Context, *QueryChains) (*QueryChainsResponse, error)
+	Audit(context.Context, *QueryAudit) (*QueryAuditResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -153,18 +316,51 @@ func (UnimplementedQueryServer) Denoms(context.Context, *QueryDenoms) (*QueryDen
 func (UnimplementedQueryServer) Address(context.Context, *QueryAddress) (*QueryAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
 }
+func (UnimplementedQueryServer) Account(context.Context, *QueryAccount) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
 func (UnimplementedQueryServer) Stats(context.Context, *QueryStats) (*QueryStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
 }
 func (UnimplementedQueryServer) StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StatsByChannel not implemented")
 }
+func (UnimplementedQueryServer) StatsByAccount(context.Context, *QueryStatsByAccount) (*QueryStatsByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsByAccount not implemented")
+}
+func (UnimplementedQueryServer) StatsByChain(context.Context, *QueryStatsByChain) (*QueryStatsByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsByChain not implemented")
+}
+func (UnimplementedQueryServer) StatsHistory(context.Context, *QueryStatsHistory) (*QueryStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsHistory not implemented")
+}
 func (UnimplementedQueryServer) GetMemo(context.Context, *QueryMemo) (*QueryMemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
 }
 func (UnimplementedQueryServer) GetMemos(context.Context, *QueryMemos) (*QueryMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemos not implemented")
 }
+func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccounts) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (UnimplementedQueryServer) AccountsByChannel(context.Context, *QueryAccountsByChannel) (*QueryAccountsByChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByChannel not implemented")
+}
+func (UnimplementedQueryServer) AccountsByRecipient(context.Context, *QueryAccountsByRecipient) (*QueryAccountsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByRecipient not implemented")
+}
+func (UnimplementedQueryServer) AccountsByFallback(context.Context, *QueryAccountsByFallback) (*QueryAccountsByFallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByFallback not implemented")
+}
+func (UnimplementedQueryServer) ForwardHistory(context.Context, *QueryForwardHistory) (*QueryForwardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardHistory not implemented")
+}
+func (UnimplementedQueryServer) Chains(context.Context, *QueryChains) (*QueryChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chains not implemented")
+}
+func (UnimplementedQueryServer) Audit(context.Context, *QueryAudit) (*QueryAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -222,6 +418,24 @@ func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Account_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryStats)
 	if err := dec(in); err != nil {
@@ -258,6 +472,60 @@ func _Query_StatsByChannel_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StatsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsByAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StatsByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsByAccount(ctx, req.(*QueryStatsByAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsByChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StatsByChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsByChain(ctx, req.(*QueryStatsByChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StatsHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsHistory(ctx, req.(*QueryStatsHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryMemo)
 	if err := dec(in); err != nil {
@@ -294,6 +562,132 @@ func _Query_GetMemos_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Accounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByChannel(ctx, req.(*QueryAccountsByChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByRecipient(ctx, req.(*QueryAccountsByRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByFallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByFallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByFallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByFallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByFallback(ctx, req.(*QueryAccountsByFallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ForwardHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardHistory(ctx, req.(*QueryForwardHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Chains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChains)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Chains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Chains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Chains(ctx, req.(*QueryChains))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAudit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Audit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Audit(ctx, req.(*QueryAudit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -309,6 +703,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Address",
 			Handler:    _Query_Address_Handler,
 		},
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
 		{
 			MethodName: "Stats",
 			Handler:    _Query_Stats_Handler,
@@ -317,6 +715,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StatsByChannel",
 			Handler:    _Query_StatsByChannel_Handler,
 		},
+		{
+			MethodName: "StatsByAccount",
+			Handler:    _Query_StatsByAccount_Handler,
+		},
+		{
+			MethodName: "StatsByChain",
+			Handler:    _Query_StatsByChain_Handler,
+		},
+		{
+			MethodName: "StatsHistory",
+			Handler:    _Query_StatsHistory_Handler,
+		},
 		{
 			MethodName: "GetMemo",
 			Handler:    _Query_GetMemo_Handler,
@@ -325,6 +735,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMemos",
 			Handler:    _Query_GetMemos_Handler,
 		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "AccountsByChannel",
+			Handler:    _Query_AccountsByChannel_Handler,
+		},
+		{
+			MethodName: "AccountsByRecipient",
+			Handler:    _Query_AccountsByRecipient_Handler,
+		},
+		{
+			MethodName: "AccountsByFallback",
+			Handler:    _Query_AccountsByFallback_Handler,
+		},
+		{
+			MethodName: "ForwardHistory",
+			Handler:    _Query_ForwardHistory_Handler,
+		},
+		{
+			MethodName: "Chains",
+			Handler:    _Query_Chains_Handler,
+		},
+		{
+			MethodName: "Audit",
+			Handler:    _Query_Audit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/forwarding/v1/query.proto",
@@ -18,6 +18,26 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
@@ -39,10 +59,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_RegisterAccount_FullMethodName  = "/noble.forwarding.v1.Msg/RegisterAccount"
-	Msg_ClearAccount_FullMethodName     = "/noble.forwarding.v1.Msg/ClearAccount"
-	Msg_SetAllowedDenoms_FullMethodName = "/noble.forwarding.v1.Msg/SetAllowedDenoms"
-	Msg_SetMemo_FullMethodName          = "/noble.forwarding.v1.Msg/SetMemo"
+	Msg_RegisterAccount_FullMethodName     = "/noble.forwarding.v1.Msg/RegisterAccount"
+	Msg_ClearAccount_FullMethodName        = "/noble.forwarding.v1.Msg/ClearAccount"
+	Msg_SetAllowedDenoms_FullMethodName    = "/noble.forwarding.v1.Msg/SetAllowedDenoms"
+	Msg_SetMemo_FullMethodName             = "/noble.forwarding.v1.Msg/SetMemo"
+	Msg_SetHistoryRetention_FullMethodName = "/noble.forwarding.v1.Msg/SetHistoryRetention"
+	Msg_SetStatsRetention_FullMethodName   = "/noble.forwarding.v1.Msg/SetStatsRetention"
+	Msg_SetChainChannel_FullMethodName     = "/noble.forwarding.v1.Msg/SetChainChannel"
+	Msg_SetRegistrationMode_FullMethodName = "/noble.forwarding.v1.Msg/SetRegistrationMode"
+	Msg_SetForwardMode_FullMethodName      = "/noble.forwarding.v1.Msg/SetForwardMode"
+	Msg_SetSignerlessFee_FullMethodName    = "/noble.forwarding.v1.Msg/SetSignerlessFee"
+	Msg_SetRegistrationFee_FullMethodName  = "/noble.forwarding.v1.Msg/SetRegistrationFee"
 )
 
 // MsgClient is the client API for Msg service.
@@ -53,6 +80,13 @@ type MsgClient interface {
 	ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error)
 	SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error)
 	SetMemo(ctx context.Context, in *MsgSetMemo, opts ...grpc.CallOption) (*MsgSetMemoResponse, error)
+	SetHistoryRetention(ctx context.Context, in *MsgSetHistoryRetention, opts ...grpc.CallOption) (*MsgSetHistoryRetentionResponse, error)
+	SetStatsRetention(ctx context.Context, in *MsgSetStatsRetention, opts ...grpc.CallOption) (*MsgSetStatsRetentionResponse, error)
+	SetChainChannel(ctx context.Context, in *MsgSetChainChannel, opts ...grpc.CallOption) (*MsgSetChainChannelResponse, error)
+	SetRegistrationMode(ctx context.Context, in *MsgSetRegistrationMode, opts ...grpc.CallOption) (*MsgSetRegistrationModeResponse, error)
+	SetForwardMode(ctx context.Context, in *MsgSetForwardMode, opts ...grpc.CallOption) (*MsgSetForwardModeResponse, error)
+	SetSignerlessFee(ctx context.Context, in *MsgSetSignerlessFee, opts ...grpc.CallOption) (*MsgSetSignerlessFeeResponse, error)
+	SetRegistrationFee(ctx context.Context, in *MsgSetRegistrationFee, opts ...grpc.CallOption) (*MsgSetRegistrationFeeResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +137,76 @@ func (c *msgClient) SetMemo(ctx context.Context, in *MsgSetMemo, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) SetHistoryRetention(ctx context.Context, in *MsgSetHistoryRetention, opts ...grpc.CallOption) (*MsgSetHistoryRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetHistoryRetentionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetHistoryRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetStatsRetention(ctx context.Context, in *MsgSetStatsRetention, opts ...grpc.CallOption) (*MsgSetStatsRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetStatsRetentionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetStatsRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChainChannel(ctx context.Context, in *MsgSetChainChannel, opts ...grpc.CallOption) (*MsgSetChainChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetChainChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_SetChainChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRegistrationMode(ctx context.Context, in *MsgSetRegistrationMode, opts ...grpc.CallOption) (*MsgSetRegistrationModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRegistrationModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRegistrationMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetForwardMode(ctx context.Context, in *MsgSetForwardMode, opts ...grpc.CallOption) (*MsgSetForwardModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetForwardModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetForwardMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSignerlessFee(ctx context.Context, in *MsgSetSignerlessFee, opts ...grpc.CallOption) (*MsgSetSignerlessFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetSignerlessFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSignerlessFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRegistrationFee(ctx context.Context, in *MsgSetRegistrationFee, opts ...grpc.CallOption) (*MsgSetRegistrationFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRegistrationFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRegistrationFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -111,6 +215,13 @@ type MsgServer interface {
 	ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error)
 	SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error)
 	SetMemo(context.Context, *MsgSetMemo) (*MsgSetMemoResponse, error)
+	SetHistoryRetention(context.Context, *MsgSetHistoryRetention) (*MsgSetHistoryRetentionResponse, error)
+	SetStatsRetention(context.Context, *MsgSetStatsRetention) (*MsgSetStatsRetentionResponse, error)
+	SetChainChannel(context.Context, *MsgSetChainChannel) (*MsgSetChainChannelResponse, error)
+	SetRegistrationMode(context.Context, *MsgSetRegistrationMode) (*MsgSetRegistrationModeResponse, error)
+	SetForwardMode(context.Context, *MsgSetForwardMode) (*MsgSetForwardModeResponse, error)
+	SetSignerlessFee(context.Context, *MsgSetSignerlessFee) (*MsgSetSignerlessFeeResponse, error)
+	SetRegistrationFee(context.Context, *MsgSetRegistrationFee) (*MsgSetRegistrationFeeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +244,27 @@ func (UnimplementedMsgServer) SetAllowedDenoms(context.Context, *MsgSetAllowedDe
 func (UnimplementedMsgServer) SetMemo(context.Context, *MsgSetMemo) (*MsgSetMemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMemo not implemented")
 }
+func (UnimplementedMsgServer) SetHistoryRetention(context.Context, *MsgSetHistoryRetention) (*MsgSetHistoryRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHistoryRetention not implemented")
+}
+func (UnimplementedMsgServer) SetStatsRetention(context.Context, *MsgSetStatsRetention) (*MsgSetStatsRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStatsRetention not implemented")
+}
+func (UnimplementedMsgServer) SetChainChannel(context.Context, *MsgSetChainChannel) (*MsgSetChainChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChainChannel not implemented")
+}
+func (UnimplementedMsgServer) SetRegistrationMode(context.Context, *MsgSetRegistrationMode) (*MsgSetRegistrationModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRegistrationMode not implemented")
+}
+func (UnimplementedMsgServer) SetForwardMode(context.Context, *MsgSetForwardMode) (*MsgSetForwardModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForwardMode not implemented")
+}
+func (UnimplementedMsgServer) SetSignerlessFee(context.Context, *MsgSetSignerlessFee) (*MsgSetSignerlessFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSignerlessFee not implemented")
+}
+func (UnimplementedMsgServer) SetRegistrationFee(context.Context, *MsgSetRegistrationFee) (*MsgSetRegistrationFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRegistrationFee not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -226,6 +358,132 @@ func _Msg_SetMemo_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHistoryRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHistoryRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHistoryRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetHistoryRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHistoryRetention(ctx, req.(*MsgSetHistoryRetention))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetStatsRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStatsRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetStatsRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetStatsRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStatsRetention(ctx, req.(*MsgSetStatsRetention))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChainChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChainChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChainChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetChainChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChainChannel(ctx, req.(*MsgSetChainChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRegistrationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRegistrationMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRegistrationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRegistrationMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRegistrationMode(ctx, req.(*MsgSetRegistrationMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetForwardMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForwardMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForwardMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetForwardMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForwardMode(ctx, req.(*MsgSetForwardMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSignerlessFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSignerlessFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSignerlessFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSignerlessFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSignerlessFee(ctx, req.(*MsgSetSignerlessFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRegistrationFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRegistrationFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRegistrationFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRegistrationFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRegistrationFee(ctx, req.(*MsgSetRegistrationFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +507,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMemo",
 			Handler:    _Msg_SetMemo_Handler,
 		},
+		{
+			MethodName: "SetHistoryRetention",
+			Handler:    _Msg_SetHistoryRetention_Handler,
+		},
+		{
+			MethodName: "SetStatsRetention",
+			Handler:    _Msg_SetStatsRetention_Handler,
+		},
+		{
+			MethodName: "SetChainChannel",
+			Handler:    _Msg_SetChainChannel_Handler,
+		},
+		{
+			MethodName: "SetRegistrationMode",
+			Handler:    _Msg_SetRegistrationMode_Handler,
+		},
+		{
+			MethodName: "SetForwardMode",
+			Handler:    _Msg_SetForwardMode_Handler,
+		},
+		{
+			MethodName: "SetSignerlessFee",
+			Handler:    _Msg_SetSignerlessFee_Handler,
+		},
+		{
+			MethodName: "SetRegistrationFee",
+			Handler:    _Msg_SetRegistrationFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/forwarding/v1/tx.proto",
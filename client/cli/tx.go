@@ -26,11 +26,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/noble-assets/forwarding/v2/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagAddressVersion = "address-version"
+	FlagFallback       = "fallback"
+)
+
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -41,78 +47,133 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(TxRegisterAccountSignerlessly())
+	cmd.AddCommand(TxClearAccountSignerlessly())
 
 	return cmd
 }
 
-func TxRegisterAccountSignerlessly() *cobra.Command {
+func TxClearAccountSignerlessly() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-account-signerlessly [channel] [recipient] (fallback)",
-		Short: "Signerlessly register a forwarding account for a channel and recipient",
-		Long:  "Signerlessly register a forwarding account for a channel and recipient, with an optional fallback address",
-		Args:  cobra.RangeArgs(2, 3),
+		Use:   "clear-account-signerlessly [address]",
+		Short: "Signerlessly clear funds inside a forwarding account",
+		Long:  "Signerlessly clear funds inside a forwarding account, optionally to its fallback address",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			address := types.GenerateAddress(args[0], args[1], "")
-			if len(args) == 3 {
-				address = types.GenerateAddress(args[0], args[1], args[2])
+			fallback, err := cmd.Flags().GetBool(FlagFallback)
+			if err != nil {
+				return err
 			}
-			msg := &types.MsgRegisterAccount{
-				Signer:    address.String(),
-				Recipient: args[1],
-				Channel:   args[0],
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid account address: %w", err)
 			}
-			if len(args) == 3 {
-				msg.Fallback = args[2]
+			msg := &types.MsgClearAccount{
+				Signer:   args[0],
+				Address:  args[0],
+				Fallback: fallback,
 			}
 
-			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			return broadcastSignerlessTx(cmd, clientCtx, address, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagFallback, false, "clear funds to fallback address, if exists")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRegisterAccountSignerlessly() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-account-signerlessly [channel] [recipient] (fallback)",
+		Short: "Signerlessly register a forwarding account for a channel and recipient",
+		Long:  "Signerlessly register a forwarding account for a channel and recipient, with an optional fallback address",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			builder, err := factory.BuildUnsignedTx(msg)
+
+			rawVersion, err := cmd.Flags().GetUint32(FlagAddressVersion)
 			if err != nil {
 				return err
 			}
-
-			err = builder.SetSignatures(signingtypes.SignatureV2{
-				PubKey: &types.ForwardingPubKey{Key: address},
-				Data: &signingtypes.SingleSignatureData{
-					SignMode:  signingtypes.SignMode_SIGN_MODE_DIRECT,
-					Signature: []byte(""),
-				},
-			})
-			if err != nil {
+			version := types.AddressVersion(rawVersion)
+			if err := types.ValidateAddressVersion(version); err != nil {
 				return err
 			}
 
-			if clientCtx.GenerateOnly {
-				bz, err := clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
-				if err != nil {
-					return err
-				}
-
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+			fallback := ""
+			if len(args) == 3 {
+				fallback = args[2]
 			}
 
-			bz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
-			if err != nil {
-				return err
-			}
-			res, err := clientCtx.BroadcastTx(bz)
-			if err != nil {
-				return err
+			address := types.GenerateAddressWithVersion(types.ResolveAddressVersion(version), args[0], args[1], fallback)
+			msg := &types.MsgRegisterAccount{
+				Signer:         address.String(),
+				Recipient:      args[1],
+				Channel:        args[0],
+				Fallback:       fallback,
+				AddressVersion: version,
 			}
 
-			return clientCtx.PrintProto(res)
+			return broadcastSignerlessTx(cmd, clientCtx, address, msg)
 		},
 	}
 
+	cmd.Flags().Uint32(FlagAddressVersion, 0, "address version used to derive the forwarding account, defaults to the latest")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// broadcastSignerlessTx builds a transaction containing a single message, signs
+// it with the public key of the forwarding account, and broadcasts it.
+func broadcastSignerlessTx(cmd *cobra.Command, clientCtx client.Context, address sdk.AccAddress, msg sdk.Msg) error {
+	factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+	builder, err := factory.BuildUnsignedTx(msg)
+	if err != nil {
+		return err
+	}
+
+	err = builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey: &types.ForwardingPubKey{Key: address},
+		Data: &signingtypes.SingleSignatureData{
+			SignMode:  signingtypes.SignMode_SIGN_MODE_DIRECT,
+			Signature: []byte(""),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		bz, err := clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+	}
+
+	bz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return err
+	}
+	res, err := clientCtx.BroadcastTx(bz)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
@@ -22,7 +22,9 @@ package forwarding
 
 import (
 	"context"
+	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/forwarding/v2/keeper"
 	"github.com/noble-assets/forwarding/v2/types"
@@ -41,16 +43,94 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, genesis types.GenesisSta
 		_ = k.NumOfForwards.Set(ctx, channel, count)
 	}
 
-	for channel, total := range genesis.TotalForwarded {
-		_ = k.TotalForwarded.Set(ctx, channel, total)
+	for channel, rawTotal := range genesis.TotalForwarded {
+		total, err := sdk.ParseCoinsNormalized(rawTotal)
+		if err != nil {
+			panic(fmt.Sprintf("failed to parse total forwarded of %s: %v", channel, err))
+		}
+		for _, coin := range total {
+			_ = k.TotalForwarded.Set(ctx, collections.Join(channel, coin.Denom), coin.Amount)
+		}
 	}
+
+	// NOTE: Genesis exported before retentions were introduced leaves them
+	// zero, so we fall back to the defaults instead of disabling them.
+	if genesis.HistoryRetention != 0 {
+		_ = k.HistoryRetention.Set(ctx, genesis.HistoryRetention)
+	}
+
+	for address, stats := range genesis.AccountStats {
+		_ = k.AccountStats.Set(ctx, address, stats)
+	}
+
+	if genesis.StatsRetention != 0 {
+		_ = k.StatsRetention.Set(ctx, genesis.StatsRetention)
+	}
+
+	for _, bucket := range genesis.StatsBuckets {
+		_ = k.StatsBuckets.Set(ctx, collections.Join3(bucket.Day, bucket.Channel, bucket.Denom), bucket)
+	}
+
+	for chainID, channel := range genesis.ChainChannels {
+		_ = k.ChainChannels.Set(ctx, chainID, channel)
+	}
+
+	_ = k.RegistrationMode.Set(ctx, int32(types.ResolveRegistrationMode(genesis.RegistrationMode)))
+
+	for channel, count := range genesis.NumOfRegistrationFailures {
+		_ = k.NumOfRegistrationFailures.Set(ctx, channel, count)
+	}
+
+	for _, packet := range genesis.InFlightPackets {
+		_ = k.InFlightPackets.Set(ctx, collections.Join(packet.ForwardChannel, packet.ForwardSequence), packet)
+	}
+
+	_ = k.ForwardMode.Set(ctx, int32(types.ResolveForwardMode(genesis.ForwardMode)))
+
+	if !genesis.SignerlessFee.IsNil() && genesis.SignerlessFee.IsPositive() {
+		_ = k.SignerlessFee.Set(ctx, genesis.SignerlessFee)
+	}
+
+	if !genesis.RegistrationFee.IsNil() && genesis.RegistrationFee.IsPositive() {
+		_ = k.RegistrationFee.Set(ctx, genesis.RegistrationFee)
+	}
+
+	for address, fee := range genesis.UnpaidRegistrationFees {
+		_ = k.UnpaidRegistrationFees.Set(ctx, address, fee)
+	}
+
+	for address, sequence := range genesis.HistorySequence {
+		_ = k.HistorySequence.Set(ctx, address, sequence)
+	}
+
+	for _, entry := range genesis.ForwardRecords {
+		_ = k.ForwardRecords.Set(ctx, collections.Join(entry.Address, entry.Index), entry.Record)
+	}
+
+	// NOTE: Forwarding accounts are part of the x/auth genesis, which is
+	// initialized before this module, so we rebuild our index from there.
+	_ = k.IndexAllAccounts(ctx)
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		AllowedDenoms:  k.GetAllowedDenoms(ctx),
-		NumOfAccounts:  k.GetAllNumOfAccounts(ctx),
-		NumOfForwards:  k.GetAllNumOfForwards(ctx),
-		TotalForwarded: k.GetAllTotalForwarded(ctx),
+		AllowedDenoms:             k.GetAllowedDenoms(ctx),
+		NumOfAccounts:             k.GetAllNumOfAccounts(ctx),
+		NumOfForwards:             k.GetAllNumOfForwards(ctx),
+		TotalForwarded:            k.GetAllTotalForwarded(ctx),
+		HistoryRetention:          k.GetHistoryRetention(ctx),
+		AccountStats:              k.GetAllAccountStats(ctx),
+		StatsRetention:            k.GetStatsRetention(ctx),
+		StatsBuckets:              k.GetAllStatsBuckets(ctx),
+		ChainChannels:             k.GetAllChainChannels(ctx),
+		RegistrationMode:          k.GetRegistrationMode(ctx),
+		NumOfRegistrationFailures: k.GetAllNumOfRegistrationFailures(ctx),
+		InFlightPackets:           k.GetAllInFlightPackets(ctx),
+		ForwardMode:               k.GetForwardMode(ctx),
+		SignerlessFee:             k.GetSignerlessFee(ctx),
+		RegistrationFee:           k.GetRegistrationFee(ctx),
+		UnpaidRegistrationFees:    k.GetAllUnpaidRegistrationFees(ctx),
+		HistorySequence:           k.GetAllHistorySequences(ctx),
+		ForwardRecords:            k.GetAllForwardRecords(ctx),
 	}
 }
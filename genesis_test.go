@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarding_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestInitGenesisDefaultsZeroRetentions(t *testing.T) {
+	app, ctx := setupSimApp(t)
+
+	genesis := types.DefaultGenesisState()
+	genesis.HistoryRetention = 0
+	genesis.StatsRetention = 0
+	forwarding.InitGenesis(ctx, app.ForwardingKeeper, *genesis)
+
+	require.Equal(t, types.DefaultHistoryRetention, app.ForwardingKeeper.GetHistoryRetention(ctx))
+	require.Equal(t, types.DefaultStatsRetention, app.ForwardingKeeper.GetStatsRetention(ctx))
+}
+
+func TestInitGenesisRejectsMalformedTotalForwarded(t *testing.T) {
+	app, ctx := setupSimApp(t)
+
+	genesis := types.DefaultGenesisState()
+	genesis.TotalForwarded = map[string]string{"channel-0": "not-coins"}
+	require.Panics(t, func() {
+		forwarding.InitGenesis(ctx, app.ForwardingKeeper, *genesis)
+	})
+}
+
+func TestGenesisExportsForwardHistory(t *testing.T) {
+	app, ctx := setupSimApp(t)
+
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "recipient", "").String()
+	for sequence := range uint64(3) {
+		app.ForwardingKeeper.AppendForwardRecord(ctx, address, sdk.NewInt64Coin("uusdc", 100), sequence+1, types.FORWARD_OUTCOME_SENT)
+	}
+
+	genesis := forwarding.ExportGenesis(ctx, app.ForwardingKeeper)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, map[string]uint64{address: 3}, genesis.HistorySequence)
+	require.Len(t, genesis.ForwardRecords, 3)
+
+	// ACT: Import the exported genesis into a fresh chain.
+	imported, importedCtx := setupSimApp(t)
+	forwarding.InitGenesis(importedCtx, imported.ForwardingKeeper, *genesis)
+
+	exported := forwarding.ExportGenesis(importedCtx, imported.ForwardingKeeper)
+	require.Equal(t, genesis.HistorySequence, exported.HistorySequence)
+	require.Equal(t, genesis.ForwardRecords, exported.ForwardRecords)
+
+	// New records continue the imported history, rather than overwriting it.
+	imported.ForwardingKeeper.AppendForwardRecord(importedCtx, address, sdk.NewInt64Coin("uusdc", 100), 4, types.FORWARD_OUTCOME_SENT)
+	history, err := imported.ForwardingKeeper.ForwardHistory(importedCtx, &types.QueryForwardHistory{Address: address})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 4)
+
+	// ACT: Validate a record that would be overwritten by the next forward.
+	genesis.HistorySequence[address] = 2
+	require.ErrorContains(t, genesis.Validate(), "forward record index must be below the history sequence")
+}
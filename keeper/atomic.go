@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+// ForwardAtomically forwards the amount that a forwarding account received
+// from an incoming transfer, less any unpaid registration fee, and records the transfer as in-flight, so that it
+// is only acknowledged once the forward is acknowledged. The forward is only
+// recorded in the stats and history once it is successfully acknowledged.
+func (k *Keeper) ForwardAtomically(ctx context.Context, account *types.ForwardingAccount, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	amount, unescrowed, err := receivedAmount(packet, data)
+	if err != nil {
+		return err
+	}
+
+	if found, _ := k.AllowedDenoms.Has(ctx, amount.Denom); !found {
+		return sdkerrors.Wrap(types.ErrDenomNotAllowed, amount.Denom)
+	}
+
+	if err := k.ValidateChannel(ctx, account.Channel); err != nil {
+		return err
+	}
+
+	memo, err := k.Memos.Get(ctx, collections.Join(account.Address, amount.Denom))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to get memo: %w", err)
+	}
+
+	// NOTE: The registration fee is held by the module until the forward is
+	// acknowledged, so that it can be returned if the transfer is refunded.
+	fee, err := k.collectRegistrationFee(ctx, account.GetAddress(), amount)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to collect registration fee")
+	}
+	if fee.Equal(amount) {
+		return sdkerrors.Wrapf(types.ErrForwardFailed, "amount doesn't cover the registration fee of %s", fee)
+	}
+
+	msg := k.newForwardTransfer(ctx, account, amount.Sub(fee), memo)
+	if err := msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+	res, err := k.transferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	inFlight := types.NewInFlightPacket(account.Address, account.Channel, res.Sequence, packet, amount, unescrowed, memo, fee)
+	if err := k.InFlightPackets.Set(ctx, collections.Join(account.Channel, res.Sequence), inFlight); err != nil {
+		return fmt.Errorf("failed to set in-flight packet in state: %w", err)
+	}
+
+	return nil
+}
+
+// OnForwardAcknowledged acknowledges the incoming transfer of an atomic
+// forward, refunding it if the forward failed. Acknowledgements of other
+// packets are ignored.
+func (k *Keeper) OnForwardAcknowledged(ctx context.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	if ack.Success() {
+		return k.resolveInFlightPacket(ctx, packet, nil)
+	}
+
+	return k.resolveInFlightPacket(ctx, packet, sdkerrors.Wrap(types.ErrForwardFailed, ack.GetError()))
+}
+
+// OnForwardTimedOut refunds the incoming transfer of an atomic forward that
+// timed out. Timeouts of other packets are ignored.
+func (k *Keeper) OnForwardTimedOut(ctx context.Context, packet channeltypes.Packet) error {
+	return k.resolveInFlightPacket(ctx, packet, types.ErrForwardTimedOut)
+}
+
+// resolveInFlightPacket writes the acknowledgement of the incoming transfer
+// that was forwarded by the given packet, if any. If the forward succeeded,
+// it is recorded and the held registration fee burned, while if it failed,
+// the received amount is refunded first, and an error is acknowledged.
+func (k *Keeper) resolveInFlightPacket(ctx context.Context, packet channeltypes.Packet, forwardErr error) error {
+	if packet.SourcePort != transfertypes.PortID {
+		return nil
+	}
+
+	key := collections.Join(packet.SourceChannel, packet.Sequence)
+	inFlight, err := k.InFlightPackets.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get in-flight packet from state: %w", err)
+	}
+	if err := k.InFlightPackets.Remove(ctx, key); err != nil {
+		return fmt.Errorf("failed to remove in-flight packet from state: %w", err)
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	if forwardErr == nil {
+		account, err := k.GetForwardingAccount(ctx, inFlight.Address)
+		if err != nil {
+			return sdkerrors.Wrap(err, "failed to get forwarding account")
+		}
+
+		if !inFlight.RegistrationFee.IsNil() && inFlight.RegistrationFee.IsPositive() {
+			if err := k.burnRegistrationFee(ctx, inFlight.Address, inFlight.Address, inFlight.RegistrationFee); err != nil {
+				return sdkerrors.Wrap(err, "failed to burn registration fee")
+			}
+		}
+
+		k.recordForward(ctx, account, inFlight.ForwardedAmount(), inFlight.ForwardSequence, inFlight.Memo)
+	} else {
+		if err := k.refundInFlightPacket(ctx, inFlight); err != nil {
+			return sdkerrors.Wrap(err, "failed to refund in-flight packet")
+		}
+		ack = channeltypes.NewErrorAcknowledgement(forwardErr)
+
+		_ = k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardRefunded{
+			Address:  inFlight.Address,
+			Channel:  inFlight.DestinationChannel,
+			Sequence: inFlight.Sequence,
+			Amount:   inFlight.Amount,
+			Reason:   forwardErr.Error(),
+		})
+	}
+
+	return k.channelKeeper.WriteAcknowledgement(sdk.UnwrapSDKContext(ctx), inFlight.Packet(), ack)
+}
+
+// refundInFlightPacket reverts the receipt of an incoming transfer, after the
+// forward has been refunded to the forwarding account, and the registration
+// fee held by the module returned to it. Unescrowed amounts are
+// escrowed again, while minted vouchers are burned, so that the counterparty
+// can safely refund the sender upon an error acknowledgement.
+func (k *Keeper) refundInFlightPacket(ctx context.Context, inFlight types.InFlightPacket) error {
+	address, err := k.accountKeeper.AddressCodec().StringToBytes(inFlight.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to decode forwarding account address")
+	}
+
+	if !inFlight.RegistrationFee.IsNil() && inFlight.RegistrationFee.IsPositive() {
+		if err := k.returnRegistrationFee(ctx, address, inFlight.RegistrationFee); err != nil {
+			return sdkerrors.Wrap(err, "failed to return registration fee")
+		}
+	}
+
+	if inFlight.Unescrowed {
+		escrow := transfertypes.GetEscrowAddress(inFlight.DestinationPort, inFlight.DestinationChannel)
+		return k.transferKeeper.EscrowCoin(sdk.UnwrapSDKContext(ctx), address, escrow, inFlight.Amount)
+	}
+
+	coins := sdk.NewCoins(inFlight.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, transfertypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins)
+}
+
+// receivedAmount returns the amount that an incoming transfer credited on this
+// chain, and whether it was unescrowed, mirroring the receive logic of ICS-20.
+func receivedAmount(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, bool, error) {
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, false, fmt.Errorf("invalid transfer amount: %s", data.Amount)
+	}
+
+	denom := transfertypes.ExtractDenomFromPath(data.Denom)
+	unescrowed := denom.HasPrefix(packet.SourcePort, packet.SourceChannel)
+	if unescrowed {
+		denom.Trace = denom.Trace[1:]
+	} else {
+		denom.Trace = append([]transfertypes.Hop{transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel)}, denom.Trace...)
+	}
+
+	return sdk.NewCoin(denom.IBCDenom(), amount), unescrowed, nil
+}
@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/simapp"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestForwardAtomicallyRejectsDisallowedDenom(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "cosmos1recipient", nil)
+	account, err := app.ForwardingKeeper.GetForwardingAccount(sdkCtx, addr)
+	require.NoError(t, err)
+
+	packet := newIncomingPacket("channel-1", 1)
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", addr, "")
+
+	err = app.ForwardingKeeper.ForwardAtomically(sdkCtx, account, packet, data)
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+	require.ErrorContains(t, err, transfertypes.ExtractDenomFromPath("transfer/channel-1/uatom").IBCDenom())
+}
+
+func TestForwardAtomicallyRecordsForwardOnAcknowledgement(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureActiveChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+	require.NoError(t, app.BankKeeper.SetParams(sdkCtx, banktypes.DefaultParams()))
+	app.TransferKeeper.SetParams(sdkCtx, transfertypes.NewParams(true, true))
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "cosmos1recipient", nil)
+	require.NoError(t, app.ForwardingKeeper.Memos.Set(sdkCtx, collections.Join(addr, "uusdc"), "memo"))
+	account, err := app.ForwardingKeeper.GetForwardingAccount(sdkCtx, addr)
+	require.NoError(t, err)
+
+	amount := sdk.NewInt64Coin("uusdc", 100)
+	fundAccount(t, app, sdkCtx, addr, amount)
+	incoming := newIncomingPacket("channel-1", 7)
+	data := transfertypes.NewFungibleTokenPacketData("transfer/counter-0/uusdc", "100", "cosmos1sender", addr, "")
+
+	// ACT: Forward the received amount.
+	err = app.ForwardingKeeper.ForwardAtomically(sdkCtx, account, incoming, data)
+	require.NoError(t, err)
+
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	require.True(t, app.BankKeeper.GetBalance(sdkCtx, account.GetAddress(), "uusdc").IsZero())
+	require.Equal(t, amount, app.BankKeeper.GetBalance(sdkCtx, escrow, "uusdc"))
+
+	inFlight, err := app.ForwardingKeeper.InFlightPackets.Get(sdkCtx, collections.Join("channel-0", uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, amount, inFlight.Amount)
+	require.True(t, inFlight.Unescrowed)
+	require.Equal(t, "memo", inFlight.Memo)
+
+	// The forward isn't recorded until it's acknowledged.
+	stats, err := app.ForwardingKeeper.StatsByChannel(sdkCtx, &types.QueryStatsByChannel{Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Zero(t, stats.NumOfForwards)
+
+	// ACT: Acknowledge the forward.
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	err = app.ForwardingKeeper.OnForwardAcknowledged(sdkCtx, newForwardPacket("channel-0", 1), channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	require.NoError(t, err)
+
+	stats, err = app.ForwardingKeeper.StatsByChannel(sdkCtx, &types.QueryStatsByChannel{Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.NumOfForwards)
+
+	history, err := app.ForwardingKeeper.ForwardHistory(sdkCtx, &types.QueryForwardHistory{Address: addr})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 1)
+	require.Equal(t, uint64(1), history.Records[0].Sequence)
+	require.Equal(t, types.FORWARD_OUTCOME_SENT, history.Records[0].Outcome)
+
+	executed := findEvent[*types.ForwardExecuted](t, sdkCtx)
+	require.Equal(t, addr, executed.Address)
+	require.Equal(t, amount, executed.Amount)
+	require.Equal(t, uint64(1), executed.Sequence)
+	require.Equal(t, "memo", executed.Memo)
+
+	_, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(sdkCtx, transfertypes.PortID, "channel-1", 7)
+	require.True(t, found)
+}
+
+func TestForwardAtomicallyHoldsRegistrationFee(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureActiveChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.RegistrationFee.Set(sdkCtx, sdk.NewInt64Coin("uusdc", 30)))
+	require.NoError(t, app.BankKeeper.SetParams(sdkCtx, banktypes.DefaultParams()))
+	app.TransferKeeper.SetParams(sdkCtx, transfertypes.NewParams(true, true))
+
+	module := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	data := func(receiver string) transfertypes.FungibleTokenPacketData {
+		return transfertypes.NewFungibleTokenPacketData("transfer/counter-0/uusdc", "100", "cosmos1sender", receiver, "")
+	}
+
+	// ARRANGE: Register an account over IBC, which owes the registration fee.
+	res, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{Recipient: "cosmos1recipient", Channel: "channel-0"})
+	require.NoError(t, err)
+	account, err := app.ForwardingKeeper.GetForwardingAccount(sdkCtx, res.Address)
+	require.NoError(t, err)
+
+	// ACT: Forward an amount that doesn't cover more than the fee, whose
+	// state is discarded along with the error acknowledgement.
+	cachedCtx, _ := sdkCtx.CacheContext()
+	fundAccount(t, app, cachedCtx, res.Address, sdk.NewInt64Coin("uusdc", 30))
+	small := transfertypes.NewFungibleTokenPacketData("transfer/counter-0/uusdc", "30", "cosmos1sender", res.Address, "")
+	err = app.ForwardingKeeper.ForwardAtomically(cachedCtx, account, newIncomingPacket("channel-1", 6), small)
+	require.ErrorIs(t, err, types.ErrForwardFailed)
+	require.ErrorContains(t, err, "doesn't cover the registration fee")
+
+	// ACT: Forward an amount that covers the fee.
+	fundAccount(t, app, sdkCtx, res.Address, sdk.NewInt64Coin("uusdc", 100))
+	err = app.ForwardingKeeper.ForwardAtomically(sdkCtx, account, newIncomingPacket("channel-1", 7), data(res.Address))
+	require.NoError(t, err)
+
+	// ASSERT: The fee is held by the module, and the remainder is forwarded.
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 30), app.BankKeeper.GetBalance(sdkCtx, module, "uusdc"))
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 70), app.BankKeeper.GetBalance(sdkCtx, escrow, "uusdc"))
+	inFlight, err := app.ForwardingKeeper.InFlightPackets.Get(sdkCtx, collections.Join("channel-0", uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 30), inFlight.RegistrationFee)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 70), inFlight.ForwardedAmount())
+
+	// ACT: Acknowledge the forward.
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	err = app.ForwardingKeeper.OnForwardAcknowledged(sdkCtx, newForwardPacket("channel-0", 1), channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	require.NoError(t, err)
+
+	// ASSERT: The held fee is burned.
+	require.True(t, app.BankKeeper.GetBalance(sdkCtx, module, "uusdc").IsZero())
+	paid := findEvent[*types.RegistrationFeePaid](t, sdkCtx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 30), paid.Amount)
+	require.True(t, paid.Remaining.IsZero())
+	executed := findEvent[*types.ForwardExecuted](t, sdkCtx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 70), executed.Amount)
+
+	// ARRANGE: Register another account over IBC, and forward to it.
+	res, err = app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{Recipient: "cosmos1other", Channel: "channel-0"})
+	require.NoError(t, err)
+	account, err = app.ForwardingKeeper.GetForwardingAccount(sdkCtx, res.Address)
+	require.NoError(t, err)
+	fundAccount(t, app, sdkCtx, res.Address, sdk.NewInt64Coin("uusdc", 100))
+	err = app.ForwardingKeeper.ForwardAtomically(sdkCtx, account, newIncomingPacket("channel-1", 8), data(res.Address))
+	require.NoError(t, err)
+
+	// ACT: Fail the forward, after the transfer module refunded it.
+	require.NoError(t, app.BankKeeper.SendCoins(sdkCtx, escrow, account.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 70))))
+	err = app.ForwardingKeeper.OnForwardAcknowledged(sdkCtx, newForwardPacket("channel-0", 2), channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed")))
+	require.NoError(t, err)
+
+	// ASSERT: The held fee is returned and owed again, and the whole amount is refunded.
+	require.True(t, app.BankKeeper.GetBalance(sdkCtx, module, "uusdc").IsZero())
+	require.True(t, app.BankKeeper.GetBalance(sdkCtx, account.GetAddress(), "uusdc").IsZero())
+	refundEscrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), app.BankKeeper.GetBalance(sdkCtx, refundEscrow, "uusdc"))
+	unpaid, err := app.ForwardingKeeper.UnpaidRegistrationFees.Get(sdkCtx, res.Address)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 30), unpaid)
+}
+
+func TestForwardAcknowledgedWritesAcknowledgement(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "cosmos1recipient", nil)
+	incoming := newIncomingPacket("channel-1", 7)
+	setInFlightPacket(t, app, sdkCtx, addr, incoming, sdk.NewInt64Coin("uusdc", 100), true)
+
+	// ACT: Acknowledge an unrelated packet, which is ignored.
+	err := app.ForwardingKeeper.OnForwardAcknowledged(sdkCtx, newForwardPacket("channel-0", 2), channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	require.NoError(t, err)
+	require.False(t, app.IBCKeeper.ChannelKeeper.HasPacketAcknowledgement(sdkCtx, transfertypes.PortID, "channel-1", 7))
+
+	// ACT: Acknowledge the forward.
+	err = app.ForwardingKeeper.OnForwardAcknowledged(sdkCtx, newForwardPacket("channel-0", 1), channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	require.NoError(t, err)
+
+	ack, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(sdkCtx, transfertypes.PortID, "channel-1", 7)
+	require.True(t, found)
+	require.Equal(t, channeltypes.CommitAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()), ack)
+
+	found, err = app.ForwardingKeeper.InFlightPackets.Has(sdkCtx, collections.Join("channel-0", uint64(1)))
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestForwardFailedRefundsUnescrowedAmount(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "cosmos1recipient", nil)
+	amount := sdk.NewInt64Coin("uusdc", 100)
+	fundAccount(t, app, sdkCtx, addr, amount)
+	setInFlightPacket(t, app, sdkCtx, addr, newIncomingPacket("channel-1", 7), amount, true)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	err := app.ForwardingKeeper.OnForwardAcknowledged(sdkCtx, newForwardPacket("channel-0", 1), channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed")))
+	require.NoError(t, err)
+
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+	require.True(t, app.BankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(addr), "uusdc").IsZero())
+	require.Equal(t, amount, app.BankKeeper.GetBalance(sdkCtx, escrow, "uusdc"))
+	require.Equal(t, amount, app.TransferKeeper.GetTotalEscrowForDenom(sdkCtx, "uusdc"))
+
+	ack, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(sdkCtx, transfertypes.PortID, "channel-1", 7)
+	require.True(t, found)
+	require.Equal(t, channeltypes.CommitAcknowledgement(channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed).Acknowledgement()), ack)
+
+	refunded := findEvent[*types.ForwardRefunded](t, sdkCtx)
+	require.Equal(t, addr, refunded.Address)
+	require.Equal(t, "channel-1", refunded.Channel)
+	require.Equal(t, uint64(7), refunded.Sequence)
+	require.Equal(t, amount, refunded.Amount)
+}
+
+func TestForwardTimedOutBurnsMintedAmount(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "cosmos1recipient", nil)
+	amount := sdk.NewInt64Coin(transfertypes.ExtractDenomFromPath("transfer/channel-1/uatom").IBCDenom(), 100)
+	fundAccount(t, app, sdkCtx, addr, amount)
+	setInFlightPacket(t, app, sdkCtx, addr, newIncomingPacket("channel-1", 7), amount, false)
+
+	err := app.ForwardingKeeper.OnForwardTimedOut(sdkCtx, newForwardPacket("channel-0", 1))
+	require.NoError(t, err)
+
+	require.True(t, app.BankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(addr), amount.Denom).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(sdkCtx, amount.Denom).IsZero())
+
+	ack, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(sdkCtx, transfertypes.PortID, "channel-1", 7)
+	require.True(t, found)
+	require.Equal(t, channeltypes.CommitAcknowledgement(channeltypes.NewErrorAcknowledgement(types.ErrForwardTimedOut).Acknowledgement()), ack)
+}
+
+// newIncomingPacket returns a transfer packet received on the given channel.
+func newIncomingPacket(channel string, sequence uint64) channeltypes.Packet {
+	return channeltypes.NewPacket([]byte("data"), sequence, transfertypes.PortID, "counter-0", transfertypes.PortID, channel, clienttypes.ZeroHeight(), 1)
+}
+
+// newForwardPacket returns a transfer packet sent on the given channel.
+func newForwardPacket(channel string, sequence uint64) channeltypes.Packet {
+	return channeltypes.NewPacket([]byte("data"), sequence, transfertypes.PortID, channel, transfertypes.PortID, "counter-0", clienttypes.ZeroHeight(), 1)
+}
+
+// setInFlightPacket records an atomic forward of an incoming packet through
+// the first sequence of the forwarding account's channel.
+func setInFlightPacket(t *testing.T, app *simapp.SimApp, sdkCtx sdk.Context, address string, packet channeltypes.Packet, amount sdk.Coin, unescrowed bool) {
+	t.Helper()
+
+	inFlight := types.NewInFlightPacket(address, "channel-0", 1, packet, amount, unescrowed, "", sdk.NewInt64Coin(amount.Denom, 0))
+	require.NoError(t, app.ForwardingKeeper.InFlightPackets.Set(sdkCtx, collections.Join("channel-0", uint64(1)), inFlight))
+}
+
+// fundAccount mints the given amount to an account.
+func fundAccount(t *testing.T, app *simapp.SimApp, sdkCtx sdk.Context, address string, amount sdk.Coin) {
+	t.Helper()
+
+	coins := sdk.NewCoins(amount)
+	require.NoError(t, app.BankKeeper.MintCoins(sdkCtx, transfertypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, transfertypes.ModuleName, sdk.MustAccAddressFromBech32(address), coins))
+}
+
+// findEvent returns the first typed event of the given type.
+func findEvent[T any](t *testing.T, sdkCtx sdk.Context) T {
+	t.Helper()
+
+	for _, event := range sdkCtx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if typed, ok := msg.(T); ok {
+			return typed
+		}
+	}
+
+	var empty T
+	t.Fatalf("event %T not found", empty)
+	return empty
+}
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+const (
+	NumOfAccountsInvariantName  = "num-of-accounts"
+	TotalForwardedInvariantName = "total-forwarded"
+	MemosInvariantName          = "memos"
+)
+
+// RegisterInvariants registers all x/forwarding invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) { //nolint:staticcheck // required by the deprecated x/crisis interface
+	ir.RegisterRoute(types.ModuleName, NumOfAccountsInvariantName, NumOfAccountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, TotalForwardedInvariantName, TotalForwardedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, MemosInvariantName, MemosInvariant(k))
+}
+
+// AllInvariants runs all x/forwarding invariants.
+func AllInvariants(k *Keeper) sdk.Invariant { //nolint:staticcheck // required by the deprecated x/crisis interface
+	return func(ctx sdk.Context) (string, bool) {
+		return formatInvariant("all", Audit(ctx, k))
+	}
+}
+
+// NumOfAccountsInvariant checks that the number of accounts stored per channel
+// matches the number of forwarding accounts, and that all of them are indexed.
+func NumOfAccountsInvariant(k *Keeper) sdk.Invariant { //nolint:staticcheck // required by the deprecated x/crisis interface
+	return func(ctx sdk.Context) (string, bool) {
+		return formatInvariant(NumOfAccountsInvariantName, k.AuditNumOfAccounts(ctx))
+	}
+}
+
+// TotalForwardedInvariant checks that the total forwarded of every channel
+// is a valid coin per denom.
+func TotalForwardedInvariant(k *Keeper) sdk.Invariant { //nolint:staticcheck // required by the deprecated x/crisis interface
+	return func(ctx sdk.Context) (string, bool) {
+		return formatInvariant(TotalForwardedInvariantName, k.AuditTotalForwarded(ctx))
+	}
+}
+
+// MemosInvariant checks that memos are only stored for existing forwarding
+// accounts.
+func MemosInvariant(k *Keeper) sdk.Invariant { //nolint:staticcheck // required by the deprecated x/crisis interface
+	return func(ctx sdk.Context) (string, bool) {
+		return formatInvariant(MemosInvariantName, k.AuditMemos(ctx))
+	}
+}
+
+func formatInvariant(route string, discrepancies []types.Discrepancy) (string, bool) {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "found %d discrepancies\n", len(discrepancies))
+	for _, discrepancy := range discrepancies {
+		fmt.Fprintf(&msg, "\t%s (%s): %s\n", discrepancy.Key, discrepancy.Invariant, discrepancy.Description)
+	}
+
+	return sdk.FormatInvariant(types.ModuleName, route, msg.String()), len(discrepancies) > 0
+}
+
+// Audit checks the state of the module against all invariants, returning
+// every discrepancy that was found.
+func Audit(ctx context.Context, k *Keeper) (discrepancies []types.Discrepancy) {
+	discrepancies = append(discrepancies, k.AuditNumOfAccounts(ctx)...)
+	discrepancies = append(discrepancies, k.AuditTotalForwarded(ctx)...)
+	discrepancies = append(discrepancies, k.AuditMemos(ctx)...)
+
+	return
+}
+
+// AuditNumOfAccounts compares the number of accounts stored per channel
+// against the forwarding accounts stored in x/auth, and checks that the
+// registered accounts index contains exactly those accounts. x/auth is walked,
+// as the index is maintained by the same code path as the stored counts.
+func (k *Keeper) AuditNumOfAccounts(ctx context.Context) (discrepancies []types.Discrepancy) {
+	actual := make(map[string]uint64)
+	k.accountKeeper.IterateAccounts(ctx, func(rawAccount sdk.AccountI) (stop bool) {
+		account, ok := rawAccount.(*types.ForwardingAccount)
+		if !ok {
+			return false
+		}
+
+		actual[account.Channel] += 1
+
+		if found, _ := k.RegisteredAccounts.Has(ctx, collections.Join(account.Channel, account.Address)); !found {
+			discrepancies = append(discrepancies, types.Discrepancy{
+				Invariant:   NumOfAccountsInvariantName,
+				Key:         account.Address,
+				Description: fmt.Sprintf("forwarding account on %s is not indexed", account.Channel),
+			})
+		}
+
+		return false
+	})
+
+	_ = k.RegisteredAccounts.Walk(ctx, nil, func(key collections.Pair[string, string]) (stop bool, err error) {
+		account, err := k.GetForwardingAccount(ctx, key.K2())
+		switch {
+		case err != nil:
+			discrepancies = append(discrepancies, types.Discrepancy{
+				Invariant:   NumOfAccountsInvariantName,
+				Key:         key.K2(),
+				Description: fmt.Sprintf("indexed account on %s is invalid: %s", key.K1(), err),
+			})
+		case account.Channel != key.K1():
+			discrepancies = append(discrepancies, types.Discrepancy{
+				Invariant:   NumOfAccountsInvariantName,
+				Key:         key.K2(),
+				Description: fmt.Sprintf("indexed account on %s forwards to %s", key.K1(), account.Channel),
+			})
+		}
+
+		return false, nil
+	})
+
+	expected := k.GetAllNumOfAccounts(ctx)
+
+	channels := make([]string, 0, len(expected)+len(actual))
+	for channel := range expected {
+		channels = append(channels, channel)
+	}
+	for channel := range actual {
+		if _, found := expected[channel]; !found {
+			channels = append(channels, channel)
+		}
+	}
+	sort.Strings(channels)
+
+	for _, channel := range channels {
+		if expected[channel] == actual[channel] {
+			continue
+		}
+
+		discrepancies = append(discrepancies, types.Discrepancy{
+			Invariant:   NumOfAccountsInvariantName,
+			Key:         channel,
+			Description: fmt.Sprintf("stored %d accounts, found %d forwarding accounts", expected[channel], actual[channel]),
+		})
+	}
+
+	return
+}
+
+// AuditTotalForwarded checks that the total forwarded of every channel is a
+// valid coin per denom.
+func (k *Keeper) AuditTotalForwarded(ctx context.Context) (discrepancies []types.Discrepancy) {
+	_ = k.TotalForwarded.Walk(ctx, nil, func(key collections.Pair[string, string], amount math.Int) (stop bool, err error) {
+		coin := sdk.Coin{Denom: key.K2(), Amount: amount}
+		if err := coin.Validate(); err != nil {
+			discrepancies = append(discrepancies, types.Discrepancy{
+				Invariant:   TotalForwardedInvariantName,
+				Key:         key.K1(),
+				Description: fmt.Sprintf("invalid total forwarded of %s: %s", key.K2(), err),
+			})
+		}
+
+		return false, nil
+	})
+
+	return
+}
+
+// AuditMemos checks that every stored memo belongs to an existing forwarding
+// account.
+func (k *Keeper) AuditMemos(ctx context.Context) (discrepancies []types.Discrepancy) {
+	_ = k.Memos.Walk(ctx, nil, func(key collections.Pair[string, string], _ string) (stop bool, err error) {
+		if _, err := k.GetForwardingAccount(ctx, key.K1()); err != nil {
+			discrepancies = append(discrepancies, types.Discrepancy{
+				Invariant:   MemosInvariantName,
+				Key:         key.K1(),
+				Description: fmt.Sprintf("memo for %s references an invalid account: %s", key.K2(), err),
+			})
+		}
+
+		return false, nil
+	})
+
+	return
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/keeper"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestAuditNumOfAccounts(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipientone", nil)
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipienttwo", nil)
+	require.Empty(t, app.ForwardingKeeper.AuditNumOfAccounts(sdkCtx))
+
+	// ACT: Store a forwarding account in x/auth without registering it.
+	unregistered := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-1", "iaa1unregistered", "")
+	app.AccountKeeper.SetAccount(sdkCtx, app.AccountKeeper.NewAccount(sdkCtx, &types.ForwardingAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(unregistered),
+		Channel:     "channel-1",
+		Recipient:   "iaa1unregistered",
+	}))
+
+	discrepancies := app.ForwardingKeeper.AuditNumOfAccounts(sdkCtx)
+	require.Len(t, discrepancies, 2)
+	require.Equal(t, unregistered.String(), discrepancies[0].Key)
+	require.Equal(t, "forwarding account on channel-1 is not indexed", discrepancies[0].Description)
+	require.Equal(t, "channel-1", discrepancies[1].Key)
+	require.Equal(t, "stored 0 accounts, found 1 forwarding accounts", discrepancies[1].Description)
+
+	// ACT: Remove a registered account from the index, keeping its count.
+	app, sdkCtx = setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	first := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipientone", nil)
+	require.NoError(t, app.ForwardingKeeper.RegisteredAccounts.Remove(sdkCtx, collections.Join("channel-0", first)))
+
+	discrepancies = app.ForwardingKeeper.AuditNumOfAccounts(sdkCtx)
+	require.Len(t, discrepancies, 1)
+	require.Equal(t, first, discrepancies[0].Key)
+	require.Equal(t, keeper.NumOfAccountsInvariantName, discrepancies[0].Invariant)
+
+	// ACT: Index an account under the wrong channel, and a non-forwarding account.
+	require.NoError(t, app.ForwardingKeeper.RegisteredAccounts.Set(sdkCtx, collections.Join("channel-0", first)))
+	require.NoError(t, app.ForwardingKeeper.RegisteredAccounts.Set(sdkCtx, collections.Join("channel-1", first)))
+	unknown := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, app.ForwardingKeeper.RegisteredAccounts.Set(sdkCtx, collections.Join("channel-0", unknown)))
+
+	discrepancies = app.ForwardingKeeper.AuditNumOfAccounts(sdkCtx)
+	require.Len(t, discrepancies, 2)
+	keys := []string{discrepancies[0].Key, discrepancies[1].Key}
+	require.ElementsMatch(t, []string{first, unknown}, keys)
+}
+
+func TestAuditTotalForwarded(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+
+	app.ForwardingKeeper.IncrementTotalForwarded(sdkCtx, "channel-0", sdk.NewInt64Coin("uusdc", 100))
+	require.Empty(t, app.ForwardingKeeper.AuditTotalForwarded(sdkCtx))
+
+	// ACT: Store a negative total, and a total of an invalid denom.
+	require.NoError(t, app.ForwardingKeeper.TotalForwarded.Set(sdkCtx, collections.Join("channel-1", "uusdc"), sdkmath.NewInt(-1)))
+	require.NoError(t, app.ForwardingKeeper.TotalForwarded.Set(sdkCtx, collections.Join("channel-2", "!"), sdkmath.NewInt(1)))
+
+	discrepancies := app.ForwardingKeeper.AuditTotalForwarded(sdkCtx)
+	require.Len(t, discrepancies, 2)
+	require.Equal(t, keeper.TotalForwardedInvariantName, discrepancies[0].Invariant)
+	require.Equal(t, "channel-1", discrepancies[0].Key)
+	require.Contains(t, discrepancies[0].Description, "invalid total forwarded of uusdc")
+	require.Equal(t, "channel-2", discrepancies[1].Key)
+}
+
+func TestAuditMemos(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", []types.MemoEntry{{Denom: "uusdc", Memo: "memo-usdc"}})
+	require.Empty(t, app.ForwardingKeeper.AuditMemos(sdkCtx))
+
+	// ACT: Store a memo for an account that isn't a forwarding account.
+	unknown := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, app.ForwardingKeeper.Memos.Set(sdkCtx, collections.Join(unknown, "uusdc"), "memo-usdc"))
+
+	discrepancies := app.ForwardingKeeper.AuditMemos(sdkCtx)
+	require.Len(t, discrepancies, 1)
+	require.Equal(t, keeper.MemosInvariantName, discrepancies[0].Invariant)
+	require.Equal(t, unknown, discrepancies[0].Key)
+	require.Contains(t, discrepancies[0].Description, "memo for uusdc references an invalid account")
+}
+
+func TestRegisterInvariants(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+
+	registry := make(invariantRegistry)
+	keeper.RegisterInvariants(registry, app.ForwardingKeeper)
+	require.Len(t, registry, 3)
+
+	for _, route := range []string{keeper.NumOfAccountsInvariantName, keeper.TotalForwardedInvariantName, keeper.MemosInvariantName} {
+		invariant, found := registry[types.ModuleName+"/"+route]
+		require.True(t, found, route)
+
+		_, broken := invariant(sdkCtx)
+		require.False(t, broken, route)
+	}
+
+	// ACT: Break the state checked by every invariant.
+	unknown := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, app.ForwardingKeeper.NumOfAccounts.Set(sdkCtx, "channel-0", 2))
+	require.NoError(t, app.ForwardingKeeper.TotalForwarded.Set(sdkCtx, collections.Join("channel-0", "uusdc"), sdkmath.NewInt(-1)))
+	require.NoError(t, app.ForwardingKeeper.Memos.Set(sdkCtx, collections.Join(unknown, "uusdc"), "memo-usdc"))
+
+	for route, invariant := range registry {
+		msg, broken := invariant(sdkCtx)
+		require.True(t, broken, route)
+		require.Contains(t, msg, "found 1 discrepancies")
+	}
+}
+
+// invariantRegistry collects registered invariants by module and route.
+type invariantRegistry map[string]sdk.Invariant //nolint:staticcheck // required by the deprecated x/crisis interface
+
+func (r invariantRegistry) RegisterRoute(moduleName, route string, invariant sdk.Invariant) { //nolint:staticcheck // required by the deprecated x/crisis interface
+	r[moduleName+"/"+route] = invariant
+}
@@ -31,12 +31,15 @@ import (
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 	"github.com/noble-assets/forwarding/v2/types"
 )
 
@@ -54,12 +57,30 @@ type Keeper struct {
 
 	authority string
 
-	Schema         collections.Schema
-	AllowedDenoms  collections.KeySet[string]
-	NumOfAccounts  collections.Map[string, uint64]
-	NumOfForwards  collections.Map[string, uint64]
-	TotalForwarded collections.Map[string, string]
-	Memos          collections.Map[collections.Pair[string, string], string]
+	Schema             collections.Schema
+	AllowedDenoms      collections.KeySet[string]
+	NumOfAccounts      collections.Map[string, uint64]
+	NumOfForwards      collections.Map[string, uint64]
+	TotalForwarded     collections.Map[collections.Pair[string, string], math.Int]
+	Memos              collections.Map[collections.Pair[string, string], string]
+	RegisteredAccounts collections.KeySet[collections.Pair[string, string]]
+	RecipientAccounts  collections.KeySet[collections.Pair[string, string]]
+	FallbackAccounts   collections.KeySet[collections.Pair[string, string]]
+	HistoryRetention   collections.Item[uint64]
+	HistorySequence    collections.Map[string, uint64]
+	ForwardRecords     collections.Map[collections.Pair[string, uint64], types.ForwardRecord]
+	AccountStats       collections.Map[string, types.AccountStats]
+	StatsRetention     collections.Item[uint64]
+	StatsBuckets       collections.Map[collections.Triple[uint64, string, string], types.StatsBucket]
+	ChainChannels      collections.Map[string, string]
+
+	RegistrationMode          collections.Item[int32]
+	NumOfRegistrationFailures collections.Map[string, uint64]
+	InFlightPackets           collections.Map[collections.Pair[string, uint64], types.InFlightPacket]
+	ForwardMode               collections.Item[int32]
+	SignerlessFee             collections.Item[sdk.Coin]
+	RegistrationFee           collections.Item[sdk.Coin]
+	UnpaidRegistrationFees    collections.Map[string, sdk.Coin]
 
 	TransientSchema collections.Schema
 	PendingForwards collections.Map[string, types.ForwardingAccount]
@@ -68,6 +89,8 @@ type Keeper struct {
 	bankKeeper     types.BankKeeper
 	channelKeeper  types.ChannelKeeper
 	transferKeeper types.TransferKeeper
+
+	chainIdResolvers types.ChainIdResolvers
 }
 
 func NewKeeper(
@@ -96,11 +119,29 @@ func NewKeeper(
 
 		authority: authority,
 
-		AllowedDenoms:  collections.NewKeySet(builder, types.AllowedDenomsPrefix, "allowed_denoms", collections.StringKey),
-		NumOfAccounts:  collections.NewMap(builder, types.NumOfAccountsPrefix, "num_of_accounts", collections.StringKey, collections.Uint64Value),
-		NumOfForwards:  collections.NewMap(builder, types.NumOfForwardsPrefix, "num_of_forwards", collections.StringKey, collections.Uint64Value),
-		TotalForwarded: collections.NewMap(builder, types.TotalForwardedPrefix, "total_forwarded", collections.StringKey, collections.StringValue),
-		Memos:          collections.NewMap(builder, types.MemosPrefix, "memos", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		AllowedDenoms:      collections.NewKeySet(builder, types.AllowedDenomsPrefix, "allowed_denoms", collections.StringKey),
+		NumOfAccounts:      collections.NewMap(builder, types.NumOfAccountsPrefix, "num_of_accounts", collections.StringKey, collections.Uint64Value),
+		NumOfForwards:      collections.NewMap(builder, types.NumOfForwardsPrefix, "num_of_forwards", collections.StringKey, collections.Uint64Value),
+		TotalForwarded:     collections.NewMap(builder, types.TotalForwardedPrefix, "total_forwarded", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		Memos:              collections.NewMap(builder, types.MemosPrefix, "memos", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		RegisteredAccounts: collections.NewKeySet(builder, types.RegisteredAccountsPrefix, "registered_accounts", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		RecipientAccounts:  collections.NewKeySet(builder, types.RecipientAccountsPrefix, "recipient_accounts", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		FallbackAccounts:   collections.NewKeySet(builder, types.FallbackAccountsPrefix, "fallback_accounts", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		HistoryRetention:   collections.NewItem(builder, types.HistoryRetentionPrefix, "history_retention", collections.Uint64Value),
+		HistorySequence:    collections.NewMap(builder, types.HistorySequencePrefix, "history_sequence", collections.StringKey, collections.Uint64Value),
+		ForwardRecords:     collections.NewMap(builder, types.ForwardRecordsPrefix, "forward_records", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.ForwardRecord](cdc)),
+		AccountStats:       collections.NewMap(builder, types.AccountStatsPrefix, "account_stats", collections.StringKey, codec.CollValue[types.AccountStats](cdc)),
+		StatsRetention:     collections.NewItem(builder, types.StatsRetentionPrefix, "stats_retention", collections.Uint64Value),
+		StatsBuckets:       collections.NewMap(builder, types.StatsBucketsPrefix, "stats_buckets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.StatsBucket](cdc)),
+		ChainChannels:      collections.NewMap(builder, types.ChainChannelsPrefix, "chain_channels", collections.StringKey, collections.StringValue),
+
+		RegistrationMode:          collections.NewItem(builder, types.RegistrationModePrefix, "registration_mode", collections.Int32Value),
+		NumOfRegistrationFailures: collections.NewMap(builder, types.RegistrationFailuresPrefix, "num_of_registration_failures", collections.StringKey, collections.Uint64Value),
+		InFlightPackets:           collections.NewMap(builder, types.InFlightPacketsPrefix, "in_flight_packets", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.InFlightPacket](cdc)),
+		ForwardMode:               collections.NewItem(builder, types.ForwardModePrefix, "forward_mode", collections.Int32Value),
+		SignerlessFee:             collections.NewItem(builder, types.SignerlessFeePrefix, "signerless_fee", codec.CollValue[sdk.Coin](cdc)),
+		RegistrationFee:           collections.NewItem(builder, types.RegistrationFeePrefix, "registration_fee", codec.CollValue[sdk.Coin](cdc)),
+		UnpaidRegistrationFees:    collections.NewMap(builder, types.UnpaidRegistrationFeesPrefix, "unpaid_registration_fees", collections.StringKey, codec.CollValue[sdk.Coin](cdc)),
 
 		PendingForwards: collections.NewMap(transientBuilder, types.PendingForwardsPrefix, "pending_forwards", collections.StringKey, codec.CollValue[types.ForwardingAccount](cdc)),
 
@@ -108,6 +149,8 @@ func NewKeeper(
 		bankKeeper:     bankKeeper,
 		channelKeeper:  channelKeeper,
 		transferKeeper: transferKeeper,
+
+		chainIdResolvers: types.DefaultChainIdResolvers(),
 	}
 
 	schema, err := builder.Build()
@@ -126,60 +169,228 @@ func NewKeeper(
 
 // ExecuteForwards is an end block hook that clears all pending forwards from transient state.
 func (k *Keeper) ExecuteForwards(ctx context.Context) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), types.MetricKeyExecuteForwards)
+
 	denoms := k.GetAllowedDenoms(ctx)
 
 	forwards := k.GetPendingForwards(ctx)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(len(forwards)), types.MetricKeyPendingForwards)
 	if len(forwards) > 0 {
 		k.Logger().Info(fmt.Sprintf("executing %d automatic forward(s)", len(forwards)))
 	}
 
+	summary := types.ForwardsSummary{NumOfAccounts: uint64(len(forwards))}
 	for _, forward := range forwards {
-		channel, _ := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, forward.Channel)
-		if channel.State != channeltypes.OPEN {
-			k.Logger().Error("skipped automatic forward due to non open channel", "channel", forward.Channel, "address", forward.GetAddress().String(), "state", channel.State.String())
+		k.executeForward(ctx, &forward, denoms, &summary)
+	}
+
+	if len(forwards) > 0 {
+		_ = k.eventService.EventManager(ctx).Emit(ctx, &summary)
+	}
+
+	k.PruneStatsBuckets(ctx)
+
+	// NOTE: As pending forwards are stored in transient state, they are automatically cleared at the end of the block lifecycle. No further action is required.
+}
+
+// ExecuteForward immediately forwards the balance of a forwarding account,
+// removing it from the pending forwards of the current block.
+func (k *Keeper) ExecuteForward(ctx context.Context, forward *types.ForwardingAccount) {
+	_ = k.PendingForwards.Remove(ctx, forward.Address)
+
+	var summary types.ForwardsSummary
+	k.executeForward(ctx, forward, k.GetAllowedDenoms(ctx), &summary)
+}
+
+// executeForward forwards the balance of every allowed denom held by a
+// forwarding account, counting the outcomes in the given summary.
+func (k *Keeper) executeForward(ctx context.Context, forward *types.ForwardingAccount, denoms []string, summary *types.ForwardsSummary) {
+	channel, _ := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, forward.Channel)
+	if channel.State != channeltypes.OPEN {
+		k.Logger().Error("skipped automatic forward due to non open channel", "channel", forward.Channel, "address", forward.GetAddress().String(), "state", channel.State.String())
+		summary.NumOfSkipped += 1
+		incrForwardCounter(types.ForwardOutcomeSkipped, forward.Channel, "")
+		_ = k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardSkipped{
+			Address: forward.Address,
+			Channel: forward.Channel,
+			Reason:  fmt.Sprintf("channel is not open: %s", channel.State.String()),
+		})
+		return
+	}
+
+	for _, denom := range denoms {
+		balance := k.bankKeeper.GetBalance(ctx, forward.GetAddress(), denom)
+		if balance.IsZero() {
 			continue
 		}
 
-		for _, denom := range denoms {
-			balance := k.bankKeeper.GetBalance(ctx, forward.GetAddress(), denom)
-			if balance.IsZero() {
-				continue
-			}
-
-			// fetch memo if it exists and use it for the transfer
-			memo, err := k.Memos.Get(ctx, collections.Join(forward.GetAddress().String(), denom))
-			if err != nil && !errors.Is(err, collections.ErrNotFound) {
-				k.Logger().Error("failed to get memo for automatic forward", "channel", forward.Channel, "address", forward.GetAddress().String(), "denom", denom, "err", err)
-				continue
-			}
-
-			timeout := uint64(k.headerService.GetHeaderInfo(ctx).Time.UnixNano()) + defaultRelativePacketTimeoutTimestamp
-			msg := &transfertypes.MsgTransfer{
-				SourcePort:       transfertypes.PortID,
-				SourceChannel:    forward.Channel,
-				Token:            balance,
-				Sender:           forward.Address,
-				Receiver:         forward.Recipient,
-				TimeoutHeight:    clienttypes.ZeroHeight(),
-				TimeoutTimestamp: timeout,
-				Memo:             memo,
-			}
-			if err := msg.ValidateBasic(); err != nil {
-				k.Logger().Error("ibc message validation failed", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
-				continue
-			}
-			_, err = k.transferKeeper.Transfer(ctx, msg)
-			if err != nil {
-				// TODO: Consider moving to persistent store in order to retry in future blocks?
-				k.Logger().Error("unable to execute automatic forward", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
-			} else {
-				k.IncrementNumOfForwards(ctx, forward.Channel)
-				k.IncrementTotalForwarded(ctx, forward.Channel, balance)
-			}
+		balance = k.PayRegistrationFee(ctx, forward.GetAddress(), balance)
+		if balance.IsZero() {
+			continue
 		}
+
+		// fetch memo if it exists and use it for the transfer
+		memo, err := k.Memos.Get(ctx, collections.Join(forward.GetAddress().String(), denom))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			k.Logger().Error("failed to get memo for automatic forward", "channel", forward.Channel, "address", forward.GetAddress().String(), "denom", denom, "err", err)
+			k.AppendForwardRecord(ctx, forward.Address, balance, 0, types.FORWARD_OUTCOME_FAILED)
+			summary.NumOfFailed += 1
+			incrMemoLookupErrorCounter(forward.Channel, denom)
+			incrForwardCounter(types.ForwardOutcomeFailed, forward.Channel, denom)
+			_ = k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardFailed{
+				Address:   forward.Address,
+				Channel:   forward.Channel,
+				Recipient: forward.Recipient,
+				Amount:    balance,
+				Reason:    fmt.Sprintf("failed to get memo: %s", err),
+			})
+			continue
+		}
+
+		msg := k.newForwardTransfer(ctx, forward, balance, memo)
+		if err := msg.ValidateBasic(); err != nil {
+			k.Logger().Error("ibc message validation failed", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
+			k.AppendForwardRecord(ctx, forward.Address, balance, 0, types.FORWARD_OUTCOME_FAILED)
+			summary.NumOfSkipped += 1
+			incrForwardCounter(types.ForwardOutcomeSkipped, forward.Channel, denom)
+			_ = k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardSkipped{
+				Address: forward.Address,
+				Channel: forward.Channel,
+				Denom:   denom,
+				Reason:  fmt.Sprintf("ibc message validation failed: %s", err),
+			})
+			continue
+		}
+		res, err := k.transferKeeper.Transfer(ctx, msg)
+		if err != nil {
+			// TODO: Consider moving to persistent store in order to retry in future blocks?
+			k.Logger().Error("unable to execute automatic forward", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
+			k.AppendForwardRecord(ctx, forward.Address, balance, 0, types.FORWARD_OUTCOME_FAILED)
+			summary.NumOfFailed += 1
+			incrForwardCounter(types.ForwardOutcomeFailed, forward.Channel, denom)
+			_ = k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardFailed{
+				Address:   forward.Address,
+				Channel:   forward.Channel,
+				Recipient: forward.Recipient,
+				Amount:    balance,
+				Reason:    err.Error(),
+			})
+		} else {
+			k.recordForward(ctx, forward, balance, res.Sequence, memo)
+			summary.NumOfExecuted += 1
+		}
+	}
+}
+
+// PayRegistrationFee burns as much of the unpaid registration fee of a
+// forwarding account as the given balance covers, returning the remainder of
+// the balance. Balances in other denoms are returned unchanged.
+func (k *Keeper) PayRegistrationFee(ctx context.Context, address sdk.AccAddress, balance sdk.Coin) sdk.Coin {
+	cachedCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	fee, err := k.collectRegistrationFee(cachedCtx, address, balance)
+	if err == nil && fee.IsPositive() {
+		err = k.burnRegistrationFee(cachedCtx, address.String(), address.String(), fee)
+	}
+	if err != nil {
+		k.Logger().Error("failed to deduct registration fee", "address", address.String(), "amount", fee.String(), "err", err)
+		return balance
 	}
 
-	// NOTE: As pending forwards are stored in transient state, they are automatically cleared at the end of the block lifecycle. No further action is required.
+	writeCache()
+	return balance.Sub(fee)
+}
+
+// collectRegistrationFee moves as much of the unpaid registration fee of a
+// forwarding account as the given balance covers to the module account,
+// returning the collected amount.
+func (k *Keeper) collectRegistrationFee(ctx context.Context, address sdk.AccAddress, balance sdk.Coin) (sdk.Coin, error) {
+	unpaid, err := k.UnpaidRegistrationFees.Get(ctx, address.String())
+	if err != nil || unpaid.Denom != balance.Denom {
+		return sdk.NewCoin(balance.Denom, math.ZeroInt()), nil
+	}
+
+	fee := sdk.NewCoin(unpaid.Denom, math.MinInt(unpaid.Amount, balance.Amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return fee, err
+	}
+
+	if remaining := unpaid.Sub(fee); remaining.IsZero() {
+		err = k.UnpaidRegistrationFees.Remove(ctx, address.String())
+	} else {
+		err = k.UnpaidRegistrationFees.Set(ctx, address.String(), remaining)
+	}
+
+	return fee, err
+}
+
+// returnRegistrationFee returns a registration fee collected from a
+// forwarding account, which owes it again.
+func (k *Keeper) returnRegistrationFee(ctx context.Context, address sdk.AccAddress, fee sdk.Coin) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(fee)); err != nil {
+		return err
+	}
+
+	if unpaid, err := k.UnpaidRegistrationFees.Get(ctx, address.String()); err == nil {
+		fee = fee.Add(unpaid)
+	}
+
+	return k.UnpaidRegistrationFees.Set(ctx, address.String(), fee)
+}
+
+// burnRegistrationFee burns a registration fee held by the module account,
+// that was paid for a forwarding account.
+func (k *Keeper) burnRegistrationFee(ctx context.Context, address string, payer string, fee sdk.Coin) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return err
+	}
+
+	remaining, err := k.UnpaidRegistrationFees.Get(ctx, address)
+	if err != nil {
+		remaining = sdk.NewCoin(fee.Denom, math.ZeroInt())
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.RegistrationFeePaid{
+		Address:   address,
+		Amount:    fee,
+		Remaining: remaining,
+		Payer:     payer,
+	})
+}
+
+// newForwardTransfer returns the outbound transfer of a forward.
+func (k *Keeper) newForwardTransfer(ctx context.Context, forward *types.ForwardingAccount, amount sdk.Coin, memo string) *transfertypes.MsgTransfer {
+	timeout := uint64(k.headerService.GetHeaderInfo(ctx).Time.UnixNano()) + defaultRelativePacketTimeoutTimestamp
+
+	return &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    forward.Channel,
+		Token:            amount,
+		Sender:           forward.Address,
+		Receiver:         forward.Recipient,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: timeout,
+		Memo:             memo,
+	}
+}
+
+// recordForward updates the statistics and history of a sent forward, and
+// emits an event describing it.
+func (k *Keeper) recordForward(ctx context.Context, forward *types.ForwardingAccount, amount sdk.Coin, sequence uint64, memo string) {
+	k.IncrementNumOfForwards(ctx, forward.Channel)
+	k.IncrementTotalForwarded(ctx, forward.Channel, amount)
+	k.IncrementAccountStats(ctx, forward.Address, amount)
+	k.IncrementStatsBucket(ctx, forward.Channel, amount)
+	k.AppendForwardRecord(ctx, forward.Address, amount, sequence, types.FORWARD_OUTCOME_SENT)
+	incrForwardCounter(types.ForwardOutcomeExecuted, forward.Channel, amount.Denom)
+	_ = k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardExecuted{
+		Address:   forward.Address,
+		Channel:   forward.Channel,
+		Recipient: forward.Recipient,
+		Amount:    amount,
+		Sequence:  sequence,
+		Memo:      memo,
+	})
 }
 
 // SendRestrictionFn checks every transfer executed on the Noble chain to see if
@@ -197,7 +408,7 @@ func (k *Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.Acc
 
 	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, account.Channel)
 	if !fromAddr.Equals(escrow) {
-		k.SetPendingForward(ctx, account)
+		k.SetPendingForward(ctx, account, types.QUEUE_TRIGGER_BANK_SEND, fromAddr.String())
 	}
 
 	return toAddr, nil
@@ -210,6 +421,38 @@ func (k *Keeper) SetIBCKeepers(channelKeeper types.ChannelKeeper, transferKeeper
 	k.transferKeeper = transferKeeper
 }
 
+// SetChainIdResolver registers the resolver used to extract chain IDs from
+// light clients of the given client type, overriding any existing resolver.
+// This allows apps to support light clients that ibc-go doesn't provide.
+func (k *Keeper) SetChainIdResolver(clientType string, resolver types.ChainIdResolver) {
+	k.chainIdResolvers[clientType] = resolver
+}
+
+// GetChannelChainId returns the client type of a transfer channel's light
+// client, along with the chain ID of the counterparty chain it tracks.
+func (k *Keeper) GetChannelChainId(ctx context.Context, channelID string) (clientType string, chainId string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// NOTE: The localhost client doesn't store a client state, and tracks
+	// this chain.
+	channel, found := k.channelKeeper.GetChannel(sdkCtx, transfertypes.PortID, channelID)
+	if found && len(channel.ConnectionHops) > 0 && channel.ConnectionHops[0] == exported.LocalhostConnectionID {
+		return exported.Localhost, sdkCtx.ChainID()
+	}
+
+	_, clientState, err := k.channelKeeper.GetChannelClientState(sdkCtx, transfertypes.PortID, channelID)
+	if err != nil {
+		return "", types.UnknownChainId
+	}
+
+	return clientState.ClientType(), k.chainIdResolvers.Resolve(clientState)
+}
+
+// GetAuthority returns the address that is allowed to update the module's configuration.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k *Keeper) Logger() log.Logger {
 	return k.logger.With("module", types.ModuleName)
 }
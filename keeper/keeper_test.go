@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestExecuteForwardsEmitsSkippedEvents(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+	account, err := app.ForwardingKeeper.GetForwardingAccount(sdkCtx, addr)
+	require.NoError(t, err)
+
+	channel := channeltypes.NewChannel(
+		channeltypes.CLOSED,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, "counter-0"),
+		[]string{"connection-0"},
+		transfertypes.V1,
+	)
+	app.IBCKeeper.ChannelKeeper.SetChannel(sdkCtx, transfertypes.PortID, "channel-0", channel)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.ForwardingKeeper.PendingForwards.Set(sdkCtx, account.Address, *account))
+	app.ForwardingKeeper.ExecuteForwards(sdkCtx)
+
+	events := sdkCtx.EventManager().ABCIEvents()
+	require.Len(t, events, 2)
+
+	rawSkipped, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	skipped, ok := rawSkipped.(*types.ForwardSkipped)
+	require.True(t, ok)
+	require.Equal(t, addr, skipped.Address)
+	require.Equal(t, "channel-0", skipped.Channel)
+	require.Empty(t, skipped.Denom)
+
+	rawSummary, err := sdk.ParseTypedEvent(events[1])
+	require.NoError(t, err)
+	require.Equal(t, &types.ForwardsSummary{NumOfAccounts: 1, NumOfSkipped: 1}, rawSummary)
+}
+
+func TestExecuteForwardsSkipsInvalidTransfer(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+	amount := sdk.NewInt64Coin("uusdc", 100)
+	fundAccount(t, app, sdkCtx, addr, amount)
+
+	// ARRANGE: Queue the account with a blank recipient, which fails validation.
+	account, err := app.ForwardingKeeper.GetForwardingAccount(sdkCtx, addr)
+	require.NoError(t, err)
+	account.Recipient = ""
+	require.NoError(t, app.ForwardingKeeper.PendingForwards.Set(sdkCtx, account.Address, *account))
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	app.ForwardingKeeper.ExecuteForwards(sdkCtx)
+
+	skipped := findEvent[*types.ForwardSkipped](t, sdkCtx)
+	require.Equal(t, addr, skipped.Address)
+	require.Equal(t, "uusdc", skipped.Denom)
+	require.Contains(t, skipped.Reason, "ibc message validation failed")
+	summary := findEvent[*types.ForwardsSummary](t, sdkCtx)
+	require.Equal(t, &types.ForwardsSummary{NumOfAccounts: 1, NumOfSkipped: 1}, summary)
+
+	history, err := app.ForwardingKeeper.ForwardHistory(sdkCtx, &types.QueryForwardHistory{Address: addr})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 1)
+	require.Equal(t, types.FORWARD_OUTCOME_FAILED, history.Records[0].Outcome)
+}
+
+func TestExecuteForwardsWithoutPendingForwardsEmitsNothing(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	app.ForwardingKeeper.ExecuteForwards(sdkCtx)
+
+	require.Empty(t, sdkCtx.EventManager().Events())
+}
+
+func TestExecuteForwardSharesAccounting(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+	amount := sdk.NewInt64Coin("uusdc", 100)
+	fundAccount(t, app, sdkCtx, addr, amount)
+
+	pending, err := app.ForwardingKeeper.PendingForwards.Has(sdkCtx, addr)
+	require.NoError(t, err)
+	require.True(t, pending)
+
+	account, err := app.ForwardingKeeper.GetForwardingAccount(sdkCtx, addr)
+	require.NoError(t, err)
+
+	// ACT: Forward immediately, which fails as transfers are disabled.
+	app.TransferKeeper.SetParams(sdkCtx, transfertypes.NewParams(false, true))
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	app.ForwardingKeeper.ExecuteForward(sdkCtx, account)
+
+	failed := findEvent[*types.ForwardFailed](t, sdkCtx)
+	require.Equal(t, addr, failed.Address)
+	require.Equal(t, amount, failed.Amount)
+	for _, event := range sdkCtx.EventManager().ABCIEvents() {
+		require.NotEqual(t, "noble.forwarding.v1.ForwardsSummary", event.Type)
+	}
+
+	history, err := app.ForwardingKeeper.ForwardHistory(sdkCtx, &types.QueryForwardHistory{Address: addr})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 1)
+	require.Equal(t, types.FORWARD_OUTCOME_FAILED, history.Records[0].Outcome)
+
+	pending, err = app.ForwardingKeeper.PendingForwards.Has(sdkCtx, addr)
+	require.NoError(t, err)
+	require.False(t, pending)
+	require.Equal(t, amount, app.BankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(addr), "uusdc"))
+}
+
+func TestRegistrationFeePaidFromFirstForwards(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.RegistrationFee.Set(sdkCtx, sdk.NewInt64Coin("uusdc", 150)))
+
+	// ARRANGE: Register an account over IBC, which owes the registration fee.
+	res, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+		Recipient: "iaa1recipient",
+		Channel:   "channel-0",
+	})
+	require.NoError(t, err)
+	addr := res.Address
+	unpaid, err := app.ForwardingKeeper.UnpaidRegistrationFees.Get(sdkCtx, addr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 150), unpaid)
+
+	account, err := app.ForwardingKeeper.GetForwardingAccount(sdkCtx, addr)
+	require.NoError(t, err)
+	app.TransferKeeper.SetParams(sdkCtx, transfertypes.NewParams(false, true))
+
+	// ACT: Forward a balance that only covers part of the fee.
+	fundAccount(t, app, sdkCtx, addr, sdk.NewInt64Coin("uusdc", 100))
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	app.ForwardingKeeper.ExecuteForward(sdkCtx, account)
+
+	// ASSERT: The whole balance is burned, and nothing is forwarded.
+	paid := findEvent[*types.RegistrationFeePaid](t, sdkCtx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), paid.Amount)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 50), paid.Remaining)
+	require.Equal(t, addr, paid.Payer)
+	for _, event := range sdkCtx.EventManager().ABCIEvents() {
+		require.NotEqual(t, "noble.forwarding.v1.ForwardFailed", event.Type)
+	}
+	require.True(t, app.BankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(addr), "uusdc").IsZero())
+	require.True(t, app.BankKeeper.GetSupply(sdkCtx, "uusdc").IsZero())
+
+	// ACT: Forward a balance that covers the rest of the fee.
+	fundAccount(t, app, sdkCtx, addr, sdk.NewInt64Coin("uusdc", 80))
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	app.ForwardingKeeper.ExecuteForward(sdkCtx, account)
+
+	// ASSERT: The fee is paid, and the remainder is forwarded, which fails as
+	// transfers are disabled.
+	paid = findEvent[*types.RegistrationFeePaid](t, sdkCtx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 50), paid.Amount)
+	require.True(t, paid.Remaining.IsZero())
+	failed := findEvent[*types.ForwardFailed](t, sdkCtx)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 30), failed.Amount)
+
+	found, err := app.ForwardingKeeper.UnpaidRegistrationFees.Has(sdkCtx, addr)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 30), app.BankKeeper.GetSupply(sdkCtx, "uusdc"))
+}
+
+func TestSendRestrictionFnEmitsAccountQueued(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	require.NoError(t, app.BankKeeper.MintCoins(sdkCtx, transfertypes.ModuleName, coins))
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, transfertypes.ModuleName, sdk.MustAccAddressFromBech32(addr), coins))
+
+	var queued *types.AccountQueued
+	for _, event := range sdkCtx.EventManager().ABCIEvents() {
+		if event.Type != "noble.forwarding.v1.AccountQueued" {
+			continue
+		}
+
+		raw, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		queued = raw.(*types.AccountQueued)
+	}
+	require.NotNil(t, queued)
+	require.Equal(t, addr, queued.Address)
+	require.Equal(t, "channel-0", queued.Channel)
+	require.Equal(t, types.QUEUE_TRIGGER_BANK_SEND, queued.Trigger)
+	require.Equal(t, app.AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String(), queued.Depositor)
+
+	pending, err := app.ForwardingKeeper.PendingForwards.Has(sdkCtx, addr)
+	require.NoError(t, err)
+	require.True(t, pending)
+}
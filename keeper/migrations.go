@@ -21,9 +21,13 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/noble-assets/forwarding/v2/migrations/v1"
+	v2 "github.com/noble-assets/forwarding/v2/migrations/v2"
+	"github.com/noble-assets/forwarding/v2/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -64,3 +68,43 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	adapter := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+
+	// TotalForwarded was stored as a coins string per channel until v3, so we
+	// split it into a typed amount per channel and denom.
+	totals := v2.GetAllTotalForwarded(adapter)
+	v2.DeleteAllTotalForwarded(adapter, totals)
+
+	for channel, rawTotal := range totals {
+		total, err := sdk.ParseCoinsNormalized(rawTotal)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse total forwarded of %s", channel)
+		}
+
+		for _, coin := range total {
+			err := m.keeper.TotalForwarded.Set(ctx, collections.Join(channel, coin.Denom), coin.Amount)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Forward history was introduced in v3, so we initialize its retention.
+	err := m.keeper.HistoryRetention.Set(ctx, types.DefaultHistoryRetention)
+	if err != nil {
+		return err
+	}
+
+	// Time-bucketed stats were introduced in v3, so we initialize their retention.
+	err = m.keeper.StatsRetention.Set(ctx, types.DefaultStatsRetention)
+	if err != nil {
+		return err
+	}
+
+	// The indexes of registered accounts were introduced in v3, so we backfill
+	// them with all forwarding accounts currently stored in x/auth.
+	return m.keeper.IndexAllAccounts(ctx)
+}
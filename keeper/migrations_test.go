@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/keeper"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestMigrate2to3BackfillsAccounts(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+
+	// Simulate state prior to the index being introduced.
+	require.NoError(t, app.ForwardingKeeper.RegisteredAccounts.Clear(sdkCtx, nil))
+
+	require.NoError(t, keeper.NewMigrator(app.ForwardingKeeper).Migrate2to3(sdkCtx))
+
+	resp, err := app.ForwardingKeeper.AccountsByChannel(sdkCtx, &types.QueryAccountsByChannel{
+		Channel: "channel-0",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Accounts, 1)
+	require.Equal(t, addr, resp.Accounts[0].Address)
+}
+
+func TestMigrate2to3SplitsTotalForwarded(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+
+	// Simulate state prior to totals being stored per denom.
+	store := prefix.NewStore(sdkCtx.KVStore(app.GetKey(types.StoreKey)), types.TotalForwardedPrefix)
+	store.Set([]byte("channel-0"), []byte("25uatom,150uusdc"))
+	store.Set([]byte("channel-1"), []byte("100uusdc"))
+
+	require.NoError(t, keeper.NewMigrator(app.ForwardingKeeper).Migrate2to3(sdkCtx))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 25), sdk.NewInt64Coin("uusdc", 150)), app.ForwardingKeeper.GetTotalForwarded(sdkCtx, "channel-0"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)), app.ForwardingKeeper.GetTotalForwarded(sdkCtx, "channel-1"))
+	require.Equal(t, map[string]string{
+		"channel-0": "25uatom,150uusdc",
+		"channel-1": "100uusdc",
+	}, app.ForwardingKeeper.GetAllTotalForwarded(sdkCtx))
+
+	app.ForwardingKeeper.IncrementTotalForwarded(sdkCtx, "channel-1", sdk.NewInt64Coin("uusdc", 50))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 150)), app.ForwardingKeeper.GetTotalForwarded(sdkCtx, "channel-1"))
+}
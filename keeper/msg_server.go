@@ -28,6 +28,7 @@ import (
 	"cosmossdk.io/collections"
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorstypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -37,62 +38,39 @@ import (
 var _ types.MsgServer = &Keeper{}
 
 func (k *Keeper) RegisterAccount(ctx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
-	if !channeltypes.IsValidChannelID(msg.Channel) {
-		return nil, errors.New("invalid channel")
-	}
-
-	if len(msg.Recipient) > transfertypes.MaximumReceiverLength {
-		return nil, fmt.Errorf("recipient address must not exceed %d bytes", transfertypes.MaximumReceiverLength)
-	}
-
-	if msg.Fallback != "" {
-		if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Fallback); err != nil {
-			return nil, errors.New("invalid fallback address")
-		}
-	}
-	address := types.GenerateAddress(msg.Channel, msg.Recipient, msg.Fallback)
-
-	channel, found := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, msg.Channel)
-	if !found {
-		return nil, fmt.Errorf("channel does not exist: %s", msg.Channel)
+	msg, address, err := k.ValidateRegistration(ctx, msg)
+	if err != nil {
+		return nil, err
 	}
-	if channel.State != channeltypes.OPEN {
-		return nil, fmt.Errorf("channel is not open: %s, %s", msg.Channel, channel.State)
+	if err := k.chargeRegistrationFee(ctx, msg, address); err != nil {
+		return nil, err
 	}
 
-	if k.accountKeeper.HasAccount(ctx, address) {
-		rawAccount := k.accountKeeper.GetAccount(ctx, address)
-
-		if err := ValidateAccountFields(rawAccount, address); err != nil {
-			return nil, err
+	version := types.ResolveAddressVersion(msg.AddressVersion)
+
+	if rawAccount := k.accountKeeper.GetAccount(ctx, address); rawAccount != nil {
+		// NOTE: ValidateRegistration ensures that existing accounts are base
+		// accounts, which are converted into forwarding accounts.
+		account := &types.ForwardingAccount{
+			BaseAccount:    rawAccount.(*authtypes.BaseAccount),
+			Channel:        msg.Channel,
+			Recipient:      msg.Recipient,
+			CreatedAt:      k.headerService.GetHeaderInfo(ctx).Height,
+			Fallback:       msg.Fallback,
+			AddressVersion: version,
 		}
+		k.accountKeeper.SetAccount(ctx, account)
 
-		switch account := rawAccount.(type) {
-		case *authtypes.BaseAccount:
-			rawAccount = &types.ForwardingAccount{
-				BaseAccount: account,
-				Channel:     msg.Channel,
-				Recipient:   msg.Recipient,
-				CreatedAt:   k.headerService.GetHeaderInfo(ctx).Height,
-				Fallback:    msg.Fallback,
-			}
-			k.accountKeeper.SetAccount(ctx, rawAccount)
-
-			k.IncrementNumOfAccounts(ctx, msg.Channel)
-		case *types.ForwardingAccount:
-			return nil, errors.New("account has already been registered")
-		default:
-			return nil, fmt.Errorf("unsupported account type: %T", rawAccount)
+		k.IncrementNumOfAccounts(ctx, msg.Channel)
+		if err := k.IndexAccount(ctx, account); err != nil {
+			return nil, fmt.Errorf("failed to index account in state: %w", err)
 		}
 
 		for _, denom := range k.GetAllowedDenoms(ctx) {
 			balance := k.bankKeeper.GetBalance(ctx, address, denom)
 			if !balance.IsZero() {
-				account, ok := rawAccount.(*types.ForwardingAccount)
-				if ok {
-					k.SetPendingForward(ctx, account)
-					break
-				}
+				k.SetPendingForward(ctx, account, types.QUEUE_TRIGGER_REGISTRATION, "")
+				break
 			}
 		}
 
@@ -100,6 +78,7 @@ func (k *Keeper) RegisterAccount(ctx context.Context, msg *types.MsgRegisterAcco
 			return nil, err
 		}
 
+		incrRegistrationCounter(RegistrationPath(msg, address), msg.Channel)
 		return &types.MsgRegisterAccountResponse{Address: address.String()}, k.eventService.EventManager(ctx).Emit(ctx, &types.AccountRegistered{
 			Address:   address.String(),
 			Channel:   msg.Channel,
@@ -110,20 +89,25 @@ func (k *Keeper) RegisterAccount(ctx context.Context, msg *types.MsgRegisterAcco
 
 	base := k.accountKeeper.NewAccountWithAddress(ctx, address)
 	account := types.ForwardingAccount{
-		BaseAccount: authtypes.NewBaseAccount(base.GetAddress(), base.GetPubKey(), base.GetAccountNumber(), base.GetSequence()),
-		Channel:     msg.Channel,
-		Recipient:   msg.Recipient,
-		CreatedAt:   k.headerService.GetHeaderInfo(ctx).Height,
-		Fallback:    msg.Fallback,
+		BaseAccount:    authtypes.NewBaseAccount(base.GetAddress(), base.GetPubKey(), base.GetAccountNumber(), base.GetSequence()),
+		Channel:        msg.Channel,
+		Recipient:      msg.Recipient,
+		CreatedAt:      k.headerService.GetHeaderInfo(ctx).Height,
+		Fallback:       msg.Fallback,
+		AddressVersion: version,
 	}
 
 	k.accountKeeper.SetAccount(ctx, &account)
 	k.IncrementNumOfAccounts(ctx, msg.Channel)
+	if err := k.IndexAccount(ctx, &account); err != nil {
+		return nil, fmt.Errorf("failed to index account in state: %w", err)
+	}
 
 	if err := k.setInitialMemos(ctx, address, msg.Memos); err != nil {
 		return nil, err
 	}
 
+	incrRegistrationCounter(RegistrationPath(msg, address), msg.Channel)
 	return &types.MsgRegisterAccountResponse{Address: address.String()}, k.eventService.EventManager(ctx).Emit(ctx, &types.AccountRegistered{
 		Address:   address.String(),
 		Channel:   account.Channel,
@@ -157,7 +141,7 @@ func (k *Keeper) ClearAccount(ctx context.Context, msg *types.MsgClearAccount) (
 	}
 
 	if !msg.Fallback || account.Fallback == "" {
-		k.SetPendingForward(ctx, account)
+		k.SetPendingForward(ctx, account, types.QUEUE_TRIGGER_MANUAL_CLEAR, "")
 		return &types.MsgClearAccountResponse{}, nil
 	}
 
@@ -165,7 +149,11 @@ func (k *Keeper) ClearAccount(ctx context.Context, msg *types.MsgClearAccount) (
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to decode fallback address")
 	}
-	err = k.bankKeeper.SendCoins(ctx, address, fallback, totalBalance)
+	clearedBalance := sdk.NewCoins()
+	for _, balance := range totalBalance {
+		clearedBalance = clearedBalance.Add(k.PayRegistrationFee(ctx, address, balance))
+	}
+	err = k.bankKeeper.SendCoins(ctx, address, fallback, clearedBalance)
 	if err != nil {
 		return nil, errors.New("failed to clear balance to fallback account")
 	}
@@ -202,6 +190,279 @@ func (k *Keeper) SetAllowedDenoms(ctx context.Context, msg *types.MsgSetAllowedD
 	})
 }
 
+func (k *Keeper) SetHistoryRetention(ctx context.Context, msg *types.MsgSetHistoryRetention) (*types.MsgSetHistoryRetentionResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	previousRetention := k.GetHistoryRetention(ctx)
+	if err := k.HistoryRetention.Set(ctx, msg.Retention); err != nil {
+		return nil, errors.New("failed to set history retention in state")
+	}
+
+	return &types.MsgSetHistoryRetentionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.HistoryRetentionConfigured{
+		PreviousRetention: previousRetention,
+		CurrentRetention:  msg.Retention,
+	})
+}
+
+func (k *Keeper) SetStatsRetention(ctx context.Context, msg *types.MsgSetStatsRetention) (*types.MsgSetStatsRetentionResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	previousRetention := k.GetStatsRetention(ctx)
+	if err := k.StatsRetention.Set(ctx, msg.Retention); err != nil {
+		return nil, errors.New("failed to set stats retention in state")
+	}
+
+	return &types.MsgSetStatsRetentionResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.StatsRetentionConfigured{
+		PreviousRetention: previousRetention,
+		CurrentRetention:  msg.Retention,
+	})
+}
+
+func (k *Keeper) SetChainChannel(ctx context.Context, msg *types.MsgSetChainChannel) (*types.MsgSetChainChannelResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if msg.ChainId == "" {
+		return nil, errors.New("chain id cannot be empty")
+	}
+
+	previousChannel, _ := k.ChainChannels.Get(ctx, msg.ChainId)
+	if msg.Channel == "" {
+		if err := k.ChainChannels.Remove(ctx, msg.ChainId); err != nil {
+			return nil, errors.New("failed to remove chain channel from state")
+		}
+	} else {
+		if err := k.ValidateChainChannel(ctx, msg.ChainId, msg.Channel); err != nil {
+			return nil, err
+		}
+
+		if err := k.ChainChannels.Set(ctx, msg.ChainId, msg.Channel); err != nil {
+			return nil, errors.New("failed to set chain channel in state")
+		}
+	}
+
+	return &types.MsgSetChainChannelResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ChainChannelConfigured{
+		ChainId:         msg.ChainId,
+		PreviousChannel: previousChannel,
+		CurrentChannel:  msg.Channel,
+	})
+}
+
+func (k *Keeper) SetRegistrationMode(ctx context.Context, msg *types.MsgSetRegistrationMode) (*types.MsgSetRegistrationModeResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := types.ValidateRegistrationMode(msg.Mode); err != nil {
+		return nil, err
+	}
+	mode := types.ResolveRegistrationMode(msg.Mode)
+
+	previousMode := k.GetRegistrationMode(ctx)
+	if err := k.RegistrationMode.Set(ctx, int32(mode)); err != nil {
+		return nil, errors.New("failed to set registration mode in state")
+	}
+
+	return &types.MsgSetRegistrationModeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RegistrationModeConfigured{
+		PreviousMode: previousMode,
+		CurrentMode:  mode,
+	})
+}
+
+func (k *Keeper) SetForwardMode(ctx context.Context, msg *types.MsgSetForwardMode) (*types.MsgSetForwardModeResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := types.ValidateForwardMode(msg.Mode); err != nil {
+		return nil, err
+	}
+	mode := types.ResolveForwardMode(msg.Mode)
+
+	previousMode := k.GetForwardMode(ctx)
+	if err := k.ForwardMode.Set(ctx, int32(mode)); err != nil {
+		return nil, errors.New("failed to set forward mode in state")
+	}
+
+	return &types.MsgSetForwardModeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardModeConfigured{
+		PreviousMode: previousMode,
+		CurrentMode:  mode,
+	})
+}
+
+func (k *Keeper) SetSignerlessFee(ctx context.Context, msg *types.MsgSetSignerlessFee) (*types.MsgSetSignerlessFeeResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := msg.Fee.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signerless fee: %w", err)
+	}
+	if msg.Fee.IsPositive() {
+		if found, _ := k.AllowedDenoms.Has(ctx, msg.Fee.Denom); !found {
+			return nil, sdkerrors.Wrap(types.ErrDenomNotAllowed, msg.Fee.Denom)
+		}
+	}
+
+	// NOTE: A zero fee disables the signerless fee.
+	previousFee := k.GetSignerlessFee(ctx)
+	if msg.Fee.IsZero() {
+		if err := k.SignerlessFee.Remove(ctx); err != nil {
+			return nil, errors.New("failed to remove signerless fee from state")
+		}
+	} else if err := k.SignerlessFee.Set(ctx, msg.Fee); err != nil {
+		return nil, errors.New("failed to set signerless fee in state")
+	}
+
+	return &types.MsgSetSignerlessFeeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SignerlessFeeConfigured{
+		PreviousFee: previousFee,
+		CurrentFee:  msg.Fee,
+	})
+}
+
+func (k *Keeper) SetRegistrationFee(ctx context.Context, msg *types.MsgSetRegistrationFee) (*types.MsgSetRegistrationFeeResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := msg.Fee.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid registration fee: %w", err)
+	}
+	if msg.Fee.IsPositive() {
+		if found, _ := k.AllowedDenoms.Has(ctx, msg.Fee.Denom); !found {
+			return nil, sdkerrors.Wrap(types.ErrDenomNotAllowed, msg.Fee.Denom)
+		}
+	}
+
+	// NOTE: A zero fee disables the registration fee. Fees owed by accounts
+	// that are already registered are unaffected.
+	previousFee := k.GetRegistrationFee(ctx)
+	if msg.Fee.IsZero() {
+		if err := k.RegistrationFee.Remove(ctx); err != nil {
+			return nil, errors.New("failed to remove registration fee from state")
+		}
+	} else if err := k.RegistrationFee.Set(ctx, msg.Fee); err != nil {
+		return nil, errors.New("failed to set registration fee in state")
+	}
+
+	return &types.MsgSetRegistrationFeeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RegistrationFeeConfigured{
+		PreviousFee: previousFee,
+		CurrentFee:  msg.Fee,
+	})
+}
+
+// ValidateRegistration checks that a registration can succeed, returning the
+// message with its channel resolved and the address of the forwarding account.
+// It is also used to reject signerless registrations before they are charged.
+func (k *Keeper) ValidateRegistration(ctx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccount, sdk.AccAddress, error) {
+	if msg.ChainId != "" {
+		channel, err := k.ResolveChainChannel(ctx, msg.ChainId, msg.Channel)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resolved := *msg
+		resolved.Channel = channel
+		msg = &resolved
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return nil, nil, types.ErrInvalidChannel
+	}
+
+	if len(msg.Recipient) > transfertypes.MaximumReceiverLength {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidRecipient, "recipient address must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+	}
+
+	if msg.Fallback != "" {
+		if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Fallback); err != nil {
+			return nil, nil, types.ErrInvalidFallback
+		}
+	}
+
+	if err := types.ValidateAddressVersion(msg.AddressVersion); err != nil {
+		return nil, nil, err
+	}
+	version := types.ResolveAddressVersion(msg.AddressVersion)
+	address := types.GenerateAddressWithVersion(version, msg.Channel, msg.Recipient, msg.Fallback)
+
+	if err := k.ValidateChannel(ctx, msg.Channel); err != nil {
+		return nil, nil, err
+	}
+
+	if rawAccount := k.accountKeeper.GetAccount(ctx, address); rawAccount != nil {
+		if err := ValidateAccountFields(rawAccount, address); err != nil {
+			return nil, nil, err
+		}
+
+		switch rawAccount.(type) {
+		case *authtypes.BaseAccount:
+		case *types.ForwardingAccount:
+			return nil, nil, types.ErrAccountAlreadyRegistered
+		default:
+			return nil, nil, sdkerrors.Wrapf(types.ErrUnsupportedAccountType, "%T", rawAccount)
+		}
+	}
+
+	if len(msg.Memos) > 0 {
+		if err := validateMemoEntries(msg.Memos); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return msg, address, nil
+}
+
+// ResolveChainChannel returns the channel registered for a counterparty
+// chain. If a channel is provided, it must match the registered channel.
+func (k *Keeper) ResolveChainChannel(ctx context.Context, chainID string, channel string) (string, error) {
+	registered, err := k.ChainChannels.Get(ctx, chainID)
+	if err != nil {
+		return "", sdkerrors.Wrapf(types.ErrUnknownChain, "no channel registered for chain: %s", chainID)
+	}
+	if channel != "" && channel != registered {
+		return "", sdkerrors.Wrapf(types.ErrChainMismatch, "channel %s does not match channel %s registered for chain %s", channel, registered, chainID)
+	}
+
+	return registered, k.ValidateChainChannel(ctx, chainID, registered)
+}
+
+// ValidateChainChannel checks that a transfer channel is open and that its
+// light client tracks the given counterparty chain.
+func (k *Keeper) ValidateChainChannel(ctx context.Context, chainID string, channelID string) error {
+	if err := k.ValidateChannel(ctx, channelID); err != nil {
+		return err
+	}
+
+	_, actual := k.GetChannelChainId(ctx, channelID)
+	if actual == types.UnknownChainId {
+		return sdkerrors.Wrapf(types.ErrChainMismatch, "chain of channel %s can't be resolved", channelID)
+	}
+	if actual != chainID {
+		return sdkerrors.Wrapf(types.ErrChainMismatch, "channel %s is connected to chain %s, not %s", channelID, actual, chainID)
+	}
+
+	return nil
+}
+
+// ValidateChannel checks that a transfer channel exists and is open.
+func (k *Keeper) ValidateChannel(ctx context.Context, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, channelID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrChannelNotFound, channelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return sdkerrors.Wrapf(types.ErrChannelNotOpen, "%s, %s", channelID, channel.State)
+	}
+
+	return nil
+}
+
 // ValidateAccountFields is a utility for checking if an account is eligible to be registered.
 //
 // A valid account must satisfy one of the following conditions.
@@ -221,7 +482,7 @@ func ValidateAccountFields(account sdk.AccountI, address sdk.AccAddress) error {
 	isValidPubKey := pubKey != nil && pubKey.Equals(&types.ForwardingPubKey{Key: address})
 
 	if !isNewAccount && !isValidPubKey {
-		return fmt.Errorf("attempting to register an existing user account with address: %s", address.String())
+		return sdkerrors.Wrapf(types.ErrExistingAccount, "attempting to register an existing user account with address: %s", address.String())
 	}
 	return nil
 }
@@ -234,7 +495,19 @@ func (k *Keeper) SetMemo(ctx context.Context, msg *types.MsgSetMemo) (*types.Msg
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return nil, fmt.Errorf("invalid denom: %w", err)
 	}
-	address := types.GenerateAddress(msg.Channel, msg.Recipient, msg.Fallback)
+	if err := types.ValidateAddressVersion(msg.AddressVersion); err != nil {
+		return nil, err
+	}
+
+	version := msg.AddressVersion
+	if version == types.ADDRESS_VERSION_UNSPECIFIED {
+		// NOTE: Accounts registered before versioning was introduced use v1.
+		version = types.DefaultAddressVersion
+		if !k.accountKeeper.HasAccount(ctx, types.GenerateAddressWithVersion(version, msg.Channel, msg.Recipient, msg.Fallback)) {
+			version = types.ADDRESS_VERSION_V1
+		}
+	}
+	address := types.GenerateAddressWithVersion(version, msg.Channel, msg.Recipient, msg.Fallback)
 	rawAccount := k.accountKeeper.GetAccount(ctx, address)
 	if rawAccount == nil {
 		return nil, errors.New("account does not exist")
@@ -268,6 +541,35 @@ func (k *Keeper) SetMemo(ctx context.Context, msg *types.MsgSetMemo) (*types.Msg
 	})
 }
 
+// chargeRegistrationFee charges the registration fee of a forwarding account.
+// Registrations through a message are paid for by their signer, while
+// registrations over IBC have no signer that can pay, so the fee is owed by
+// the account and paid from its first forwards.
+func (k *Keeper) chargeRegistrationFee(ctx context.Context, msg *types.MsgRegisterAccount, address sdk.AccAddress) error {
+	fee := k.GetRegistrationFee(ctx)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	switch RegistrationPath(msg, address) {
+	case types.RegistrationPathPacket, types.RegistrationPathMemo:
+		if err := k.UnpaidRegistrationFees.Set(ctx, address.String(), fee); err != nil {
+			return fmt.Errorf("failed to set unpaid registration fee in state: %w", err)
+		}
+		return nil
+	}
+
+	payer, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Signer)
+	if err != nil {
+		return errors.New("invalid signer address")
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return sdkerrors.Wrapf(errorstypes.ErrInsufficientFunds, "failed to pay registration fee of %s: %s", fee, err)
+	}
+
+	return k.burnRegistrationFee(ctx, address.String(), msg.Signer, fee)
+}
+
 func (k *Keeper) setInitialMemos(ctx context.Context, address sdk.AccAddress, entries []types.MemoEntry) error {
 	if len(entries) == 0 {
 		return nil
@@ -288,25 +590,25 @@ func (k *Keeper) setInitialMemos(ctx context.Context, address sdk.AccAddress, en
 
 func validateMemoEntries(entries []types.MemoEntry) error {
 	if len(entries) > MaxMemoEntries {
-		return fmt.Errorf("cannot register more than %d memos", MaxMemoEntries)
+		return sdkerrors.Wrapf(types.ErrInvalidMemo, "cannot register more than %d memos", MaxMemoEntries)
 	}
 
 	seen := make(map[string]struct{}, len(entries))
 	for _, entry := range entries {
 		if entry.Denom == "" {
-			return errors.New("memo denom cannot be empty")
+			return sdkerrors.Wrap(types.ErrInvalidMemo, "memo denom cannot be empty")
 		}
 		if _, ok := seen[entry.Denom]; ok {
-			return fmt.Errorf("duplicate memo denom: %s", entry.Denom)
+			return sdkerrors.Wrapf(types.ErrInvalidMemo, "duplicate memo denom: %s", entry.Denom)
 		}
 		if len(entry.Memo) == 0 {
-			return fmt.Errorf("memo for denom %s cannot be empty", entry.Denom)
+			return sdkerrors.Wrapf(types.ErrInvalidMemo, "memo for denom %s cannot be empty", entry.Denom)
 		}
 		if len(entry.Memo) > MaxMemoLength {
-			return fmt.Errorf("memo for denom %s exceeds maximum length of %d characters", entry.Denom, MaxMemoLength)
+			return sdkerrors.Wrapf(types.ErrInvalidMemo, "memo for denom %s exceeds maximum length of %d characters", entry.Denom, MaxMemoLength)
 		}
 		if err := sdk.ValidateDenom(entry.Denom); err != nil {
-			return fmt.Errorf("invalid denom %s: %w", entry.Denom, err)
+			return sdkerrors.Wrapf(types.ErrInvalidMemo, "invalid denom %s: %s", entry.Denom, err)
 		}
 		seen[entry.Denom] = struct{}{}
 	}
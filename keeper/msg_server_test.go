@@ -24,9 +24,12 @@ import (
 	"fmt"
 	"testing"
 
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorstypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/noble-assets/forwarding/v2/keeper"
@@ -135,6 +138,81 @@ func TestRegisterAccountStoresInitialMemos(t *testing.T) {
 	}
 }
 
+func TestRegisterAccountAddressVersions(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+	ensureOpenChannel(t, app, sdkCtx, "channel-10")
+
+	// v1 concatenates the fields, so shifting a boundary produces the same address.
+	require.Equal(t,
+		types.GenerateAddressWithVersion(types.ADDRESS_VERSION_V1, "channel-1", "0abc", ""),
+		types.GenerateAddressWithVersion(types.ADDRESS_VERSION_V1, "channel-10", "abc", ""),
+	)
+	require.NotEqual(t,
+		types.GenerateAddressWithVersion(types.ADDRESS_VERSION_V2, "channel-1", "0abc", ""),
+		types.GenerateAddressWithVersion(types.ADDRESS_VERSION_V2, "channel-10", "abc", ""),
+	)
+	// The deprecated helper keeps deriving v1 addresses, while unknown versions panic.
+	require.Equal(t,
+		types.GenerateAddressWithVersion(types.ADDRESS_VERSION_V1, "channel-1", "0abc", ""),
+		types.GenerateAddress("channel-1", "0abc", ""), //nolint:staticcheck // covers the deprecated helper
+	)
+	require.Panics(t, func() {
+		types.GenerateAddressWithVersion(types.AddressVersion(99), "channel-1", "0abc", "")
+	})
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	res, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "0abc",
+		Channel:   "channel-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-1", "0abc", "").String(), res.Address)
+
+	account, err := app.ForwardingKeeper.Account(sdkCtx, &types.QueryAccount{Address: res.Address})
+	require.NoError(t, err)
+	require.Equal(t, types.ADDRESS_VERSION_V2, account.AddressVersion)
+
+	address, err := app.ForwardingKeeper.Address(sdkCtx, &types.QueryAddress{Channel: "channel-1", Recipient: "0abc"})
+	require.NoError(t, err)
+	require.Equal(t, res.Address, address.Address)
+	require.Equal(t, types.ADDRESS_VERSION_V2, address.AddressVersion)
+	require.True(t, address.Exists)
+
+	// Legacy accounts can still be registered and managed using v1.
+	res, err = app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+		Signer:         signer,
+		Recipient:      "abc",
+		Channel:        "channel-10",
+		AddressVersion: types.ADDRESS_VERSION_V1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.GenerateAddressWithVersion(types.ADDRESS_VERSION_V1, "channel-10", "abc", "").String(), res.Address)
+
+	_, err = app.ForwardingKeeper.SetMemo(sdkCtx, &types.MsgSetMemo{
+		Signer:    authority,
+		Recipient: "abc",
+		Channel:   "channel-10",
+		Denom:     "uusdc",
+		Memo:      "memo-usdc",
+	})
+	require.NoError(t, err)
+
+	memo, err := app.ForwardingKeeper.GetMemo(sdkCtx, &types.QueryMemo{Address: res.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, "memo-usdc", memo.Memo)
+
+	_, err = app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+		Signer:         signer,
+		Recipient:      "abc",
+		Channel:        "channel-1",
+		AddressVersion: types.AddressVersion(3),
+	})
+	require.ErrorContains(t, err, "unknown address version")
+}
+
 func TestRegisterAccountRejectsTooManyMemos(t *testing.T) {
 	app, sdkCtx := setupForwardingKeeper(t)
 	ensureOpenChannel(t, app, sdkCtx, "channel-0")
@@ -159,6 +237,65 @@ func TestRegisterAccountRejectsTooManyMemos(t *testing.T) {
 	require.ErrorContains(t, err, "cannot register more than")
 }
 
+func TestRegisterAccountErrorCodes(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name string
+		msg  *types.MsgRegisterAccount
+		err  *sdkerrors.Error
+	}{
+		{
+			name: "invalid channel",
+			msg:  &types.MsgRegisterAccount{Recipient: "abc", Channel: "invalid"},
+			err:  types.ErrInvalidChannel,
+		},
+		{
+			name: "channel does not exist",
+			msg:  &types.MsgRegisterAccount{Recipient: "abc", Channel: "channel-1"},
+			err:  types.ErrChannelNotFound,
+		},
+		{
+			name: "invalid fallback address",
+			msg:  &types.MsgRegisterAccount{Recipient: "abc", Channel: "channel-0", Fallback: "invalid"},
+			err:  types.ErrInvalidFallback,
+		},
+		{
+			name: "unknown chain",
+			msg:  &types.MsgRegisterAccount{Recipient: "abc", ChainId: "osmosis-1"},
+			err:  types.ErrUnknownChain,
+		},
+		{
+			name: "invalid memo",
+			msg:  &types.MsgRegisterAccount{Recipient: "abc", Channel: "channel-0", Memos: []types.MemoEntry{{Denom: "uusdc"}}},
+			err:  types.ErrInvalidMemo,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.msg.Signer = signer
+
+			_, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, test.msg)
+			require.ErrorIs(t, err, test.err)
+
+			// NOTE: Error acknowledgements are redacted to the ABCI code.
+			ack := channeltypes.NewErrorAcknowledgement(err)
+			require.Contains(t, ack.GetError(), fmt.Sprintf("ABCI code: %d:", test.err.ABCICode()))
+		})
+	}
+
+	msg := &types.MsgRegisterAccount{Signer: signer, Recipient: "def", Channel: "channel-0"}
+	_, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, msg)
+	require.NoError(t, err)
+
+	_, err = app.ForwardingKeeper.RegisterAccount(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrAccountAlreadyRegistered)
+}
+
 func TestSetMemoRequiresOwner(t *testing.T) {
 	app, sdkCtx := setupForwardingKeeper(t)
 	ensureOpenChannel(t, app, sdkCtx, "channel-0")
@@ -278,3 +415,348 @@ func TestSetMemoAllowsAdditionalEntries(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestSetHistoryRetention(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+
+	_, err := app.ForwardingKeeper.SetHistoryRetention(sdkCtx, &types.MsgSetHistoryRetention{
+		Signer:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Retention: 5,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetHistoryRetention(sdkCtx, &types.MsgSetHistoryRetention{
+		Signer:    authority,
+		Retention: 5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), app.ForwardingKeeper.GetHistoryRetention(sdkCtx))
+}
+
+func TestSetStatsRetention(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+
+	_, err := app.ForwardingKeeper.SetStatsRetention(sdkCtx, &types.MsgSetStatsRetention{
+		Signer:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Retention: 30,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetStatsRetention(sdkCtx, &types.MsgSetStatsRetention{
+		Signer:    authority,
+		Retention: 30,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), app.ForwardingKeeper.GetStatsRetention(sdkCtx))
+}
+
+func TestSetRegistrationMode(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	require.Equal(t, types.REGISTRATION_MODE_STRICT, app.ForwardingKeeper.GetRegistrationMode(sdkCtx))
+
+	_, err := app.ForwardingKeeper.SetRegistrationMode(sdkCtx, &types.MsgSetRegistrationMode{
+		Signer: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Mode:   types.REGISTRATION_MODE_SOFT_FAIL,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetRegistrationMode(sdkCtx, &types.MsgSetRegistrationMode{
+		Signer: authority,
+		Mode:   types.RegistrationMode(3),
+	})
+	require.ErrorContains(t, err, "unknown registration mode")
+
+	_, err = app.ForwardingKeeper.SetRegistrationMode(sdkCtx, &types.MsgSetRegistrationMode{
+		Signer: authority,
+		Mode:   types.REGISTRATION_MODE_SOFT_FAIL,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.REGISTRATION_MODE_SOFT_FAIL, app.ForwardingKeeper.GetRegistrationMode(sdkCtx))
+
+	_, err = app.ForwardingKeeper.SetRegistrationMode(sdkCtx, &types.MsgSetRegistrationMode{
+		Signer: authority,
+		Mode:   types.REGISTRATION_MODE_UNSPECIFIED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.REGISTRATION_MODE_STRICT, app.ForwardingKeeper.GetRegistrationMode(sdkCtx))
+}
+
+func TestSetForwardMode(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	require.Equal(t, types.FORWARD_MODE_END_BLOCK, app.ForwardingKeeper.GetForwardMode(sdkCtx))
+
+	_, err := app.ForwardingKeeper.SetForwardMode(sdkCtx, &types.MsgSetForwardMode{
+		Signer: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Mode:   types.FORWARD_MODE_IMMEDIATE,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetForwardMode(sdkCtx, &types.MsgSetForwardMode{
+		Signer: authority,
+		Mode:   types.ForwardMode(3),
+	})
+	require.ErrorContains(t, err, "unknown forward mode")
+
+	_, err = app.ForwardingKeeper.SetForwardMode(sdkCtx, &types.MsgSetForwardMode{
+		Signer: authority,
+		Mode:   types.FORWARD_MODE_IMMEDIATE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.FORWARD_MODE_IMMEDIATE, app.ForwardingKeeper.GetForwardMode(sdkCtx))
+
+	_, err = app.ForwardingKeeper.SetForwardMode(sdkCtx, &types.MsgSetForwardMode{
+		Signer: authority,
+		Mode:   types.FORWARD_MODE_UNSPECIFIED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.FORWARD_MODE_END_BLOCK, app.ForwardingKeeper.GetForwardMode(sdkCtx))
+}
+
+func TestSetSignerlessFee(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	require.True(t, app.ForwardingKeeper.GetSignerlessFee(sdkCtx).IsZero())
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+
+	_, err := app.ForwardingKeeper.SetSignerlessFee(sdkCtx, &types.MsgSetSignerlessFee{
+		Signer: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Fee:    sdk.NewInt64Coin("uusdc", 10),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetSignerlessFee(sdkCtx, &types.MsgSetSignerlessFee{
+		Signer: authority,
+		Fee:    sdk.NewInt64Coin("uatom", 10),
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	_, err = app.ForwardingKeeper.SetSignerlessFee(sdkCtx, &types.MsgSetSignerlessFee{
+		Signer: authority,
+		Fee:    sdk.Coin{Denom: "uusdc"},
+	})
+	require.ErrorContains(t, err, "invalid signerless fee")
+
+	_, err = app.ForwardingKeeper.SetSignerlessFee(sdkCtx, &types.MsgSetSignerlessFee{
+		Signer: authority,
+		Fee:    sdk.NewInt64Coin("uusdc", 10),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), app.ForwardingKeeper.GetSignerlessFee(sdkCtx))
+
+	_, err = app.ForwardingKeeper.SetSignerlessFee(sdkCtx, &types.MsgSetSignerlessFee{
+		Signer: authority,
+		Fee:    sdk.NewInt64Coin("uatom", 0),
+	})
+	require.NoError(t, err)
+	require.True(t, app.ForwardingKeeper.GetSignerlessFee(sdkCtx).IsZero())
+}
+
+func TestSetRegistrationFee(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	require.True(t, app.ForwardingKeeper.GetRegistrationFee(sdkCtx).IsZero())
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+
+	_, err := app.ForwardingKeeper.SetRegistrationFee(sdkCtx, &types.MsgSetRegistrationFee{
+		Signer: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Fee:    sdk.NewInt64Coin("uusdc", 10),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetRegistrationFee(sdkCtx, &types.MsgSetRegistrationFee{
+		Signer: authority,
+		Fee:    sdk.NewInt64Coin("uatom", 10),
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	_, err = app.ForwardingKeeper.SetRegistrationFee(sdkCtx, &types.MsgSetRegistrationFee{
+		Signer: authority,
+		Fee:    sdk.Coin{Denom: "uusdc"},
+	})
+	require.ErrorContains(t, err, "invalid registration fee")
+
+	_, err = app.ForwardingKeeper.SetRegistrationFee(sdkCtx, &types.MsgSetRegistrationFee{
+		Signer: authority,
+		Fee:    sdk.NewInt64Coin("uusdc", 10),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), app.ForwardingKeeper.GetRegistrationFee(sdkCtx))
+
+	_, err = app.ForwardingKeeper.SetRegistrationFee(sdkCtx, &types.MsgSetRegistrationFee{
+		Signer: authority,
+		Fee:    sdk.NewInt64Coin("uatom", 0),
+	})
+	require.NoError(t, err)
+	require.True(t, app.ForwardingKeeper.GetRegistrationFee(sdkCtx).IsZero())
+}
+
+func TestRegisterAccountChargesRegistrationFee(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+	require.NoError(t, app.ForwardingKeeper.RegistrationFee.Set(sdkCtx, sdk.NewInt64Coin("uusdc", 10)))
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	msg := &types.MsgRegisterAccount{Signer: signer, Recipient: "cosmos1recipient", Channel: "channel-0"}
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "cosmos1recipient", "")
+
+	// ACT: Register an account with a signer that can't pay the fee.
+	_, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, msg)
+	require.ErrorIs(t, err, errorstypes.ErrInsufficientFunds)
+	require.Nil(t, app.AccountKeeper.GetAccount(sdkCtx, address))
+
+	// ACT: Register an account with a signer that can pay the fee.
+	fundAccount(t, app, sdkCtx, signer, sdk.NewInt64Coin("uusdc", 100))
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	res, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, msg)
+	require.NoError(t, err)
+	require.Equal(t, address.String(), res.Address)
+
+	// ASSERT: The fee is burned from the signer, and the account owes nothing.
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 90), app.BankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(signer), "uusdc"))
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 90), app.BankKeeper.GetSupply(sdkCtx, "uusdc"))
+
+	paid := findEvent[*types.RegistrationFeePaid](t, sdkCtx)
+	require.Equal(t, res.Address, paid.Address)
+	require.Equal(t, signer, paid.Payer)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), paid.Amount)
+	require.True(t, paid.Remaining.IsZero())
+
+	found, err := app.ForwardingKeeper.UnpaidRegistrationFees.Has(sdkCtx, res.Address)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestSetChainChannel(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-0", "osmosis-1")
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-1", "cosmoshub-4")
+
+	_, err := app.ForwardingKeeper.SetChainChannel(sdkCtx, &types.MsgSetChainChannel{
+		Signer:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		ChainId: "osmosis-1",
+		Channel: "channel-0",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetChainChannel(sdkCtx, &types.MsgSetChainChannel{
+		Signer:  authority,
+		ChainId: "osmosis-1",
+		Channel: "channel-1",
+	})
+	require.ErrorContains(t, err, "channel channel-1 is connected to chain cosmoshub-4, not osmosis-1")
+
+	// Channels whose chain ID can't be resolved can't be registered.
+	ensureOpenChannel(t, app, sdkCtx, "channel-2")
+	_, err = app.ForwardingKeeper.SetChainChannel(sdkCtx, &types.MsgSetChainChannel{
+		Signer:  authority,
+		ChainId: types.UnknownChainId,
+		Channel: "channel-2",
+	})
+	require.ErrorContains(t, err, "chain of channel channel-2 can't be resolved")
+
+	_, err = app.ForwardingKeeper.SetChainChannel(sdkCtx, &types.MsgSetChainChannel{
+		Signer:  authority,
+		ChainId: "osmosis-1",
+		Channel: "channel-0",
+	})
+	require.NoError(t, err)
+
+	chains, err := app.ForwardingKeeper.Chains(sdkCtx, &types.QueryChains{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"osmosis-1": "channel-0"}, chains.ChainChannels)
+
+	_, err = app.ForwardingKeeper.SetChainChannel(sdkCtx, &types.MsgSetChainChannel{
+		Signer:  authority,
+		ChainId: "osmosis-1",
+	})
+	require.NoError(t, err)
+
+	chains, err = app.ForwardingKeeper.Chains(sdkCtx, &types.QueryChains{})
+	require.NoError(t, err)
+	require.Empty(t, chains.ChainChannels)
+}
+
+func TestRegisterAccountByChainID(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-0", "osmosis-1")
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-1", "osmosis-1")
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-2", "cosmoshub-4")
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	_, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "osmo1recipient",
+		ChainId:   "osmosis-1",
+	})
+	require.ErrorContains(t, err, "no channel registered for chain: osmosis-1")
+
+	_, err = app.ForwardingKeeper.SetChainChannel(sdkCtx, &types.MsgSetChainChannel{
+		Signer:  authority,
+		ChainId: "osmosis-1",
+		Channel: "channel-1",
+	})
+	require.NoError(t, err)
+
+	address, err := app.ForwardingKeeper.Address(sdkCtx, &types.QueryAddress{
+		ChainId:   "osmosis-1",
+		Recipient: "osmo1recipient",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", address.Channel)
+	require.Equal(t, types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-1", "osmo1recipient", "").String(), address.Address)
+
+	res, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "osmo1recipient",
+		ChainId:   "osmosis-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, address.Address, res.Address)
+
+	_, err = app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "osmo1other",
+		Channel:   "channel-0",
+		ChainId:   "osmosis-1",
+	})
+	require.ErrorContains(t, err, "does not match channel channel-1 registered for chain osmosis-1")
+
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "osmo1other", nil)
+	registerAccountWithMemos(t, app, sdkCtx, "channel-2", "cosmos1recipient", nil)
+	app.ForwardingKeeper.IncrementNumOfForwards(sdkCtx, "channel-0")
+	app.ForwardingKeeper.IncrementTotalForwarded(sdkCtx, "channel-0", sdk.NewInt64Coin("uusdc", 100))
+	app.ForwardingKeeper.IncrementNumOfForwards(sdkCtx, "channel-1")
+	app.ForwardingKeeper.IncrementTotalForwarded(sdkCtx, "channel-1", sdk.NewInt64Coin("uusdc", 50))
+
+	stats, err := app.ForwardingKeeper.StatsByChain(sdkCtx, &types.QueryStatsByChain{ChainId: "osmosis-1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.NumOfAccounts)
+	require.Equal(t, uint64(2), stats.NumOfForwards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 150)), stats.TotalForwarded)
+	require.Equal(t, []string{"channel-0", "channel-1"}, stats.Channels)
+}
+
+func TestRegistrationPath(t *testing.T) {
+	configureSDK()
+
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "cosmos1recipient", "")
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name   string
+		signer string
+		path   string
+	}{
+		{name: "packet", signer: "", path: types.RegistrationPathPacket},
+		{name: "memo", signer: authtypes.NewModuleAddress(types.ModuleName).String(), path: types.RegistrationPathMemo},
+		{name: "signerless", signer: address.String(), path: types.RegistrationPathSignerless},
+		{name: "message", signer: signer, path: types.RegistrationPathMessage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &types.MsgRegisterAccount{Signer: tt.signer, Channel: "channel-0", Recipient: "cosmos1recipient"}
+			require.Equal(t, tt.path, keeper.RegistrationPath(msg, address))
+		})
+	}
+}
@@ -21,8 +21,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math"
+	"sort"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
@@ -30,6 +33,7 @@ import (
 	errorstypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/noble-assets/forwarding/v2/types"
 )
 
@@ -61,7 +65,20 @@ func (k *Keeper) Address(ctx context.Context, req *types.QueryAddress) (*types.Q
 		}
 	}
 
-	address := types.GenerateAddress(req.Channel, req.Recipient, req.Fallback)
+	channel := req.Channel
+	if req.ChainId != "" {
+		var err error
+		channel, err = k.ResolveChainChannel(ctx, req.ChainId, req.Channel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := types.ValidateAddressVersion(req.AddressVersion); err != nil {
+		return nil, err
+	}
+	version := types.ResolveAddressVersion(req.AddressVersion)
+	address := types.GenerateAddressWithVersion(version, channel, req.Recipient, req.Fallback)
 
 	exists := false
 	if k.accountKeeper.HasAccount(ctx, address) {
@@ -70,8 +87,59 @@ func (k *Keeper) Address(ctx context.Context, req *types.QueryAddress) (*types.Q
 	}
 
 	return &types.QueryAddressResponse{
-		Address: address.String(),
-		Exists:  exists,
+		Address:        address.String(),
+		Exists:         exists,
+		AddressVersion: version,
+		Channel:        channel,
+	}, nil
+}
+
+func (k *Keeper) Account(ctx context.Context, req *types.QueryAccount) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	account, err := k.GetForwardingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := sdk.NewCoins()
+	for _, denom := range k.GetAllowedDenoms(ctx) {
+		balances = balances.Add(k.bankKeeper.GetBalance(ctx, account.GetAddress(), denom))
+	}
+
+	var memos []types.MemoEntry
+	err = k.Memos.Walk(ctx, collections.NewPrefixedPairRange[string, string](account.Address), func(key collections.Pair[string, string], value string) (stop bool, err error) {
+		memos = append(memos, types.MemoEntry{
+			Denom: key.K2(),
+			Memo:  value,
+		})
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate memos for address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	channelState := channeltypes.UNINITIALIZED
+	if channel, found := k.channelKeeper.GetChannel(sdkCtx, transfertypes.PortID, account.Channel); found {
+		channelState = channel.State
+	}
+	_, chainId := k.GetChannelChainId(ctx, account.Channel)
+
+	return &types.QueryAccountResponse{
+		Address:        account.Address,
+		Channel:        account.Channel,
+		Recipient:      account.Recipient,
+		Fallback:       account.Fallback,
+		CreatedAt:      account.CreatedAt,
+		Balances:       balances,
+		Memos:          memos,
+		ChainId:        chainId,
+		ChannelState:   channelState.String(),
+		AddressVersion: account.EffectiveAddressVersion(),
 	}, nil
 }
 
@@ -82,17 +150,21 @@ func (k *Keeper) Stats(ctx context.Context, req *types.QueryStats) (*types.Query
 
 	stats := make(map[string]types.Stats)
 
-	for channel, numOfAccounts := range k.GetAllNumOfAccounts(ctx) {
+	for _, channel := range k.getStatsChannels(ctx) {
+		numOfAccounts, _ := k.NumOfAccounts.Get(ctx, channel)
 		numOfForwards, _ := k.NumOfForwards.Get(ctx, channel)
 		totalForwarded := k.GetTotalForwarded(ctx, channel)
+		numOfRegistrationFailures, _ := k.NumOfRegistrationFailures.Get(ctx, channel)
 
-		_, clientState, _ := k.channelKeeper.GetChannelClientState(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, channel)
+		clientType, chainId := k.GetChannelChainId(ctx, channel)
 
 		stats[channel] = types.Stats{
-			ChainId:        types.ParseChainId(clientState),
-			NumOfAccounts:  numOfAccounts,
-			NumOfForwards:  numOfForwards,
-			TotalForwarded: totalForwarded,
+			ChainId:                   chainId,
+			ClientType:                clientType,
+			NumOfAccounts:             numOfAccounts,
+			NumOfForwards:             numOfForwards,
+			TotalForwarded:            totalForwarded,
+			NumOfRegistrationFailures: numOfRegistrationFailures,
 		}
 	}
 
@@ -106,14 +178,138 @@ func (k *Keeper) StatsByChannel(ctx context.Context, req *types.QueryStatsByChan
 
 	numOfAccounts, _ := k.NumOfAccounts.Get(ctx, req.Channel)
 	numOfForwards, _ := k.NumOfForwards.Get(ctx, req.Channel)
+	numOfRegistrationFailures, _ := k.NumOfRegistrationFailures.Get(ctx, req.Channel)
 
 	return &types.QueryStatsByChannelResponse{
-		NumOfAccounts:  numOfAccounts,
-		NumOfForwards:  numOfForwards,
-		TotalForwarded: k.GetTotalForwarded(ctx, req.Channel),
+		NumOfAccounts:             numOfAccounts,
+		NumOfForwards:             numOfForwards,
+		TotalForwarded:            k.GetTotalForwarded(ctx, req.Channel),
+		NumOfRegistrationFailures: numOfRegistrationFailures,
+	}, nil
+}
+
+func (k *Keeper) StatsByChain(ctx context.Context, req *types.QueryStatsByChain) (*types.QueryStatsByChainResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	res := &types.QueryStatsByChainResponse{
+		TotalForwarded: sdk.NewCoins(),
+		Channels:       []string{},
+	}
+
+	for _, channel := range k.getStatsChannels(ctx) {
+		if _, chainId := k.GetChannelChainId(ctx, channel); chainId != req.ChainId {
+			continue
+		}
+
+		numOfAccounts, _ := k.NumOfAccounts.Get(ctx, channel)
+		numOfForwards, _ := k.NumOfForwards.Get(ctx, channel)
+		numOfRegistrationFailures, _ := k.NumOfRegistrationFailures.Get(ctx, channel)
+
+		res.NumOfAccounts += numOfAccounts
+		res.NumOfForwards += numOfForwards
+		res.TotalForwarded = res.TotalForwarded.Add(k.GetTotalForwarded(ctx, channel)...)
+		res.NumOfRegistrationFailures += numOfRegistrationFailures
+		res.Channels = append(res.Channels, channel)
+	}
+
+	return res, nil
+}
+
+// getStatsChannels returns the sorted channels that have either registered
+// accounts or soft-failed registrations.
+func (k *Keeper) getStatsChannels(ctx context.Context) []string {
+	set := make(map[string]struct{})
+	for channel := range k.GetAllNumOfAccounts(ctx) {
+		set[channel] = struct{}{}
+	}
+	for channel := range k.GetAllNumOfRegistrationFailures(ctx) {
+		set[channel] = struct{}{}
+	}
+
+	channels := make([]string, 0, len(set))
+	for channel := range set {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+
+	return channels
+}
+
+func (k *Keeper) StatsByAccount(ctx context.Context, req *types.QueryStatsByAccount) (*types.QueryStatsByAccountResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	_, err := k.accountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid account address")
+	}
+
+	stats, _ := k.AccountStats.Get(ctx, req.Address)
+
+	return &types.QueryStatsByAccountResponse{
+		NumOfForwards:      stats.NumOfForwards,
+		TotalForwarded:     stats.TotalForwarded,
+		FirstForwardHeight: stats.FirstForwardHeight,
+		LastForwardHeight:  stats.LastForwardHeight,
 	}, nil
 }
 
+func (k *Keeper) StatsHistory(ctx context.Context, req *types.QueryStatsHistory) (*types.QueryStatsHistoryResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	if req.StartDay > req.EndDay {
+		return nil, errors.Wrap(errorstypes.ErrInvalidRequest, "start day must not be after end day")
+	}
+
+	buckets, pageRes, err := query.CollectionFilteredPaginate(ctx, newStatsBucketsInRange(k.StatsBuckets, req.StartDay, req.EndDay), req.Pagination, func(key collections.Triple[uint64, string, string], _ types.StatsBucket) (bool, error) {
+		return req.Channel == "" || key.K2() == req.Channel, nil
+	}, func(_ collections.Triple[uint64, string, string], bucket types.StatsBucket) (types.StatsBucket, error) {
+		return bucket, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate stats buckets")
+	}
+
+	return &types.QueryStatsHistoryResponse{Buckets: buckets, Pagination: pageRes}, nil
+}
+
+// statsBucketsInRange restricts the raw iteration of the stats buckets to the
+// keys of an inclusive day range, so that paginating over them never scans
+// buckets outside of it. Buckets are keyed by day first, which makes the
+// encoded day a valid bound for the whole key.
+type statsBucketsInRange struct {
+	collections.Map[collections.Triple[uint64, string, string], types.StatsBucket]
+	start, end []byte
+}
+
+func newStatsBucketsInRange(buckets collections.Map[collections.Triple[uint64, string, string], types.StatsBucket], startDay, endDay uint64) statsBucketsInRange {
+	coll := statsBucketsInRange{Map: buckets, start: sdk.Uint64ToBigEndian(startDay)}
+	if endDay < math.MaxUint64 {
+		coll.end = sdk.Uint64ToBigEndian(endDay + 1)
+	}
+
+	return coll
+}
+
+func (c statsBucketsInRange) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Triple[uint64, string, string], types.StatsBucket], error) {
+	if bytes.Compare(start, c.start) < 0 {
+		start = c.start
+	}
+	if c.end != nil && (end == nil || bytes.Compare(end, c.end) > 0) {
+		end = c.end
+	}
+	if end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+
+	return c.Map.IterateRaw(ctx, start, end, order)
+}
+
 func (k *Keeper) GetMemo(ctx context.Context, req *types.QueryMemo) (*types.QueryMemoResponse, error) {
 	if req == nil {
 		return nil, errorstypes.ErrInvalidRequest
@@ -149,3 +345,120 @@ func (k *Keeper) GetMemos(ctx context.Context, req *types.QueryMemos) (*types.Qu
 
 	return &types.QueryMemosResponse{Memos: memos, Pagination: pageRes}, nil
 }
+
+func (k *Keeper) Accounts(ctx context.Context, req *types.QueryAccounts) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	accounts, pageRes, err := query.CollectionPaginate(ctx, k.RegisteredAccounts, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (types.ForwardingAccount, error) {
+		return k.getIndexedAccount(ctx, key.K2())
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate accounts")
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) AccountsByChannel(ctx context.Context, req *types.QueryAccountsByChannel) (*types.QueryAccountsByChannelResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	accounts, pageRes, err := query.CollectionPaginate(ctx, k.RegisteredAccounts, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (types.ForwardingAccount, error) {
+		return k.getIndexedAccount(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, string](req.Channel))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate accounts for channel")
+	}
+
+	return &types.QueryAccountsByChannelResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) AccountsByRecipient(ctx context.Context, req *types.QueryAccountsByRecipient) (*types.QueryAccountsByRecipientResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	accounts, pageRes, err := query.CollectionPaginate(ctx, k.RecipientAccounts, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (types.ForwardingAccount, error) {
+		return k.getIndexedAccount(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, string](req.Recipient))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate accounts for recipient")
+	}
+
+	return &types.QueryAccountsByRecipientResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) AccountsByFallback(ctx context.Context, req *types.QueryAccountsByFallback) (*types.QueryAccountsByFallbackResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	_, err := k.accountKeeper.AddressCodec().StringToBytes(req.Fallback)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid fallback address")
+	}
+
+	accounts, pageRes, err := query.CollectionPaginate(ctx, k.FallbackAccounts, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (types.ForwardingAccount, error) {
+		return k.getIndexedAccount(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, string](req.Fallback))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate accounts for fallback")
+	}
+
+	return &types.QueryAccountsByFallbackResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) ForwardHistory(ctx context.Context, req *types.QueryForwardHistory) (*types.QueryForwardHistoryResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	_, err := k.accountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid account address")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.ForwardRecords, req.Pagination, func(_ collections.Pair[string, uint64], value types.ForwardRecord) (types.ForwardRecord, error) {
+		return value, nil
+	}, query.WithCollectionPaginationPairPrefix[string, uint64](req.Address))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate forward history for address")
+	}
+
+	return &types.QueryForwardHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// getIndexedAccount resolves an address stored in one of the account indexes
+// to the underlying forwarding account.
+func (k *Keeper) getIndexedAccount(ctx context.Context, address string) (types.ForwardingAccount, error) {
+	account, err := k.GetForwardingAccount(ctx, address)
+	if err != nil {
+		return types.ForwardingAccount{}, errors.Wrapf(err, "failed to get account %s", address)
+	}
+
+	return *account, nil
+}
+
+func (k *Keeper) Audit(ctx context.Context, req *types.QueryAudit) (*types.QueryAuditResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	discrepancies := Audit(ctx, k)
+
+	return &types.QueryAuditResponse{
+		Broken:        len(discrepancies) > 0,
+		Discrepancies: discrepancies,
+	}, nil
+}
+
+func (k *Keeper) Chains(ctx context.Context, req *types.QueryChains) (*types.QueryChainsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryChainsResponse{ChainChannels: k.GetAllChainChannels(ctx)}, nil
+}
@@ -23,13 +23,24 @@ package keeper_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	"github.com/stretchr/testify/require"
 
 	"github.com/noble-assets/forwarding/simapp"
+	"github.com/noble-assets/forwarding/v2/keeper"
 	"github.com/noble-assets/forwarding/v2/types"
 )
 
@@ -80,6 +91,348 @@ func TestQueryGetMemosFiltersByAddress(t *testing.T) {
 	}
 }
 
+func TestQueryAccount(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+
+	memos := []types.MemoEntry{{Denom: "uusdc", Memo: "memo-usdc"}}
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", memos)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100), sdk.NewInt64Coin("uatom", 50))
+	require.NoError(t, app.BankKeeper.MintCoins(sdkCtx, transfertypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, transfertypes.ModuleName, sdk.MustAccAddressFromBech32(addr), coins))
+
+	resp, err := app.ForwardingKeeper.Account(sdkCtx, &types.QueryAccount{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, addr, resp.Address)
+	require.Equal(t, "channel-0", resp.Channel)
+	require.Equal(t, "iaa1recipient", resp.Recipient)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)), resp.Balances)
+	require.Equal(t, memos, resp.Memos)
+	require.Equal(t, "STATE_OPEN", resp.ChannelState)
+
+	_, err = app.ForwardingKeeper.Account(sdkCtx, &types.QueryAccount{
+		Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+	})
+	require.ErrorContains(t, err, "account does not exist")
+}
+
+func TestQueryAccountsByChannel(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+
+	addr1 := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipientone", nil)
+	addr2 := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipienttwo", nil)
+	addr3 := registerAccountWithMemos(t, app, sdkCtx, "channel-1", "iaa1recipientone", nil)
+
+	resp, err := app.ForwardingKeeper.Accounts(sdkCtx, &types.QueryAccounts{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Accounts, 2)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	next, err := app.ForwardingKeeper.Accounts(sdkCtx, &types.QueryAccounts{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, next.Accounts, 1)
+	require.Equal(t, addr3, next.Accounts[0].Address)
+	require.Equal(t, "channel-1", next.Accounts[0].Channel)
+
+	byChannel, err := app.ForwardingKeeper.AccountsByChannel(sdkCtx, &types.QueryAccountsByChannel{
+		Channel: "channel-0",
+	})
+	require.NoError(t, err)
+	require.Len(t, byChannel.Accounts, 2)
+	require.ElementsMatch(t, []string{addr1, addr2}, []string{byChannel.Accounts[0].Address, byChannel.Accounts[1].Address})
+}
+
+func TestQueryAccountsByRecipientAndFallback(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	ensureOpenChannel(t, app, sdkCtx, "channel-1")
+
+	fallback := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	register := func(channel, recipient, fallback string) string {
+		res, err := app.ForwardingKeeper.RegisterAccount(sdkCtx, &types.MsgRegisterAccount{
+			Signer:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Recipient: recipient,
+			Channel:   channel,
+			Fallback:  fallback,
+		})
+		require.NoError(t, err)
+		return res.Address
+	}
+
+	addr1 := register("channel-0", "iaa1recipient", "")
+	addr2 := register("channel-1", "iaa1recipient", fallback)
+	addr3 := register("channel-0", "iaa1otherrecipient", fallback)
+
+	byRecipient, err := app.ForwardingKeeper.AccountsByRecipient(sdkCtx, &types.QueryAccountsByRecipient{
+		Recipient: "iaa1recipient",
+	})
+	require.NoError(t, err)
+	require.Len(t, byRecipient.Accounts, 2)
+	require.ElementsMatch(t, []string{addr1, addr2}, []string{byRecipient.Accounts[0].Address, byRecipient.Accounts[1].Address})
+
+	byFallback, err := app.ForwardingKeeper.AccountsByFallback(sdkCtx, &types.QueryAccountsByFallback{
+		Fallback: fallback,
+	})
+	require.NoError(t, err)
+	require.Len(t, byFallback.Accounts, 2)
+	require.ElementsMatch(t, []string{addr2, addr3}, []string{byFallback.Accounts[0].Address, byFallback.Accounts[1].Address})
+
+	_, err = app.ForwardingKeeper.AccountsByFallback(sdkCtx, &types.QueryAccountsByFallback{
+		Fallback: "invalid",
+	})
+	require.ErrorContains(t, err, "invalid fallback address")
+}
+
+func TestQueryForwardHistoryPrunesToRetention(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+	require.NoError(t, app.ForwardingKeeper.HistoryRetention.Set(sdkCtx, 3))
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+	for i := int64(1); i <= 5; i++ {
+		app.ForwardingKeeper.AppendForwardRecord(sdkCtx, addr, sdk.NewInt64Coin("uusdc", i), uint64(i), types.FORWARD_OUTCOME_SENT)
+	}
+
+	resp, err := app.ForwardingKeeper.ForwardHistory(sdkCtx, &types.QueryForwardHistory{Address: addr})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 3)
+	for i, record := range resp.Records {
+		require.Equal(t, uint64(i+3), record.Sequence)
+		require.Equal(t, types.FORWARD_OUTCOME_SENT, record.Outcome)
+	}
+
+	// Lowering the retention prunes older records on the next write.
+	require.NoError(t, app.ForwardingKeeper.HistoryRetention.Set(sdkCtx, 1))
+	app.ForwardingKeeper.AppendForwardRecord(sdkCtx, addr, sdk.NewInt64Coin("uusdc", 6), 0, types.FORWARD_OUTCOME_FAILED)
+
+	resp, err = app.ForwardingKeeper.ForwardHistory(sdkCtx, &types.QueryForwardHistory{Address: addr})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	require.Equal(t, types.FORWARD_OUTCOME_FAILED, resp.Records[0].Outcome)
+}
+
+func TestQueryStatsResolvesChainIds(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	// NOTE: The simapp doesn't wire solo machines, so we only register their
+	// types, which is enough to store and read back their client state.
+	solomachine.RegisterInterfaces(app.AppCodec().InterfaceRegistry())
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-0", "osmosis-1")
+	ensureOpenChannelWithClient(t, app, sdkCtx, "channel-1", "06-solomachine-0", &solomachine.ClientState{
+		Sequence:       1,
+		ConsensusState: &solomachine.ConsensusState{Diversifier: "solo-1"},
+	})
+	ensureOpenChannel(t, app, sdkCtx, "channel-2")
+
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "osmo1recipient", nil)
+	registerAccountWithMemos(t, app, sdkCtx, "channel-1", "solo1recipient", nil)
+	registerAccountWithMemos(t, app, sdkCtx, "channel-2", "cosmos1recipient", nil)
+
+	resp, err := app.ForwardingKeeper.Stats(sdkCtx, &types.QueryStats{})
+	require.NoError(t, err)
+	require.Equal(t, "osmosis-1", resp.Stats["channel-0"].ChainId)
+	require.Equal(t, exported.Tendermint, resp.Stats["channel-0"].ClientType)
+	require.Equal(t, types.UnknownChainId, resp.Stats["channel-1"].ChainId)
+	require.Equal(t, exported.Solomachine, resp.Stats["channel-1"].ClientType)
+	require.Equal(t, types.UnknownChainId, resp.Stats["channel-2"].ChainId)
+	require.Empty(t, resp.Stats["channel-2"].ClientType)
+
+	app.ForwardingKeeper.SetChainIdResolver(exported.Solomachine, func(exported.ClientState) (string, bool) {
+		return "custom-1", true
+	})
+
+	resp, err = app.ForwardingKeeper.Stats(sdkCtx, &types.QueryStats{})
+	require.NoError(t, err)
+	require.Equal(t, "custom-1", resp.Stats["channel-1"].ChainId)
+}
+
+func TestQueryStatsRegistrationFailures(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-0", "osmosis-1")
+	ensureOpenChannelToChain(t, app, sdkCtx, "channel-1", "osmosis-1")
+
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "osmo1recipient", nil)
+
+	msg := &types.MsgRegisterAccount{Recipient: "osmo1recipient", Channel: "invalid"}
+	app.ForwardingKeeper.RecordRegistrationFailure(sdkCtx, "channel-0", "noble1receiver", msg, types.ErrInvalidChannel)
+	app.ForwardingKeeper.RecordRegistrationFailure(sdkCtx, "channel-1", "noble1receiver", msg, types.ErrInvalidChannel)
+	app.ForwardingKeeper.RecordRegistrationFailure(sdkCtx, "channel-1", "noble1receiver", msg, types.ErrInvalidChannel)
+
+	stats, err := app.ForwardingKeeper.Stats(sdkCtx, &types.QueryStats{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.Stats["channel-0"].NumOfRegistrationFailures)
+	require.Equal(t, uint64(2), stats.Stats["channel-1"].NumOfRegistrationFailures)
+	require.Zero(t, stats.Stats["channel-1"].NumOfAccounts)
+
+	byChannel, err := app.ForwardingKeeper.StatsByChannel(sdkCtx, &types.QueryStatsByChannel{Channel: "channel-1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), byChannel.NumOfRegistrationFailures)
+
+	byChain, err := app.ForwardingKeeper.StatsByChain(sdkCtx, &types.QueryStatsByChain{ChainId: "osmosis-1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), byChain.NumOfRegistrationFailures)
+	require.Equal(t, []string{"channel-0", "channel-1"}, byChain.Channels)
+}
+
+func TestQueryStatsByAccount(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	addr := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipient", nil)
+
+	resp, err := app.ForwardingKeeper.StatsByAccount(sdkCtx, &types.QueryStatsByAccount{Address: addr})
+	require.NoError(t, err)
+	require.Zero(t, resp.NumOfForwards)
+	require.True(t, resp.TotalForwarded.IsZero())
+
+	firstCtx := sdkCtx.WithHeaderInfo(header.Info{Height: 10})
+	app.ForwardingKeeper.IncrementAccountStats(firstCtx, addr, sdk.NewInt64Coin("uusdc", 100))
+	lastCtx := sdkCtx.WithHeaderInfo(header.Info{Height: 20})
+	app.ForwardingKeeper.IncrementAccountStats(lastCtx, addr, sdk.NewInt64Coin("uusdc", 50))
+	app.ForwardingKeeper.IncrementAccountStats(lastCtx, addr, sdk.NewInt64Coin("uatom", 25))
+
+	resp, err = app.ForwardingKeeper.StatsByAccount(sdkCtx, &types.QueryStatsByAccount{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.NumOfForwards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 150), sdk.NewInt64Coin("uatom", 25)), resp.TotalForwarded)
+	require.Equal(t, int64(10), resp.FirstForwardHeight)
+	require.Equal(t, int64(20), resp.LastForwardHeight)
+}
+
+func TestQueryStatsHistory(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	require.NoError(t, app.ForwardingKeeper.StatsRetention.Set(sdkCtx, 2))
+
+	day := func(n int64) sdk.Context {
+		return sdkCtx.WithHeaderInfo(header.Info{Time: time.Unix(n*86400+3600, 0)})
+	}
+
+	app.ForwardingKeeper.IncrementStatsBucket(day(10), "channel-0", sdk.NewInt64Coin("uusdc", 100))
+	app.ForwardingKeeper.IncrementStatsBucket(day(10), "channel-0", sdk.NewInt64Coin("uusdc", 50))
+	app.ForwardingKeeper.IncrementStatsBucket(day(11), "channel-0", sdk.NewInt64Coin("uusdc", 25))
+	app.ForwardingKeeper.IncrementStatsBucket(day(11), "channel-1", sdk.NewInt64Coin("uatom", 10))
+
+	resp, err := app.ForwardingKeeper.StatsHistory(sdkCtx, &types.QueryStatsHistory{StartDay: 10, EndDay: 11})
+	require.NoError(t, err)
+	require.Len(t, resp.Buckets, 3)
+	require.Equal(t, uint64(10), resp.Buckets[0].Day)
+	require.Equal(t, uint64(2), resp.Buckets[0].NumOfForwards)
+	require.Equal(t, int64(150), resp.Buckets[0].TotalForwarded.Int64())
+
+	resp, err = app.ForwardingKeeper.StatsHistory(sdkCtx, &types.QueryStatsHistory{StartDay: 10, EndDay: 11, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, resp.Buckets, 2)
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	resp, err = app.ForwardingKeeper.StatsHistory(sdkCtx, &types.QueryStatsHistory{StartDay: 10, EndDay: 11, Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, resp.Buckets, 1)
+	require.Equal(t, "channel-1", resp.Buckets[0].Channel)
+
+	resp, err = app.ForwardingKeeper.StatsHistory(sdkCtx, &types.QueryStatsHistory{StartDay: 11, EndDay: 11, Channel: "channel-1"})
+	require.NoError(t, err)
+	require.Len(t, resp.Buckets, 1)
+	require.Equal(t, "uatom", resp.Buckets[0].Denom)
+
+	_, err = app.ForwardingKeeper.StatsHistory(sdkCtx, &types.QueryStatsHistory{StartDay: 11, EndDay: 10})
+	require.Error(t, err)
+
+	// Buckets outside of the retention window are pruned.
+	app.ForwardingKeeper.PruneStatsBuckets(day(12))
+
+	resp, err = app.ForwardingKeeper.StatsHistory(sdkCtx, &types.QueryStatsHistory{StartDay: 0, EndDay: math.MaxUint64})
+	require.NoError(t, err)
+	require.Len(t, resp.Buckets, 2)
+	for _, bucket := range resp.Buckets {
+		require.Equal(t, uint64(11), bucket.Day)
+	}
+}
+
+func TestQueryStatsHistoryScansOnlyRequestedDays(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+
+	day := func(n int64) sdk.Context {
+		return sdkCtx.WithHeaderInfo(header.Info{Time: time.Unix(n*86400+3600, 0)})
+	}
+	gasUsed := func() uint64 {
+		ctx := sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		resp, err := app.ForwardingKeeper.StatsHistory(ctx, &types.QueryStatsHistory{StartDay: 10, EndDay: 10, Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		require.Len(t, resp.Buckets, 1)
+		require.Equal(t, uint64(1), resp.Pagination.Total)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	app.ForwardingKeeper.IncrementStatsBucket(day(10), "channel-0", sdk.NewInt64Coin("uusdc", 100))
+	expected := gasUsed()
+
+	// Buckets before and after the requested days must not be iterated.
+	for _, n := range []int64{0, 5, 9, 11, 20} {
+		app.ForwardingKeeper.IncrementStatsBucket(day(n), "channel-0", sdk.NewInt64Coin("uusdc", 100))
+	}
+	require.Equal(t, expected, gasUsed())
+
+	resp, err := app.ForwardingKeeper.StatsHistory(sdkCtx, &types.QueryStatsHistory{StartDay: 9, EndDay: 11, Pagination: &query.PageRequest{Reverse: true}})
+	require.NoError(t, err)
+	require.Len(t, resp.Buckets, 3)
+	for i, n := range []uint64{11, 10, 9} {
+		require.Equal(t, n, resp.Buckets[i].Day)
+	}
+}
+
+func TestQueryAudit(t *testing.T) {
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureOpenChannel(t, app, sdkCtx, "channel-0")
+
+	memos := []types.MemoEntry{{Denom: "uusdc", Memo: "memo-usdc"}}
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipientone", memos)
+	registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipienttwo", nil)
+	app.ForwardingKeeper.IncrementTotalForwarded(sdkCtx, "channel-0", sdk.NewInt64Coin("uusdc", 100))
+
+	resp, err := app.ForwardingKeeper.Audit(sdkCtx, &types.QueryAudit{})
+	require.NoError(t, err)
+	require.False(t, resp.Broken)
+	require.Empty(t, resp.Discrepancies)
+	_, broken := keeper.AllInvariants(app.ForwardingKeeper)(sdkCtx)
+	require.False(t, broken)
+
+	unknown := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, app.ForwardingKeeper.NumOfAccounts.Set(sdkCtx, "channel-0", 3))
+	require.NoError(t, app.ForwardingKeeper.TotalForwarded.Set(sdkCtx, collections.Join("channel-1", "uusdc"), sdkmath.NewInt(-1)))
+	require.NoError(t, app.ForwardingKeeper.Memos.Set(sdkCtx, collections.Join(unknown, "uusdc"), "memo-usdc"))
+
+	resp, err = app.ForwardingKeeper.Audit(sdkCtx, &types.QueryAudit{})
+	require.NoError(t, err)
+	require.True(t, resp.Broken)
+	require.Len(t, resp.Discrepancies, 3)
+	require.Equal(t, keeper.NumOfAccountsInvariantName, resp.Discrepancies[0].Invariant)
+	require.Equal(t, "channel-0", resp.Discrepancies[0].Key)
+	require.Equal(t, keeper.TotalForwardedInvariantName, resp.Discrepancies[1].Invariant)
+	require.Equal(t, "channel-1", resp.Discrepancies[1].Key)
+	require.Equal(t, keeper.MemosInvariantName, resp.Discrepancies[2].Invariant)
+	require.Equal(t, unknown, resp.Discrepancies[2].Key)
+
+	msg, broken := keeper.NumOfAccountsInvariant(app.ForwardingKeeper)(sdkCtx)
+	require.True(t, broken)
+	require.Contains(t, msg, "stored 3 accounts, found 2 forwarding accounts")
+
+	// Index entries must refer to forwarding accounts on the same channel.
+	require.NoError(t, app.ForwardingKeeper.RegisteredAccounts.Set(sdkCtx, collections.Join("channel-0", unknown)))
+	discrepancies := app.ForwardingKeeper.AuditNumOfAccounts(sdkCtx)
+	require.Len(t, discrepancies, 2)
+	require.Equal(t, unknown, discrepancies[0].Key)
+	require.Equal(t, "channel-0", discrepancies[1].Key)
+}
+
 func registerAccountWithMemos(t *testing.T, appCtx *simapp.SimApp, ctx context.Context, channel, recipient string, memos []types.MemoEntry) string {
 	t.Helper()
 
@@ -22,8 +22,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"sort"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/forwarding/v2/types"
 )
@@ -79,27 +82,384 @@ func (k *Keeper) IncrementNumOfForwards(ctx context.Context, channel string) {
 	_ = k.NumOfForwards.Set(ctx, channel, count+1)
 }
 
+func (k *Keeper) GetAllNumOfRegistrationFailures(ctx context.Context) map[string]uint64 {
+	counts := make(map[string]uint64)
+
+	_ = k.NumOfRegistrationFailures.Walk(ctx, nil, func(key string, value uint64) (stop bool, err error) {
+		counts[key] = value
+
+		return false, nil
+	})
+
+	return counts
+}
+
+func (k *Keeper) IncrementNumOfRegistrationFailures(ctx context.Context, channel string) {
+	count, _ := k.NumOfRegistrationFailures.Get(ctx, channel)
+	_ = k.NumOfRegistrationFailures.Set(ctx, channel, count+1)
+}
+
+func (k *Keeper) GetAllInFlightPackets(ctx context.Context) []types.InFlightPacket {
+	var packets []types.InFlightPacket
+
+	_ = k.InFlightPackets.Walk(ctx, nil, func(_ collections.Pair[string, uint64], packet types.InFlightPacket) (stop bool, err error) {
+		packets = append(packets, packet)
+
+		return false, nil
+	})
+
+	return packets
+}
+
+// RecordRegistrationFailure counts a soft-failed registration on the channel
+// that the transfer was received on, and emits an event describing it.
+func (k *Keeper) RecordRegistrationFailure(ctx context.Context, channel string, address string, msg *types.MsgRegisterAccount, err error) {
+	k.IncrementNumOfRegistrationFailures(ctx, channel)
+
+	_ = k.eventService.EventManager(ctx).Emit(ctx, &types.RegistrationFailed{
+		Address:   address,
+		Channel:   channel,
+		Recipient: msg.Recipient,
+		Fallback:  msg.Fallback,
+		Error:     err.Error(),
+	})
+}
+
 func (k *Keeper) GetTotalForwarded(ctx context.Context, channel string) sdk.Coins {
-	rawTotal, _ := k.TotalForwarded.Get(ctx, channel)
-	total, _ := sdk.ParseCoinsNormalized(rawTotal)
+	total := sdk.NewCoins()
+
+	// NOTE: Entries are ordered by denom, so the coins are sorted by construction.
+	_ = k.TotalForwarded.Walk(ctx, collections.NewPrefixedPairRange[string, string](channel), func(key collections.Pair[string, string], amount math.Int) (stop bool, err error) {
+		total = append(total, sdk.Coin{Denom: key.K2(), Amount: amount})
+
+		return false, nil
+	})
+
 	return total
 }
 
 func (k *Keeper) GetAllTotalForwarded(ctx context.Context) map[string]string {
-	totals := make(map[string]string)
+	totals := make(map[string]sdk.Coins)
 
-	_ = k.TotalForwarded.Walk(ctx, nil, func(key string, value string) (stop bool, err error) {
-		totals[key] = value
+	_ = k.TotalForwarded.Walk(ctx, nil, func(key collections.Pair[string, string], amount math.Int) (stop bool, err error) {
+		totals[key.K1()] = append(totals[key.K1()], sdk.Coin{Denom: key.K2(), Amount: amount})
 
 		return false, nil
 	})
 
-	return totals
+	rawTotals := make(map[string]string, len(totals))
+	for channel, total := range totals {
+		rawTotals[channel] = total.String()
+	}
+
+	return rawTotals
 }
 
 func (k *Keeper) IncrementTotalForwarded(ctx context.Context, channel string, coin sdk.Coin) {
-	total := k.GetTotalForwarded(ctx, channel)
-	_ = k.TotalForwarded.Set(ctx, channel, total.Add(coin).String())
+	key := collections.Join(channel, coin.Denom)
+
+	amount, err := k.TotalForwarded.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		amount = math.ZeroInt()
+	}
+
+	_ = k.TotalForwarded.Set(ctx, key, amount.Add(coin.Amount))
+}
+
+func (k *Keeper) GetAllAccountStats(ctx context.Context) map[string]types.AccountStats {
+	stats := make(map[string]types.AccountStats)
+
+	_ = k.AccountStats.Walk(ctx, nil, func(key string, value types.AccountStats) (stop bool, err error) {
+		stats[key] = value
+
+		return false, nil
+	})
+
+	return stats
+}
+
+// IncrementAccountStats records a successful forward in the cumulative
+// statistics of a forwarding account.
+func (k *Keeper) IncrementAccountStats(ctx context.Context, address string, coin sdk.Coin) {
+	height := k.headerService.GetHeaderInfo(ctx).Height
+
+	stats, err := k.AccountStats.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		stats.FirstForwardHeight = height
+	}
+
+	stats.NumOfForwards += 1
+	stats.TotalForwarded = stats.TotalForwarded.Add(coin)
+	stats.LastForwardHeight = height
+
+	_ = k.AccountStats.Set(ctx, address, stats)
+}
+
+// GetRegistrationMode returns how failed registrations through transfer memos
+// are handled, falling back to strict if it has not been set.
+func (k *Keeper) GetRegistrationMode(ctx context.Context) types.RegistrationMode {
+	mode, err := k.RegistrationMode.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.REGISTRATION_MODE_STRICT
+	}
+
+	return types.ResolveRegistrationMode(types.RegistrationMode(mode))
+}
+
+// GetForwardMode returns when funds received by forwarding accounts over IBC
+// are forwarded, falling back to end block if it has not been set.
+func (k *Keeper) GetForwardMode(ctx context.Context) types.ForwardMode {
+	mode, err := k.ForwardMode.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FORWARD_MODE_END_BLOCK
+	}
+
+	return types.ResolveForwardMode(types.ForwardMode(mode))
+}
+
+// GetSignerlessFee returns the fee deducted from forwarding accounts that
+// register signerlessly, falling back to no fee if it has not been set.
+func (k *Keeper) GetSignerlessFee(ctx context.Context) sdk.Coin {
+	fee, err := k.SignerlessFee.Get(ctx)
+	if err != nil {
+		return sdk.Coin{Amount: math.ZeroInt()}
+	}
+
+	return fee
+}
+
+// GetRegistrationFee returns the fee burned when registering forwarding
+// accounts, falling back to no fee if it has not been set.
+func (k *Keeper) GetRegistrationFee(ctx context.Context) sdk.Coin {
+	fee, err := k.RegistrationFee.Get(ctx)
+	if err != nil {
+		return sdk.Coin{Amount: math.ZeroInt()}
+	}
+
+	return fee
+}
+
+func (k *Keeper) GetAllUnpaidRegistrationFees(ctx context.Context) map[string]sdk.Coin {
+	fees := make(map[string]sdk.Coin)
+
+	_ = k.UnpaidRegistrationFees.Walk(ctx, nil, func(key string, value sdk.Coin) (stop bool, err error) {
+		fees[key] = value
+
+		return false, nil
+	})
+
+	return fees
+}
+
+// GetStatsRetention returns the number of days of time-bucketed stats kept,
+// falling back to the default if it has not been set.
+func (k *Keeper) GetStatsRetention(ctx context.Context) uint64 {
+	retention, err := k.StatsRetention.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultStatsRetention
+	}
+
+	return retention
+}
+
+// GetCurrentDay returns the number of days since the Unix epoch of the
+// current block time.
+func (k *Keeper) GetCurrentDay(ctx context.Context) uint64 {
+	unix := k.headerService.GetHeaderInfo(ctx).Time.Unix()
+	if unix < 0 {
+		return 0
+	}
+
+	return uint64(unix) / types.SecondsPerDay
+}
+
+func (k *Keeper) GetAllStatsBuckets(ctx context.Context) (buckets []types.StatsBucket) {
+	_ = k.StatsBuckets.Walk(ctx, nil, func(_ collections.Triple[uint64, string, string], bucket types.StatsBucket) (stop bool, err error) {
+		buckets = append(buckets, bucket)
+
+		return false, nil
+	})
+
+	return
+}
+
+// IncrementStatsBucket records a successful forward in the stats bucket of
+// the current day for the given channel and denom.
+func (k *Keeper) IncrementStatsBucket(ctx context.Context, channel string, coin sdk.Coin) {
+	if k.GetStatsRetention(ctx) == 0 {
+		return
+	}
+
+	day := k.GetCurrentDay(ctx)
+	key := collections.Join3(day, channel, coin.Denom)
+
+	bucket, err := k.StatsBuckets.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		bucket = types.StatsBucket{
+			Day:            day,
+			Channel:        channel,
+			Denom:          coin.Denom,
+			TotalForwarded: math.ZeroInt(),
+		}
+	}
+
+	bucket.NumOfForwards += 1
+	bucket.TotalForwarded = bucket.TotalForwarded.Add(coin.Amount)
+
+	_ = k.StatsBuckets.Set(ctx, key, bucket)
+}
+
+// PruneStatsBuckets removes all stats buckets that are older than the
+// configured retention window.
+func (k *Keeper) PruneStatsBuckets(ctx context.Context) {
+	day, retention := k.GetCurrentDay(ctx), k.GetStatsRetention(ctx)
+	if retention == 0 {
+		_ = k.StatsBuckets.Clear(ctx, nil)
+		return
+	}
+	if day < retention {
+		return
+	}
+
+	_ = k.StatsBuckets.Clear(ctx, collections.NewPrefixUntilTripleRange[uint64, string, string](day-retention))
+}
+
+func (k *Keeper) GetAllChainChannels(ctx context.Context) map[string]string {
+	channels := make(map[string]string)
+
+	_ = k.ChainChannels.Walk(ctx, nil, func(chainID string, channel string) (stop bool, err error) {
+		channels[chainID] = channel
+
+		return false, nil
+	})
+
+	return channels
+}
+
+// GetHistoryRetention returns the number of forward history records kept per
+// forwarding account, falling back to the default if it has not been set.
+func (k *Keeper) GetHistoryRetention(ctx context.Context) uint64 {
+	retention, err := k.HistoryRetention.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultHistoryRetention
+	}
+
+	return retention
+}
+
+func (k *Keeper) GetAllHistorySequences(ctx context.Context) map[string]uint64 {
+	sequences := make(map[string]uint64)
+
+	_ = k.HistorySequence.Walk(ctx, nil, func(address string, sequence uint64) (stop bool, err error) {
+		sequences[address] = sequence
+
+		return false, nil
+	})
+
+	return sequences
+}
+
+func (k *Keeper) GetAllForwardRecords(ctx context.Context) (records []types.ForwardRecordEntry) {
+	_ = k.ForwardRecords.Walk(ctx, nil, func(key collections.Pair[string, uint64], record types.ForwardRecord) (stop bool, err error) {
+		records = append(records, types.ForwardRecordEntry{
+			Address: key.K1(),
+			Index:   key.K2(),
+			Record:  record,
+		})
+
+		return false, nil
+	})
+
+	return
+}
+
+// AppendForwardRecord adds an entry to the forward history of an account,
+// pruning any entries that exceed the configured retention.
+func (k *Keeper) AppendForwardRecord(ctx context.Context, address string, amount sdk.Coin, sequence uint64, outcome types.ForwardOutcome) {
+	retention := k.GetHistoryRetention(ctx)
+	if retention == 0 {
+		return
+	}
+
+	index, _ := k.HistorySequence.Get(ctx, address)
+	_ = k.ForwardRecords.Set(ctx, collections.Join(address, index), types.ForwardRecord{
+		Height:   k.headerService.GetHeaderInfo(ctx).Height,
+		Amount:   amount,
+		Sequence: sequence,
+		Outcome:  outcome,
+	})
+	_ = k.HistorySequence.Set(ctx, address, index+1)
+
+	if index+1 > retention {
+		rng := collections.NewPrefixedPairRange[string, uint64](address).EndExclusive(index + 1 - retention)
+		_ = k.ForwardRecords.Clear(ctx, rng)
+	}
+}
+
+// IndexAccount adds a forwarding account to the module-owned indexes of
+// registered accounts, keyed by its channel, recipient, and fallback.
+func (k *Keeper) IndexAccount(ctx context.Context, account *types.ForwardingAccount) error {
+	if err := k.RegisteredAccounts.Set(ctx, collections.Join(account.Channel, account.Address)); err != nil {
+		return err
+	}
+
+	if err := k.RecipientAccounts.Set(ctx, collections.Join(account.Recipient, account.Address)); err != nil {
+		return err
+	}
+
+	if account.Fallback == "" {
+		return nil
+	}
+
+	return k.FallbackAccounts.Set(ctx, collections.Join(account.Fallback, account.Address))
+}
+
+// IndexAllAccounts walks every account stored in x/auth and indexes those that
+// are forwarding accounts.
+func (k *Keeper) IndexAllAccounts(ctx context.Context) (err error) {
+	k.accountKeeper.IterateAccounts(ctx, func(rawAccount sdk.AccountI) (stop bool) {
+		account, ok := rawAccount.(*types.ForwardingAccount)
+		if !ok {
+			return false
+		}
+
+		err = k.IndexAccount(ctx, account)
+		return err != nil
+	})
+
+	return
+}
+
+// HasForwardingPubKey returns whether an account has a forwarding account
+// public key, which is set when signing a signerless transaction.
+func (k *Keeper) HasForwardingPubKey(ctx context.Context, address sdk.AccAddress) bool {
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		return false
+	}
+
+	_, ok := account.GetPubKey().(*types.ForwardingPubKey)
+	return ok
+}
+
+// GetForwardingAccount returns the forwarding account stored in x/auth under
+// the given address.
+func (k *Keeper) GetForwardingAccount(ctx context.Context, address string) (*types.ForwardingAccount, error) {
+	bz, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return nil, errors.New("invalid account address")
+	}
+
+	rawAccount := k.accountKeeper.GetAccount(ctx, bz)
+	if rawAccount == nil {
+		return nil, errors.New("account does not exist")
+	}
+	account, ok := rawAccount.(*types.ForwardingAccount)
+	if !ok {
+		return nil, errors.New("account is not a forwarding account")
+	}
+
+	return account, nil
 }
 
 // TRANSIENT STATE
@@ -114,10 +474,22 @@ func (k *Keeper) GetPendingForwards(ctx context.Context) (accounts []types.Forwa
 	return
 }
 
-func (k *Keeper) SetPendingForward(ctx context.Context, account *types.ForwardingAccount) {
+// SetPendingForward queues a forwarding account to be forwarded at the end of
+// the block, emitting an event that describes what triggered it. Accounts that
+// are already queued are left as is, so that every queued account is reported
+// once, by whatever queued it first.
+func (k *Keeper) SetPendingForward(ctx context.Context, account *types.ForwardingAccount, trigger types.QueueTrigger, depositor string) {
 	if found, err := k.PendingForwards.Has(ctx, account.Address); err != nil || found {
 		return
 	}
 
 	_ = k.PendingForwards.Set(ctx, account.Address, *account)
+
+	_ = k.eventService.EventManager(ctx).Emit(ctx, &types.AccountQueued{
+		Address:   account.Address,
+		Channel:   account.Channel,
+		Trigger:   trigger,
+		Depositor: depositor,
+	})
+	incrQueuedCounter(trigger)
 }
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+// incrForwardCounter records the outcome of an automatic forward.
+func incrForwardCounter(outcome string, channel string, denom string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyForwards},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.MetricLabelOutcome, outcome),
+			telemetry.NewLabel(types.MetricLabelChannel, channel),
+			telemetry.NewLabel(types.MetricLabelDenom, denom),
+		},
+	)
+}
+
+// incrMemoLookupErrorCounter records a failure to fetch the memo of a forward.
+func incrMemoLookupErrorCounter(channel string, denom string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyMemoLookupErrors},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.MetricLabelChannel, channel),
+			telemetry.NewLabel(types.MetricLabelDenom, denom),
+		},
+	)
+}
+
+// incrQueuedCounter records a forwarding account being queued.
+func incrQueuedCounter(trigger types.QueueTrigger) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyQueued},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelTrigger, trigger.String())},
+	)
+}
+
+// incrRegistrationCounter records a successful registration of a forwarding account.
+func incrRegistrationCounter(path string, channel string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyRegistrations},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.MetricLabelPath, path),
+			telemetry.NewLabel(types.MetricLabelChannel, channel),
+		},
+	)
+}
+
+// RegistrationPath returns the path through which a registration was
+// submitted, based on its signer.
+func RegistrationPath(msg *types.MsgRegisterAccount, address sdk.AccAddress) string {
+	switch msg.Signer {
+	case "":
+		return types.RegistrationPathPacket
+	case authtypes.NewModuleAddress(types.ModuleName).String():
+		return types.RegistrationPathMemo
+	case address.String():
+		return types.RegistrationPathSignerless
+	default:
+		return types.RegistrationPathMessage
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+// setupInmemMetrics enables telemetry and routes every metric into an
+// in-memory sink for the duration of the test.
+func setupInmemMetrics(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	telemetry.EnableTelemetry()
+
+	t.Cleanup(func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	})
+
+	return sink
+}
+
+func TestTelemetryMetrics(t *testing.T) {
+	sink := setupInmemMetrics(t)
+
+	app, sdkCtx := setupForwardingKeeper(t)
+	ensureActiveChannel(t, app, sdkCtx, "channel-0")
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(sdkCtx, "uusdc"))
+	require.NoError(t, app.BankKeeper.SetParams(sdkCtx, banktypes.DefaultParams()))
+	app.TransferKeeper.SetParams(sdkCtx, transfertypes.NewParams(true, true))
+
+	// ACT: Queue and forward an account.
+	first := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipientone", nil)
+	fundAccount(t, app, sdkCtx, first, sdk.NewInt64Coin("uusdc", 100))
+	app.ForwardingKeeper.ExecuteForwards(sdkCtx)
+	// NOTE: Pending forwards are transient, so they are cleared between blocks.
+	require.NoError(t, app.ForwardingKeeper.PendingForwards.Clear(sdkCtx, nil))
+
+	// ACT: Queue an account whose forward fails, as transfers are disabled.
+	second := registerAccountWithMemos(t, app, sdkCtx, "channel-0", "iaa1recipienttwo", nil)
+	fundAccount(t, app, sdkCtx, second, sdk.NewInt64Coin("uusdc", 100))
+	app.TransferKeeper.SetParams(sdkCtx, transfertypes.NewParams(false, true))
+	app.ForwardingKeeper.ExecuteForwards(sdkCtx)
+
+	// ASSERT: Every counter, gauge and sample was emitted. The sink starts a
+	// new interval every hour, so the data is aggregated over all of them.
+	counters, samples := make(map[string]int), make(map[string]int)
+	gauges := make(map[string]float32)
+	for _, interval := range sink.Data() {
+		for key, counter := range interval.Counters {
+			counters[key] += counter.Count
+		}
+		for key, sample := range interval.Samples {
+			samples[key] += sample.Count
+		}
+		for key, gauge := range interval.Gauges {
+			gauges[key] = gauge.Value
+		}
+	}
+
+	require.Equal(t, 2, counters["forwarding.queued;trigger="+types.QUEUE_TRIGGER_BANK_SEND.String()])
+	require.Equal(t, 1, counters["forwarding.forwards;outcome="+types.ForwardOutcomeExecuted+";channel=channel-0;denom=uusdc"])
+	require.Equal(t, 1, counters["forwarding.forwards;outcome="+types.ForwardOutcomeFailed+";channel=channel-0;denom=uusdc"])
+	require.Equal(t, float32(1), gauges["pending_forwards;module=forwarding"])
+	require.Equal(t, 2, samples["execute_forwards;module=forwarding"])
+}
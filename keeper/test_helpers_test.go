@@ -30,7 +30,12 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/stretchr/testify/require"
 
 	"github.com/noble-assets/forwarding/simapp"
@@ -45,6 +50,9 @@ const (
 	bech32PrefixConsPub  = bech32PrefixAccAddr + "valconspub"
 )
 
+// authority is the forwarding authority configured in the simapp app.yaml.
+const authority = "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za"
+
 var configureSDKOnce sync.Once
 
 func configureSDK() {
@@ -88,4 +96,55 @@ func ensureOpenChannel(t *testing.T, app *simapp.SimApp, sdkCtx sdk.Context, cha
 
 type emptyAppOptions struct{}
 
+// ensureOpenChannelToChain sets an open transfer channel, whose connection is
+// backed by a Tendermint light client tracking the given chain.
+func ensureOpenChannelToChain(t *testing.T, app *simapp.SimApp, sdkCtx sdk.Context, channelID, chainID string) {
+	t.Helper()
+
+	ensureOpenChannelWithClient(t, app, sdkCtx, channelID, "07-tendermint-"+chainID, &tendermint.ClientState{ChainId: chainID})
+}
+
+// ensureOpenChannelWithClient sets an open transfer channel, whose connection
+// is backed by the given light client.
+func ensureOpenChannelWithClient(t *testing.T, app *simapp.SimApp, sdkCtx sdk.Context, channelID, clientID string, clientState exported.ClientState) {
+	t.Helper()
+
+	connectionID := "connection-" + clientID
+
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, "counter-0"),
+		[]string{connectionID},
+		transfertypes.V1,
+	)
+	app.IBCKeeper.ChannelKeeper.SetChannel(sdkCtx, transfertypes.PortID, channelID, channel)
+	app.IBCKeeper.ConnectionKeeper.SetConnection(sdkCtx, connectionID, connectiontypes.ConnectionEnd{
+		ClientId: clientID,
+		State:    connectiontypes.OPEN,
+	})
+	app.IBCKeeper.ClientKeeper.SetClientState(sdkCtx, clientID, clientState)
+}
+
+// ensureActiveChannel sets an open transfer channel, whose connection is
+// backed by an active Tendermint light client, so that packets can be sent.
+func ensureActiveChannel(t *testing.T, app *simapp.SimApp, sdkCtx sdk.Context, channelID string) {
+	t.Helper()
+
+	clientID := "07-tendermint-0"
+	height := clienttypes.NewHeight(0, 1)
+	clientState := tendermint.NewClientState(
+		"counterparty-1", tendermint.DefaultTrustLevel,
+		time.Hour, 2*time.Hour, time.Minute,
+		height, commitmenttypes.GetSDKSpecs(), nil,
+	)
+
+	app.IBCKeeper.ClientKeeper.SetParams(sdkCtx, clienttypes.DefaultParams())
+	ensureOpenChannelWithClient(t, app, sdkCtx, channelID, clientID, clientState)
+	app.IBCKeeper.ClientKeeper.SetClientConsensusState(sdkCtx, clientID, height, tendermint.NewConsensusState(
+		sdkCtx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), []byte("hash"),
+	))
+	app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(sdkCtx, transfertypes.PortID, channelID, 1)
+}
+
 func (emptyAppOptions) Get(string) interface{} { return nil }
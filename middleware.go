@@ -21,12 +21,16 @@
 package forwarding
 
 import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/hashicorp/go-metrics"
 	"github.com/noble-assets/forwarding/v2/keeper"
 	"github.com/noble-assets/forwarding/v2/types"
 )
@@ -76,10 +80,13 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet
 	//
 	// When receiving a "FungibleTokenPacketData" packet, we first check the
 	// memo field. If the memo field contains registration data, we first
-	// register a new forwarding account before continuing. We additionally
+	// register a new forwarding account before continuing. If registration
+	// fails, the transfer is either rejected or, in soft-fail mode, continues
+	// without the registration. We additionally
 	// need to check if the recipient of the token transfer is a forwarding
 	// account, as we then mark it for forwarding at the end of the block
-	// lifecycle.
+	// lifecycle, forward it immediately if configured, or forward it
+	// atomically if the sender opted in.
 	//
 	// When receiving a "RegisterAccountData" packet, we simply register a new
 	// forwarding account.
@@ -94,16 +101,38 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet
 					channel = memo.Noble.Forwarding.Channel
 				}
 
+				// NOTE: Senders that derived the receiver using v1 continue to
+				// register v1 forwarding accounts.
+				version := types.DefaultAddressVersion
+				if transferData.Receiver == types.GenerateAddressWithVersion(types.ADDRESS_VERSION_V1, channel, memo.Noble.Forwarding.Recipient, memo.Noble.Forwarding.Fallback).String() {
+					version = types.ADDRESS_VERSION_V1
+				}
+
 				req := &types.MsgRegisterAccount{
-					Signer:    authtypes.NewModuleAddress(types.ModuleName).String(),
-					Recipient: memo.Noble.Forwarding.Recipient,
-					Channel:   channel,
-					Fallback:  memo.Noble.Forwarding.Fallback,
+					Signer:         authtypes.NewModuleAddress(types.ModuleName).String(),
+					Recipient:      memo.Noble.Forwarding.Recipient,
+					Channel:        channel,
+					Fallback:       memo.Noble.Forwarding.Fallback,
+					AddressVersion: version,
+					Memos:          memo.Noble.Forwarding.Memos,
 				}
 
-				_, err := m.keeper.RegisterAccount(ctx, req)
+				// NOTE: We register in a cached context, so that a failed
+				// registration doesn't leave partial state behind when the
+				// transfer proceeds in soft-fail mode.
+				cachedCtx, writeCache := ctx.CacheContext()
+				_, err := m.keeper.RegisterAccount(cachedCtx, req)
 				if err != nil {
-					return channeltypes.NewErrorAcknowledgement(err)
+					incrRegistrationFailureCounter(types.RegistrationPathMemo, channel)
+					if m.keeper.GetRegistrationMode(ctx) != types.REGISTRATION_MODE_SOFT_FAIL {
+						return channeltypes.NewErrorAcknowledgement(err)
+					}
+
+					// NOTE: Failures are counted by the channel the transfer
+					// was received on, as the requested channel is untrusted.
+					m.keeper.RecordRegistrationFailure(ctx, packet.DestinationChannel, transferData.Receiver, req, err)
+				} else {
+					writeCache()
 				}
 			}
 		}
@@ -119,8 +148,14 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet
 		}
 
 		account, ok := rawAccount.(*types.ForwardingAccount)
+		if ok && memo.Noble != nil && memo.Noble.AtomicForward {
+			return m.onRecvAtomicForward(ctx, channelVersion, packet, relayer, transferData, account)
+		}
+		if ok && m.keeper.GetForwardMode(ctx) == types.FORWARD_MODE_IMMEDIATE {
+			return m.onRecvImmediateForward(ctx, channelVersion, packet, relayer, account)
+		}
 		if ok {
-			m.keeper.SetPendingForward(ctx, account)
+			m.keeper.SetPendingForward(ctx, account, types.QUEUE_TRIGGER_IBC_RECEIVE, transferData.Sender)
 		}
 
 		return m.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
@@ -140,20 +175,93 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet
 		Recipient: data.Recipient,
 		Channel:   channel,
 		Fallback:  data.Fallback,
+		Memos:     data.Memos,
 	}
 
+	// NOTE: Registering an existing forwarding account is acknowledged
+	// successfully, so that counterparty contracts can treat registration as
+	// idempotent. Otherwise, errors are acknowledged with their typed code.
 	res, err := m.keeper.RegisterAccount(ctx, req)
-	if err != nil {
+	switch {
+	case errors.Is(err, types.ErrAccountAlreadyRegistered):
+		address := types.GenerateAddressWithVersion(types.ResolveAddressVersion(req.AddressVersion), req.Channel, req.Recipient, req.Fallback)
+		return types.NewRegisterAccountAcknowledgement(address.String(), false, req.Channel, req.Fallback)
+	case err != nil:
+		incrRegistrationFailureCounter(types.RegistrationPathPacket, channel)
 		return channeltypes.NewErrorAcknowledgement(err)
-	} else {
-		return channeltypes.NewResultAcknowledgement([]byte(res.Address))
+	default:
+		return types.NewRegisterAccountAcknowledgement(res.Address, true, req.Channel, req.Fallback)
+	}
+}
+
+// onRecvAtomicForward handles an incoming transfer into a forwarding account
+// that opted into atomic forwarding. The received amount is forwarded
+// immediately, and the acknowledgement is written asynchronously once the
+// forward is acknowledged. If the forward can't be sent, the transfer is
+// rejected, reverting its receipt.
+func (m Middleware) onRecvAtomicForward(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress, transferData transfertypes.FungibleTokenPacketData, account *types.ForwardingAccount) exported.Acknowledgement {
+	ack := m.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if err := m.keeper.ForwardAtomically(ctx, account, packet, transferData); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return nil
+}
+
+// onRecvImmediateForward handles an incoming transfer into a forwarding
+// account when the forward mode is immediate. The balance of the account is
+// forwarded in the same transaction, once the transfer has been received.
+// Failed forwards are reported as in the end block, and don't affect the
+// acknowledgement of the transfer.
+func (m Middleware) onRecvImmediateForward(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress, account *types.ForwardingAccount) exported.Acknowledgement {
+	ack := m.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
 	}
+
+	m.keeper.ExecuteForward(ctx, account)
+
+	return ack
 }
 
+// OnAcknowledgementPacket implements the porttypes.IBCModule interface.
 func (m Middleware) OnAcknowledgementPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
-	return m.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
+	if err := m.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	// NOTE: The underlying application has already validated the
+	// acknowledgement, and refunded the forwarding account if it failed.
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil
+	}
+
+	return m.keeper.OnForwardAcknowledged(ctx, packet, ack)
 }
 
+// OnTimeoutPacket implements the porttypes.IBCModule interface.
 func (m Middleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
-	return m.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
+	if err := m.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	return m.keeper.OnForwardTimedOut(ctx, packet)
+}
+
+// incrRegistrationFailureCounter records a failed registration of a
+// forwarding account received over IBC.
+func incrRegistrationFailureCounter(path string, channel string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyRegistrationFailures},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.MetricLabelPath, path),
+			telemetry.NewLabel(types.MetricLabelChannel, channel),
+		},
+	)
 }
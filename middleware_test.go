@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarding_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/simapp"
+	"github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestMiddlewareForwardsAtomically(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	middleware := setupMiddleware(t, app, ctx)
+
+	denom := transfertypes.ExtractDenomFromPath("transfer/channel-0/uatom").IBCDenom()
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, denom))
+
+	res, err := app.ForwardingKeeper.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Recipient: "cosmos1recipient",
+		Channel:   "channel-1",
+	})
+	require.NoError(t, err)
+	address := sdk.MustAccAddressFromBech32(res.Address)
+
+	// ACT: Receive a transfer that opts into atomic forwarding.
+	memo := `{"noble":{"atomic_forward":true}}`
+	ack := middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 1, "uatom", res.Address, memo), nil)
+	require.Nil(t, ack)
+
+	inFlight, err := app.ForwardingKeeper.InFlightPackets.Get(ctx, collections.Join("channel-1", uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, res.Address, inFlight.Address)
+	require.Equal(t, "channel-0", inFlight.DestinationChannel)
+	require.Equal(t, uint64(1), inFlight.Sequence)
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), inFlight.Amount)
+	require.False(t, inFlight.Unescrowed)
+
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+	require.True(t, app.BankKeeper.GetBalance(ctx, address, denom).IsZero())
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, escrow, denom))
+
+	// ACT: Receive a transfer of a denom that isn't allowed.
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 2, "uosmo", res.Address, memo), nil)
+	require.NotNil(t, ack)
+	require.False(t, ack.Success())
+}
+
+func TestMiddlewareForwardsImmediately(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	middleware := setupMiddleware(t, app, ctx)
+	require.NoError(t, app.ForwardingKeeper.ForwardMode.Set(ctx, int32(types.FORWARD_MODE_IMMEDIATE)))
+
+	denom := transfertypes.ExtractDenomFromPath("transfer/channel-0/uatom").IBCDenom()
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, denom))
+
+	res, err := app.ForwardingKeeper.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Recipient: "cosmos1recipient",
+		Channel:   "channel-1",
+	})
+	require.NoError(t, err)
+	address := sdk.MustAccAddressFromBech32(res.Address)
+
+	// ACT: Receive a transfer into the forwarding account.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack := middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 1, "uatom", res.Address, ""), nil)
+
+	// The acknowledgement is the one written by the transfer module.
+	require.True(t, ack.Success())
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack.Acknowledgement())
+
+	// The forward is sent in the same transaction.
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+	require.True(t, app.BankKeeper.GetBalance(ctx, address, denom).IsZero())
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, escrow, denom))
+	sequence, found := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, transfertypes.PortID, "channel-1")
+	require.True(t, found)
+	require.Equal(t, uint64(2), sequence)
+
+	executed := findEvent[*types.ForwardExecuted](t, ctx)
+	require.Equal(t, res.Address, executed.Address)
+	require.Equal(t, uint64(1), executed.Sequence)
+
+	// The account isn't left queued for EndBlock.
+	found, err = app.ForwardingKeeper.PendingForwards.Has(ctx, res.Address)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestMiddlewareAcknowledgesRegistration(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	middleware := setupMiddleware(t, app, ctx)
+
+	data := types.ModuleCdc.MustMarshalJSON(&types.RegisterAccountData{Recipient: "cosmos1recipient"})
+	packet := channeltypes.NewPacket(data, 1, transfertypes.PortID, "counter-0", transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0)
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-0", "cosmos1recipient", "")
+
+	for _, created := range []bool{true, false} {
+		// ACT: Register the account, and then register it again.
+		ack := middleware.OnRecvPacket(ctx, transfertypes.V1, packet, nil)
+		require.True(t, ack.Success())
+
+		result, ok := ack.(channeltypes.Acknowledgement)
+		require.True(t, ok)
+
+		var res types.RegisterAccountAcknowledgement
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(result.GetResult(), &res))
+		require.Equal(t, uint32(types.RegisterAccountAcknowledgementVersion), res.Version)
+		require.Equal(t, address.String(), res.Address)
+		require.Equal(t, created, res.Created)
+		require.Equal(t, "channel-0", res.Channel)
+		require.Empty(t, res.Fallback)
+	}
+}
+
+func TestMiddlewareRegistersMemos(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	middleware := setupMiddleware(t, app, ctx)
+	require.NoError(t, app.ForwardingKeeper.ForwardMode.Set(ctx, int32(types.FORWARD_MODE_IMMEDIATE)))
+
+	denom := transfertypes.ExtractDenomFromPath("transfer/channel-0/uatom").IBCDenom()
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, denom))
+	memos := []types.MemoEntry{
+		{Denom: denom, Memo: "atom memo"},
+		{Denom: "uusdc", Memo: "usdc memo"},
+	}
+
+	// ACT: Register an account with memos through a transfer memo.
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-1", "cosmos1recipient", "").String()
+	memo := types.ModuleCdc.MustMarshalJSON(&types.RegisterAccountMemo{
+		Noble: &types.RegisterAccountMemo_RegisterAccountDataWrapper{
+			Forwarding: &types.RegisterAccountData{Recipient: "cosmos1recipient", Channel: "channel-1", Memos: memos},
+		},
+	})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack := middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 1, "uatom", address, string(memo)), nil)
+	require.True(t, ack.Success())
+
+	// The memos are stored per denom, and the forward uses the memo of its denom.
+	res, err := app.ForwardingKeeper.GetMemos(ctx, &types.QueryMemos{Address: address})
+	require.NoError(t, err)
+	require.ElementsMatch(t, memos, res.Memos)
+
+	executed := findEvent[*types.ForwardExecuted](t, ctx)
+	require.Equal(t, address, executed.Address)
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), executed.Amount)
+	require.Equal(t, "atom memo", executed.Memo)
+
+	// ACT: Register an account with memos through a registration packet.
+	data := types.ModuleCdc.MustMarshalJSON(&types.RegisterAccountData{Recipient: "cosmos1other", Channel: "channel-1", Memos: memos[1:]})
+	packet := channeltypes.NewPacket(data, 2, transfertypes.PortID, "counter-0", transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0)
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, packet, nil)
+	require.True(t, ack.Success())
+
+	other := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-1", "cosmos1other", "").String()
+	stored, err := app.ForwardingKeeper.GetMemo(ctx, &types.QueryMemo{Address: other, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, "usdc memo", stored.Memo)
+}
+
+func TestMiddlewareRegistrationModes(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	middleware := setupMiddleware(t, app, ctx)
+
+	denom := transfertypes.ExtractDenomFromPath("transfer/channel-0/uatom").IBCDenom()
+	address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, "channel-9", "cosmos1recipient", "")
+	memo := types.ModuleCdc.MustMarshalJSON(&types.RegisterAccountMemo{
+		Noble: &types.RegisterAccountMemo_RegisterAccountDataWrapper{
+			Forwarding: &types.RegisterAccountData{
+				Recipient: "cosmos1recipient",
+				Channel:   "channel-9",
+				Memos:     []types.MemoEntry{{Denom: denom, Memo: "memo"}},
+			},
+		},
+	})
+
+	// ACT: Register an account over a channel that doesn't exist, in strict mode.
+	ack := middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 1, "uatom", address.String(), string(memo)), nil)
+	require.False(t, ack.Success())
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, address))
+	require.True(t, app.BankKeeper.GetBalance(ctx, address, denom).IsZero())
+
+	// ACT: Register the same account in soft-fail mode.
+	require.NoError(t, app.ForwardingKeeper.RegistrationMode.Set(ctx, int32(types.REGISTRATION_MODE_SOFT_FAIL)))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 2, "uatom", address.String(), string(memo)), nil)
+	require.True(t, ack.Success())
+
+	// The funds are received, while the failed registration leaves no state behind.
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, address, denom))
+	_, ok := app.AccountKeeper.GetAccount(ctx, address).(*types.ForwardingAccount)
+	require.False(t, ok)
+	found, err := app.ForwardingKeeper.Memos.Has(ctx, collections.Join(address.String(), denom))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.Equal(t, map[string]uint64{"channel-0": 1}, app.ForwardingKeeper.GetAllNumOfRegistrationFailures(ctx))
+	failed := findEvent[*types.RegistrationFailed](t, ctx)
+	require.Equal(t, address.String(), failed.Address)
+	require.Equal(t, "channel-0", failed.Channel)
+	require.Contains(t, failed.Error, "channel-9")
+}
+
+func TestMiddlewareQueuesDepositOnce(t *testing.T) {
+	app, ctx := setupSimApp(t)
+	middleware := setupMiddleware(t, app, ctx)
+
+	denom := transfertypes.ExtractDenomFromPath("transfer/channel-0/uatom").IBCDenom()
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, denom))
+
+	res, err := app.ForwardingKeeper.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Recipient: "cosmos1recipient",
+		Channel:   "channel-1",
+	})
+	require.NoError(t, err)
+
+	// ACT: Receive a deposit, which is also credited through a bank send.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack := middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 1, "uatom", res.Address, ""), nil)
+	require.True(t, ack.Success())
+
+	queued := findEvents[*types.AccountQueued](ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, res.Address, queued[0].Address)
+	require.Equal(t, types.QUEUE_TRIGGER_IBC_RECEIVE, queued[0].Trigger)
+	require.Equal(t, "cosmos1sender", queued[0].Depositor)
+
+	found, err := app.ForwardingKeeper.PendingForwards.Has(ctx, res.Address)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// ACT: Receive another deposit while the account is still queued.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, newTransferPacket(ctx, 2, "uatom", res.Address, ""), nil)
+	require.True(t, ack.Success())
+	require.Empty(t, findEvents[*types.AccountQueued](ctx))
+}
+
+// setupMiddleware enables transfers, opens channel-1 with an active light
+// client, so that forwards can be sent through it, and returns the forwarding
+// middleware wrapping the transfer module.
+func setupMiddleware(t testing.TB, app *simapp.SimApp, ctx sdk.Context) forwarding.Middleware {
+	t.Helper()
+
+	require.NoError(t, app.BankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+	app.TransferKeeper.SetParams(ctx, transfertypes.NewParams(true, true))
+	app.IBCKeeper.ClientKeeper.SetParams(ctx, clienttypes.DefaultParams())
+
+	clientID := "07-tendermint-0"
+	height := clienttypes.NewHeight(0, 1)
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, tendermint.NewClientState(
+		"counterparty-1", tendermint.DefaultTrustLevel,
+		time.Hour, 2*time.Hour, time.Minute,
+		height, commitmenttypes.GetSDKSpecs(), nil,
+	))
+	app.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, height, tendermint.NewConsensusState(
+		ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), []byte("hash"),
+	))
+	app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, "connection-1", connectiontypes.ConnectionEnd{
+		ClientId: clientID,
+		State:    connectiontypes.OPEN,
+	})
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, "channel-1", channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, "counter-1"),
+		[]string{"connection-1"},
+		transfertypes.V1,
+	))
+	app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, transfertypes.PortID, "channel-1", 1)
+
+	return forwarding.NewMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.AccountKeeper, app.ForwardingKeeper)
+}
+
+// newTransferPacket returns a transfer of 100 of the counterparty's denom,
+// received on channel-0.
+func newTransferPacket(ctx sdk.Context, sequence uint64, denom string, receiver string, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "100", "cosmos1sender", receiver, memo)
+	timeout := uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+
+	return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, "counter-0", transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), timeout)
+}
+
+// findEvent returns the first typed event of the given type.
+func findEvent[T any](t testing.TB, ctx sdk.Context) T {
+	t.Helper()
+
+	events := findEvents[T](ctx)
+	if len(events) == 0 {
+		var empty T
+		t.Fatalf("event %T not found", empty)
+		return empty
+	}
+
+	return events[0]
+}
+
+// findEvents returns every typed event of the given type.
+func findEvents[T any](ctx sdk.Context) (events []T) {
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if typed, ok := msg.(T); ok {
+			events = append(events, typed)
+		}
+	}
+
+	return events
+}
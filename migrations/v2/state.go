@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package v2
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+// GetAllTotalForwarded implements adapted legacy store logic from version 2,
+// where the total forwarded of a channel was stored as a coins string.
+func GetAllTotalForwarded(adapter storetypes.KVStore) map[string]string {
+	totals := make(map[string]string)
+
+	store := prefix.NewStore(adapter, types.TotalForwardedPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		totals[string(iterator.Key())] = string(iterator.Value())
+	}
+
+	return totals
+}
+
+// DeleteAllTotalForwarded removes all legacy total forwarded entries from
+// version 2.
+func DeleteAllTotalForwarded(adapter storetypes.KVStore, totals map[string]string) {
+	store := prefix.NewStore(adapter, types.TotalForwardedPrefix)
+
+	for channel := range totals {
+		store.Delete([]byte(channel))
+	}
+}
@@ -38,6 +38,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -45,12 +46,13 @@ import (
 	forwardingv1 "github.com/noble-assets/forwarding/v2/api/v1"
 	"github.com/noble-assets/forwarding/v2/client/cli"
 	"github.com/noble-assets/forwarding/v2/keeper"
+	"github.com/noble-assets/forwarding/v2/simulation"
 	"github.com/noble-assets/forwarding/v2/types"
 	"github.com/spf13/cobra"
 )
 
 // ConsensusVersion defines the current Forwarding module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -59,6 +61,8 @@ var (
 	_ appmodule.HasEndBlocker    = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasInvariants       = AppModule{}
 )
 
 //
@@ -103,13 +107,17 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, cfg client.TxEncoding
 type AppModule struct {
 	AppModuleBasic
 
-	keeper *keeper.Keeper
+	keeper        *keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
-func NewAppModule(keeper *keeper.Keeper) AppModule {
+func NewAppModule(keeper *keeper.Keeper, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(),
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 	}
 }
 
@@ -144,6 +152,31 @@ func (m AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate Forwarding from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate Forwarding from version 2 to 3: %v", err))
+	}
+}
+
+func (m AppModule) RegisterInvariants(ir sdk.InvariantRegistry) { //nolint:staticcheck // required by the deprecated x/crisis interface
+	keeper.RegisterInvariants(ir, m.keeper)
+}
+
+//
+
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+func (AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
+}
+
+func (m AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(m.keeper.Schema)
+}
+
+func (m AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, m.accountKeeper, m.bankKeeper, m.keeper)
 }
 
 //
@@ -163,6 +196,12 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "recipient"},
 						{ProtoField: "fallback", Optional: true},
 					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"chain_id": {
+							Name:  "counterparty-chain-id",
+							Usage: "resolve the channel from the registered channel of a counterparty chain, in which case the channel argument can be empty",
+						},
+					},
 				},
 				{
 					RpcMethod: "ClearAccount",
@@ -184,6 +223,51 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						Varargs:    true,
 					}},
 				},
+				{
+					RpcMethod:      "SetHistoryRetention",
+					Use:            "set-history-retention [retention]",
+					Short:          "Set the number of forward history records kept per account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "retention"}},
+				},
+				{
+					RpcMethod:      "SetStatsRetention",
+					Use:            "set-stats-retention [retention]",
+					Short:          "Set the number of days of time-bucketed forwarding stats kept",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "retention"}},
+				},
+				{
+					RpcMethod: "SetChainChannel",
+					Use:       "set-chain-channel [chain-id] (channel)",
+					Short:     "Set the canonical transfer channel of a counterparty chain, or remove it if no channel is given",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "chain_id"},
+						{ProtoField: "channel", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "SetRegistrationMode",
+					Use:            "set-registration-mode [strict|soft-fail]",
+					Short:          "Set how failed registrations through transfer memos are handled",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "mode"}},
+				},
+				{
+					RpcMethod:      "SetForwardMode",
+					Use:            "set-forward-mode [end-block|immediate]",
+					Short:          "Set when funds received by forwarding accounts over IBC are forwarded",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "mode"}},
+				},
+				{
+					RpcMethod:      "SetSignerlessFee",
+					Use:            "set-signerless-fee [fee]",
+					Short:          "Set the fee deducted from forwarding accounts registering signerlessly",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fee"}},
+				},
+				{
+					RpcMethod:      "SetRegistrationFee",
+					Use:            "set-registration-fee [fee]",
+					Short:          "Set the fee charged for newly registered forwarding accounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fee"}},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -205,6 +289,45 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "recipient"},
 						{ProtoField: "fallback", Optional: true},
 					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"chain_id": {
+							Name:  "counterparty-chain-id",
+							Usage: "resolve the channel from the registered channel of a counterparty chain, in which case the channel argument can be empty",
+						},
+					},
+				},
+				{
+					RpcMethod:      "Account",
+					Use:            "account [address]",
+					Short:          "Query details of a forwarding account",
+					Long:           "Query details of a forwarding account, including its allowed denom balances, memos, and counterparty chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "StatsByAccount",
+					Use:            "stats-by-account [address]",
+					Short:          "Query forwarding stats of a forwarding account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "StatsHistory",
+					Use:            "stats-history [start-day] [end-day]",
+					Short:          "Query daily forwarding stats between two days since the Unix epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start_day"}, {ProtoField: "end_day"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"channel": {Usage: "only include stats of the given channel"},
+					},
+				},
+				{
+					RpcMethod:      "StatsByChain",
+					Use:            "stats-by-chain [chain-id]",
+					Short:          "Query forwarding stats aggregated across all channels to a counterparty chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "chain_id"}},
+				},
+				{
+					RpcMethod: "Chains",
+					Use:       "chains",
+					Short:     "Query the canonical transfer channels of counterparty chains",
 				},
 				// NOTE: We combine the Stats and StatsByChannel methods together in a custom command.
 				{
@@ -215,6 +338,40 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "StatsByChannel",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "Accounts",
+					Use:       "accounts",
+					Short:     "Query all registered forwarding accounts",
+				},
+				{
+					RpcMethod:      "AccountsByChannel",
+					Use:            "accounts-by-channel [channel]",
+					Short:          "Query registered forwarding accounts by channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod:      "AccountsByRecipient",
+					Use:            "accounts-by-recipient [recipient]",
+					Short:          "Query forwarding accounts that forward to a recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "AccountsByFallback",
+					Use:            "accounts-by-fallback [fallback]",
+					Short:          "Query forwarding accounts that use a fallback address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fallback"}},
+				},
+				{
+					RpcMethod:      "ForwardHistory",
+					Use:            "forward-history [address]",
+					Short:          "Query the forward history of a forwarding account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Audit",
+					Use:       "audit",
+					Short:     "Check the module state against all invariants and report any discrepancies",
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -279,7 +436,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		nil,
 		nil,
 	)
-	m := NewAppModule(k)
+	m := NewAppModule(k, in.AccountKeeper, in.BankKeeper)
 
 	return ModuleOutputs{Keeper: k, Module: m, Restriction: k.SendRestrictionFn}
 }
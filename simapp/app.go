@@ -21,7 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	_ "cosmossdk.io/x/upgrade"
-	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	_ "github.com/cosmos/cosmos-sdk/x/bank"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
@@ -32,6 +32,7 @@ import (
 	// Cosmos Modules
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -78,6 +79,8 @@ type SimApp struct {
 	TransferKeeper transferkeeper.Keeper
 	// Custom Modules
 	ForwardingKeeper *forwardingkeeper.Keeper
+
+	sm *module.SimulationManager
 }
 
 func init() {
@@ -162,6 +165,13 @@ func NewSimApp(
 	}
 	app.SetAnteHandler(anteHandler)
 
+	// NOTE: We override the auth module, as the default random genesis accounts
+	// include vesting accounts, and the vesting module isn't wired in this app.
+	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, randomGenesisAccounts, nil),
+	})
+	app.sm.RegisterStoreDecoders()
+
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
 	}
@@ -173,12 +183,30 @@ func NewSimApp(
 	return app, nil
 }
 
+// randomGenesisAccounts returns a base account for every simulation account.
+func randomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	accounts := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, account := range simState.Accounts {
+		accounts[i] = authtypes.NewBaseAccountWithAddress(account.Address)
+	}
+
+	return accounts
+}
+
 func (app *SimApp) LegacyAmino() *codec.LegacyAmino {
 	return app.legacyAmino
 }
 
+func (app *SimApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
+func (app *SimApp) TxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 func (app *SimApp) SimulationManager() *module.SimulationManager {
-	return nil
+	return app.sm
 }
 
 //
@@ -0,0 +1,87 @@
+//go:build sims
+
+package simapp
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/simsx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v10/modules/core/types"
+
+	"github.com/noble-assets/forwarding/v2/simulation"
+)
+
+func init() {
+	simcli.GetSimulatorFlags()
+
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount("noble", "noblepub")
+	cfg.SetBech32PrefixForValidator("noblevaloper", "noblevaloperpub")
+	cfg.SetBech32PrefixForConsensusNode("noblevalcons", "noblevalconspub")
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	simsx.Run(t, newSimApp, setupStateFactory)
+}
+
+func newSimApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	loadLatest bool,
+	appOpts servertypes.AppOptions,
+	baseAppOptions ...func(*baseapp.BaseApp),
+) *SimApp {
+	app, err := NewSimApp(logger, db, traceStore, loadLatest, appOpts, baseAppOptions...)
+	if err != nil {
+		panic(err)
+	}
+
+	return app
+}
+
+func setupStateFactory(app *SimApp) simsx.SimStateFactory {
+	return simsx.SimStateFactory{
+		Codec:         app.AppCodec(),
+		AppStateFn:    simtestutil.AppStateFnWithExtendedCb(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis(), openSimulationChannels(app.AppCodec())),
+		BlockedAddr:   app.BankKeeper.GetBlockedAddresses(),
+		AccountSource: app.AccountKeeper,
+		BalanceSource: app.BankKeeper,
+	}
+}
+
+// openSimulationChannels adds the channels used by the forwarding simulation
+// to the IBC genesis state, so that forwarding accounts can be registered.
+func openSimulationChannels(cdc codec.JSONCodec) func(rawState map[string]json.RawMessage) {
+	return func(rawState map[string]json.RawMessage) {
+		var genesis ibctypes.GenesisState
+		cdc.MustUnmarshalJSON(rawState[ibcexported.ModuleName], &genesis)
+
+		for _, channelID := range simulation.Channels {
+			channel := channeltypes.NewIdentifiedChannel(transfertypes.PortID, channelID, channeltypes.NewChannel(
+				channeltypes.OPEN,
+				channeltypes.UNORDERED,
+				channeltypes.NewCounterparty(transfertypes.PortID, channelID),
+				[]string{"connection-0"},
+				transfertypes.V1,
+			))
+			genesis.ChannelGenesis.Channels = append(genesis.ChannelGenesis.Channels, channel)
+		}
+		genesis.ChannelGenesis.NextChannelSequence = uint64(len(simulation.Channels))
+
+		rawState[ibcexported.ModuleName] = cdc.MustMarshalJSON(&genesis)
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+// Simulation parameter constants.
+const (
+	HistoryRetention = "history_retention"
+	StatsRetention   = "stats_retention"
+	RegistrationMode = "registration_mode"
+	ForwardMode      = "forward_mode"
+)
+
+// RandomizedGenState generates a random GenesisState for the forwarding module.
+func RandomizedGenState(simState *module.SimulationState) {
+	var historyRetention uint64
+	simState.AppParams.GetOrGenerate(HistoryRetention, &historyRetention, simState.Rand, func(r *rand.Rand) {
+		historyRetention = uint64(r.Intn(50))
+	})
+
+	var statsRetention uint64
+	simState.AppParams.GetOrGenerate(StatsRetention, &statsRetention, simState.Rand, func(r *rand.Rand) {
+		statsRetention = uint64(r.Intn(180))
+	})
+
+	var registrationMode types.RegistrationMode
+	simState.AppParams.GetOrGenerate(RegistrationMode, &registrationMode, simState.Rand, func(r *rand.Rand) {
+		registrationMode = types.RegistrationMode(1 + r.Intn(2))
+	})
+
+	var forwardMode types.ForwardMode
+	simState.AppParams.GetOrGenerate(ForwardMode, &forwardMode, simState.Rand, func(r *rand.Rand) {
+		forwardMode = types.ForwardMode(1 + r.Intn(2))
+	})
+
+	genesis := types.GenesisState{
+		// NOTE: We always allow the bond denom, as it's the only denom held by
+		// simulation accounts, so that deposits into forwarding accounts matter.
+		AllowedDenoms:    []string{simState.BondDenom},
+		HistoryRetention: historyRetention,
+		StatsRetention:   statsRetention,
+		RegistrationMode: registrationMode,
+		ForwardMode:      forwardMode,
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
@@ -0,0 +1,342 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/noble-assets/forwarding/v2/keeper"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgRegisterAccount  = "op_weight_msg_register_account"
+	OpWeightMsgDeposit          = "op_weight_msg_deposit"
+	OpWeightMsgClearAccount     = "op_weight_msg_clear_account"
+	OpWeightMsgSetMemo          = "op_weight_msg_set_memo"
+	OpWeightMsgSetAllowedDenoms = "op_weight_msg_set_allowed_denoms"
+
+	DefaultWeightRegisterAccount  = 50
+	DefaultWeightDeposit          = 100
+	DefaultWeightClearAccount     = 25
+	DefaultWeightSetMemo          = 25
+	DefaultWeightSetAllowedDenoms = 5
+)
+
+// Channels are the transfer channels that forwarding accounts are registered
+// on during simulations. Apps must open them in their IBC genesis state, as
+// registrations on any other channel are skipped.
+var Channels = []string{"channel-0", "channel-1", "channel-2"}
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgRegisterAccount  int
+		weightMsgDeposit          int
+		weightMsgClearAccount     int
+		weightMsgSetMemo          int
+		weightMsgSetAllowedDenoms int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgRegisterAccount, &weightMsgRegisterAccount, nil, func(_ *rand.Rand) {
+		weightMsgRegisterAccount = DefaultWeightRegisterAccount
+	})
+	appParams.GetOrGenerate(OpWeightMsgDeposit, &weightMsgDeposit, nil, func(_ *rand.Rand) {
+		weightMsgDeposit = DefaultWeightDeposit
+	})
+	appParams.GetOrGenerate(OpWeightMsgClearAccount, &weightMsgClearAccount, nil, func(_ *rand.Rand) {
+		weightMsgClearAccount = DefaultWeightClearAccount
+	})
+	appParams.GetOrGenerate(OpWeightMsgSetMemo, &weightMsgSetMemo, nil, func(_ *rand.Rand) {
+		weightMsgSetMemo = DefaultWeightSetMemo
+	})
+	appParams.GetOrGenerate(OpWeightMsgSetAllowedDenoms, &weightMsgSetAllowedDenoms, nil, func(_ *rand.Rand) {
+		weightMsgSetAllowedDenoms = DefaultWeightSetAllowedDenoms
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgRegisterAccount, SimulateMsgRegisterAccount(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgDeposit, SimulateMsgDeposit(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgClearAccount, SimulateMsgClearAccount(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetMemo, SimulateMsgSetMemo(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetAllowedDenoms, SimulateMsgSetAllowedDenoms(k)),
+	}
+}
+
+// SimulateMsgRegisterAccount generates a MsgRegisterAccount for a random
+// channel, recipient and optional fallback.
+func SimulateMsgRegisterAccount(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterAccount{})
+
+		channel := Channels[r.Intn(len(Channels))]
+		if err := k.ValidateChannel(ctx, channel); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "channel is not open"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		fallback := ""
+		if r.Intn(2) == 0 {
+			account, _ := simtypes.RandomAcc(r, accs)
+			fallback = account.Address.String()
+		}
+
+		address := types.GenerateAddressWithVersion(types.DefaultAddressVersion, channel, recipient.Address.String(), fallback)
+		if ak.HasAccount(ctx, address) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account already exists"), nil, nil
+		}
+
+		msg := &types.MsgRegisterAccount{
+			Signer:    simAccount.Address.String(),
+			Recipient: recipient.Address.String(),
+			Channel:   channel,
+			Fallback:  fallback,
+		}
+		if denoms := k.GetAllowedDenoms(ctx); len(denoms) > 0 && r.Intn(3) == 0 {
+			msg.Memos = []types.MemoEntry{{
+				Denom: denoms[r.Intn(len(denoms))],
+				Memo:  simtypes.RandStringOfLength(r, 1+r.Intn(keeper.MaxMemoLength)),
+			}}
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		})
+	}
+}
+
+// SimulateMsgDeposit generates a bank MsgSend from a random account into a
+// random forwarding account, which queues the account for forwarding.
+func SimulateMsgDeposit(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+		account, found := randomForwardingAccount(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no forwarding accounts"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		coins := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, simAccount.Address))
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "empty coins slice"), nil, nil
+		}
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := banktypes.NewMsgSend(simAccount.Address, account.GetAddress(), coins)
+
+		return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			CoinsSpentInMsg: coins,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		})
+	}
+}
+
+// SimulateMsgClearAccount generates a MsgClearAccount for a random
+// forwarding account that holds an allowed denom.
+func SimulateMsgClearAccount(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgClearAccount{})
+
+		account, found := randomForwardingAccount(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no forwarding accounts"), nil, nil
+		}
+
+		hasBalance := false
+		for _, denom := range k.GetAllowedDenoms(ctx) {
+			if !bk.GetBalance(ctx, account.GetAddress(), denom).IsZero() {
+				hasBalance = true
+				break
+			}
+		}
+		if !hasBalance {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account does not require clearing"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgClearAccount{
+			Signer:   simAccount.Address.String(),
+			Address:  account.Address,
+			Fallback: r.Intn(2) == 0,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		})
+	}
+}
+
+// SimulateMsgSetMemo generates a MsgSetMemo, signed by the fallback of a
+// random forwarding account, that either sets or clears a memo.
+func SimulateMsgSetMemo(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetMemo{})
+
+		account, found := randomForwardingAccount(r, ctx, k)
+		if !found || account.Fallback == "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no forwarding account with fallback"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(account.Fallback))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "fallback is not a simulation account"), nil, nil
+		}
+
+		denom := sdk.DefaultBondDenom
+		if denoms := k.GetAllowedDenoms(ctx); len(denoms) > 0 {
+			denom = denoms[r.Intn(len(denoms))]
+		}
+
+		memo := ""
+		if r.Intn(5) > 0 {
+			memo = simtypes.RandStringOfLength(r, 1+r.Intn(keeper.MaxMemoLength))
+		}
+
+		msg := &types.MsgSetMemo{
+			Signer:    simAccount.Address.String(),
+			Recipient: account.Recipient,
+			Channel:   account.Channel,
+			Fallback:  account.Fallback,
+			Denom:     denom,
+			Memo:      memo,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		})
+	}
+}
+
+// SimulateMsgSetAllowedDenoms executes a MsgSetAllowedDenoms on behalf of the
+// module authority. As the authority is not a simulation account, the message
+// is executed directly against the msg server, as governance would do.
+func SimulateMsgSetAllowedDenoms(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetAllowedDenoms{
+			Signer: k.GetAuthority(),
+			Denoms: randomDenoms(r, sdk.DefaultBondDenom),
+		}
+
+		if _, err := k.SetAllowedDenoms(ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to set allowed denoms"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomForwardingAccount returns a random forwarding account registered with
+// the module.
+func randomForwardingAccount(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper) (*types.ForwardingAccount, bool) {
+	var addresses []string
+	_ = k.RegisteredAccounts.Walk(ctx, nil, func(key collections.Pair[string, string]) (stop bool, err error) {
+		addresses = append(addresses, key.K2())
+		return false, nil
+	})
+	if len(addresses) == 0 {
+		return nil, false
+	}
+
+	account, err := k.GetForwardingAccount(ctx, addresses[r.Intn(len(addresses))])
+	if err != nil {
+		return nil, false
+	}
+
+	return account, true
+}
+
+// randomDenoms returns a random list of allowed denoms, which includes the
+// bond denom most of the time.
+func randomDenoms(r *rand.Rand, bondDenom string) []string {
+	var denoms []string
+	if r.Intn(5) > 0 {
+		denoms = append(denoms, bondDenom)
+	}
+
+	n := r.Intn(3)
+	for i := 0; i < n; i++ {
+		denoms = append(denoms, "u"+strings.ToLower(simtypes.RandStringOfLength(r, 3+r.Intn(5))))
+	}
+
+	return denoms
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightProposalSetAllowedDenoms = "op_weight_proposal_set_allowed_denoms"
+
+	DefaultWeightProposalSetAllowedDenoms = 100
+)
+
+// ProposalMsgs defines the module weighted proposals' contents.
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightProposalSetAllowedDenoms,
+			DefaultWeightProposalSetAllowedDenoms,
+			SimulateMsgSetAllowedDenomsProposal,
+		),
+	}
+}
+
+// SimulateMsgSetAllowedDenomsProposal returns a random MsgSetAllowedDenoms
+// that is submitted by the governance module.
+func SimulateMsgSetAllowedDenomsProposal(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	authority := sdk.AccAddress(address.Module("gov"))
+
+	return &types.MsgSetAllowedDenoms{
+		Signer: authority.String(),
+		Denoms: randomDenoms(r, sdk.DefaultBondDenom),
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -36,11 +37,91 @@ var (
 	_ authtypes.GenesisAccount = &ForwardingAccount{}
 )
 
+// DefaultAddressVersion is the address version used to derive the address of
+// new forwarding accounts when none is specified.
+const DefaultAddressVersion = ADDRESS_VERSION_V2
+
+// GenerateAddress derives the address of a forwarding account using the v1
+// address version.
+//
+// Deprecated: Use GenerateAddressWithVersion instead.
 func GenerateAddress(channel string, recipient string, fallback string) sdk.AccAddress {
-	bz := []byte(channel + recipient + fallback)
+	return GenerateAddressWithVersion(ADDRESS_VERSION_V1, channel, recipient, fallback)
+}
+
+// GenerateAddressWithVersion derives the address of a forwarding account
+// using the given address version. An unspecified version resolves to the
+// default address version, while an unknown version panics, so callers must
+// validate untrusted versions first.
+func GenerateAddressWithVersion(version AddressVersion, channel string, recipient string, fallback string) sdk.AccAddress {
+	var bz []byte
+
+	switch ResolveAddressVersion(version) {
+	case ADDRESS_VERSION_V1:
+		bz = []byte(channel + recipient + fallback)
+	case ADDRESS_VERSION_V2:
+		// Every field is prefixed with its length, so that distinct tuples
+		// can't produce the same input by shifting a field boundary.
+		bz = []byte{byte(ADDRESS_VERSION_V2)}
+		for _, field := range []string{channel, recipient, fallback} {
+			bz = append(bz, sdk.Uint64ToBigEndian(uint64(len(field)))...)
+			bz = append(bz, field...)
+		}
+	default:
+		panic(fmt.Sprintf("unknown address version: %d", version))
+	}
+
 	return address.Derive([]byte(ModuleName), bz)[12:]
 }
 
+// ValidateAddressVersion checks that an address version is known.
+func ValidateAddressVersion(version AddressVersion) error {
+	if _, found := AddressVersion_name[int32(version)]; !found {
+		return errors.Wrapf(ErrInvalidAddressVersion, "unknown address version: %d", version)
+	}
+
+	return nil
+}
+
+// ResolveAddressVersion returns the given address version, or the default
+// address version if it is unspecified.
+func ResolveAddressVersion(version AddressVersion) AddressVersion {
+	if version == ADDRESS_VERSION_UNSPECIFIED {
+		return DefaultAddressVersion
+	}
+
+	return version
+}
+
+// ValidateForwardMode checks that a forward mode is known.
+func ValidateForwardMode(mode ForwardMode) error {
+	if _, found := ForwardMode_name[int32(mode)]; !found {
+		return fmt.Errorf("unknown forward mode: %d", mode)
+	}
+
+	return nil
+}
+
+// ResolveForwardMode returns the given forward mode, where an unspecified
+// mode behaves as end block.
+func ResolveForwardMode(mode ForwardMode) ForwardMode {
+	if mode == FORWARD_MODE_UNSPECIFIED {
+		return FORWARD_MODE_END_BLOCK
+	}
+
+	return mode
+}
+
+// EffectiveAddressVersion returns the address version of the account, where
+// accounts registered before versioning was introduced use v1.
+func (fa *ForwardingAccount) EffectiveAddressVersion() AddressVersion {
+	if fa.AddressVersion == ADDRESS_VERSION_UNSPECIFIED {
+		return ADDRESS_VERSION_V1
+	}
+
+	return fa.AddressVersion
+}
+
 func (fa *ForwardingAccount) Validate() error {
 	if !channeltypes.IsValidChannelID(fa.Channel) {
 		return fmt.Errorf("%s is an invalid channel id", fa.Channel)
@@ -50,11 +131,24 @@ func (fa *ForwardingAccount) Validate() error {
 		return fmt.Errorf("%d is an invalid creation block height", fa.CreatedAt)
 	}
 
+	if err := ValidateAddressVersion(fa.AddressVersion); err != nil {
+		return err
+	}
+
 	return fa.BaseAccount.Validate()
 }
 
 //
 
+// SignerlessVerificationGas is the fixed amount of gas consumed when verifying
+// a forwarding account public key, matching the default cost of a secp256k1
+// signature so that signerless transactions aren't cheaper than signed ones.
+const SignerlessVerificationGas uint64 = 1000
+
+// MaxSignerlessMessages is the maximum number of signerless messages, and
+// therefore forwarding account public keys, allowed in a single transaction.
+const MaxSignerlessMessages = 100
+
 var _ cryptotypes.PubKey = &ForwardingPubKey{}
 
 func (fpk *ForwardingPubKey) String() string {
@@ -25,12 +25,82 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AddressVersion defines the scheme used to derive a forwarding account's
+// address from its channel, recipient, and fallback.
+type AddressVersion int32
+
+const (
+	// ADDRESS_VERSION_UNSPECIFIED is the default value.
+	ADDRESS_VERSION_UNSPECIFIED AddressVersion = 0
+	// ADDRESS_VERSION_V1 hashes the plain concatenation of the fields.
+	ADDRESS_VERSION_V1 AddressVersion = 1
+	// ADDRESS_VERSION_V2 hashes the length-prefixed fields.
+	ADDRESS_VERSION_V2 AddressVersion = 2
+)
+
+var AddressVersion_name = map[int32]string{
+	0: "ADDRESS_VERSION_UNSPECIFIED",
+	1: "ADDRESS_VERSION_V1",
+	2: "ADDRESS_VERSION_V2",
+}
+
+var AddressVersion_value = map[string]int32{
+	"ADDRESS_VERSION_UNSPECIFIED": 0,
+	"ADDRESS_VERSION_V1":          1,
+	"ADDRESS_VERSION_V2":          2,
+}
+
+func (x AddressVersion) String() string {
+	return proto.EnumName(AddressVersion_name, int32(x))
+}
+
+func (AddressVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{0}
+}
+
+// ForwardMode determines when funds received by forwarding accounts over IBC
+// are forwarded.
+type ForwardMode int32
+
+const (
+	// FORWARD_MODE_UNSPECIFIED is the default value, and behaves as end block.
+	FORWARD_MODE_UNSPECIFIED ForwardMode = 0
+	// FORWARD_MODE_END_BLOCK forwards received funds at the end of the block.
+	FORWARD_MODE_END_BLOCK ForwardMode = 1
+	// FORWARD_MODE_IMMEDIATE forwards received funds in the same transaction.
+	FORWARD_MODE_IMMEDIATE ForwardMode = 2
+)
+
+var ForwardMode_name = map[int32]string{
+	0: "FORWARD_MODE_UNSPECIFIED",
+	1: "FORWARD_MODE_END_BLOCK",
+	2: "FORWARD_MODE_IMMEDIATE",
+}
+
+var ForwardMode_value = map[string]int32{
+	"FORWARD_MODE_UNSPECIFIED": 0,
+	"FORWARD_MODE_END_BLOCK":   1,
+	"FORWARD_MODE_IMMEDIATE":   2,
+}
+
+func (x ForwardMode) String() string {
+	return proto.EnumName(ForwardMode_name, int32(x))
+}
+
+func (ForwardMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{1}
+}
+
 type ForwardingAccount struct {
 	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
 	Channel            string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 	Recipient          string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	CreatedAt          int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Fallback           string `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// address_version is the scheme used to derive the account's address.
+	// Accounts registered before versioning was introduced leave it unspecified
+	// and use v1.
+	AddressVersion AddressVersion `protobuf:"varint,6,opt,name=address_version,json=addressVersion,proto3,enum=noble.forwarding.v1.AddressVersion" json:"address_version,omitempty"`
 }
 
 func (m *ForwardingAccount) Reset()         { *m = ForwardingAccount{} }
@@ -94,6 +164,13 @@ func (m *ForwardingAccount) GetFallback() string {
 	return ""
 }
 
+func (m *ForwardingAccount) GetAddressVersion() AddressVersion {
+	if m != nil {
+		return m.AddressVersion
+	}
+	return ADDRESS_VERSION_UNSPECIFIED
+}
+
 type ForwardingPubKey struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
@@ -138,6 +215,8 @@ func (m *ForwardingPubKey) GetKey() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("noble.forwarding.v1.AddressVersion", AddressVersion_name, AddressVersion_value)
+	proto.RegisterEnum("noble.forwarding.v1.ForwardMode", ForwardMode_name, ForwardMode_value)
 	proto.RegisterType((*ForwardingAccount)(nil), "noble.forwarding.v1.ForwardingAccount")
 	proto.RegisterType((*ForwardingPubKey)(nil), "noble.forwarding.v1.ForwardingPubKey")
 }
@@ -145,30 +224,39 @@ func init() {
 func init() { proto.RegisterFile("noble/forwarding/v1/account.proto", fileDescriptor_9d86db85ab0c667b) }
 
 var fileDescriptor_9d86db85ab0c667b = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xcb, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x3e, 0x18, 0x58, 0x60, 0x75, 0x51, 0x09, 0x96, 0xca, 0x8a, 0x68, 0xe8, 0xa4,
-	0xb8, 0x63, 0x07, 0x89, 0x26, 0xc4, 0x8d, 0xe9, 0xd2, 0x0d, 0x99, 0x19, 0x86, 0xd2, 0x50, 0x3a,
-	0xa4, 0x33, 0xc5, 0xf0, 0x17, 0x2e, 0x5d, 0xfa, 0x11, 0x7e, 0x84, 0x71, 0xc5, 0xd2, 0x95, 0x31,
-	0xf0, 0x09, 0xfe, 0x80, 0x61, 0x3a, 0xd2, 0x98, 0xb8, 0xbb, 0xe7, 0xdc, 0x7b, 0xe6, 0x9c, 0x3b,
-	0x17, 0x5e, 0xc4, 0x9c, 0x44, 0x0c, 0x8d, 0x79, 0xf2, 0x88, 0x93, 0x51, 0x18, 0x07, 0x68, 0xe1,
-	0x21, 0x4c, 0x29, 0x4f, 0x63, 0xe9, 0xce, 0x13, 0x2e, 0xb9, 0x79, 0xa2, 0x46, 0xdc, 0x7c, 0xc4,
-	0x5d, 0x78, 0x35, 0x9b, 0x72, 0x31, 0xe3, 0x02, 0xe1, 0x54, 0x4e, 0xd0, 0xc2, 0x23, 0x4c, 0x62,
-	0x4f, 0x81, 0x4c, 0x54, 0x3b, 0xcb, 0xfa, 0x43, 0x85, 0x50, 0x06, 0x74, 0xeb, 0x34, 0xe0, 0x01,
-	0xcf, 0xf8, 0x6d, 0x95, 0xb1, 0xcd, 0x6f, 0x00, 0x8f, 0x6f, 0x77, 0x16, 0xbd, 0x2c, 0x81, 0x39,
-	0x80, 0x15, 0x82, 0x05, 0x1b, 0xea, 0x44, 0x16, 0x70, 0x40, 0xab, 0xdc, 0x71, 0x5c, 0xfd, 0xa0,
-	0x32, 0xd4, 0xee, 0x6e, 0x1f, 0x0b, 0xa6, 0x75, 0xfd, 0xe2, 0xea, 0xb3, 0x01, 0xfc, 0x32, 0xc9,
-	0x29, 0xd3, 0x82, 0x87, 0x74, 0x82, 0xe3, 0x98, 0x45, 0xd6, 0x9e, 0x03, 0x5a, 0x25, 0xff, 0x17,
-	0x9a, 0x75, 0x58, 0x4a, 0x18, 0x0d, 0xe7, 0x21, 0x8b, 0xa5, 0x55, 0x50, 0xbd, 0x9c, 0x30, 0xcf,
-	0x21, 0xa4, 0x09, 0xc3, 0x92, 0x8d, 0x86, 0x58, 0x5a, 0x45, 0x07, 0xb4, 0x0a, 0x7e, 0x49, 0x33,
-	0x3d, 0x69, 0xd6, 0xe0, 0xd1, 0x18, 0x47, 0x11, 0xc1, 0x74, 0x6a, 0xed, 0x2b, 0xed, 0x0e, 0x77,
-	0x9d, 0xf7, 0xd7, 0x76, 0xfd, 0xbf, 0xa8, 0x3a, 0xd3, 0xa0, 0x79, 0x09, 0xab, 0xf9, 0xd2, 0xf7,
-	0x29, 0xb9, 0x63, 0x4b, 0xb3, 0x0a, 0x0b, 0x53, 0xb6, 0x54, 0xab, 0x56, 0xfc, 0x6d, 0xd9, 0x2d,
-	0x3e, 0xbf, 0x34, 0x8c, 0xfe, 0xcd, 0xdb, 0xda, 0x06, 0xab, 0xb5, 0x0d, 0xbe, 0xd6, 0x36, 0x78,
-	0xda, 0xd8, 0xc6, 0x6a, 0x63, 0x1b, 0x1f, 0x1b, 0xdb, 0x78, 0xb8, 0x0a, 0x42, 0x39, 0x49, 0x89,
-	0x4b, 0xf9, 0x0c, 0xa9, 0x63, 0xb5, 0xb1, 0x10, 0x4c, 0x8a, 0x3f, 0x67, 0xed, 0x20, 0xb9, 0x9c,
-	0x33, 0x41, 0x0e, 0xd4, 0x7f, 0x5f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xd3, 0x7f, 0x79,
-	0xfa, 0x01, 0x00, 0x00,
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x8e, 0xd2, 0x40,
+	0x1c, 0xee, 0x00, 0xae, 0x32, 0x6c, 0xb0, 0x8e, 0x66, 0x53, 0x11, 0x4b, 0x5d, 0x2f, 0x04, 0xb3,
+	0x6d, 0x8a, 0xb7, 0xbd, 0x95, 0x6d, 0x49, 0x9a, 0x5d, 0x60, 0x53, 0x14, 0x13, 0x2f, 0xcd, 0xb4,
+	0x9d, 0x2d, 0xcd, 0xb2, 0x1d, 0xd2, 0x19, 0x30, 0xbc, 0x81, 0x47, 0x8f, 0x1e, 0x4d, 0x7c, 0x05,
+	0x5f, 0xc0, 0x9b, 0xf1, 0xc4, 0xd1, 0x93, 0x31, 0xf0, 0x22, 0x66, 0xdb, 0xee, 0x12, 0x76, 0xb9,
+	0xcd, 0xf7, 0xe7, 0x37, 0xdf, 0x6f, 0xbe, 0x0c, 0x7c, 0x15, 0x53, 0x6f, 0x42, 0xb4, 0x0b, 0x9a,
+	0x7c, 0xc2, 0x49, 0x10, 0xc5, 0xa1, 0x36, 0xd7, 0x35, 0xec, 0xfb, 0x74, 0x16, 0x73, 0x75, 0x9a,
+	0x50, 0x4e, 0xd1, 0xd3, 0xd4, 0xa2, 0x6e, 0x2c, 0xea, 0x5c, 0xaf, 0xc9, 0x3e, 0x65, 0x57, 0x94,
+	0x69, 0x78, 0xc6, 0xc7, 0xda, 0x5c, 0xf7, 0x08, 0xc7, 0x7a, 0x0a, 0xb2, 0xa1, 0xda, 0xf3, 0x4c,
+	0x77, 0x53, 0xa4, 0x65, 0x20, 0x97, 0x9e, 0x85, 0x34, 0xa4, 0x19, 0x7f, 0x7d, 0xca, 0xd8, 0xc3,
+	0x9f, 0x05, 0xf8, 0xa4, 0x7b, 0x1b, 0x61, 0x64, 0x1b, 0x20, 0x1b, 0xee, 0x7b, 0x98, 0x11, 0x37,
+	0xdf, 0x48, 0x02, 0x0a, 0x68, 0x56, 0xda, 0x8a, 0x9a, 0x5f, 0x98, 0x06, 0xe6, 0xe9, 0x6a, 0x07,
+	0x33, 0x92, 0xcf, 0x75, 0x4a, 0xcb, 0xbf, 0x0d, 0xe0, 0x54, 0xbc, 0x0d, 0x85, 0x24, 0xf8, 0xd0,
+	0x1f, 0xe3, 0x38, 0x26, 0x13, 0xa9, 0xa0, 0x80, 0x66, 0xd9, 0xb9, 0x81, 0xa8, 0x0e, 0xcb, 0x09,
+	0xf1, 0xa3, 0x69, 0x44, 0x62, 0x2e, 0x15, 0x53, 0x6d, 0x43, 0xa0, 0x97, 0x10, 0xfa, 0x09, 0xc1,
+	0x9c, 0x04, 0x2e, 0xe6, 0x52, 0x49, 0x01, 0xcd, 0xa2, 0x53, 0xce, 0x19, 0x83, 0xa3, 0x1a, 0x7c,
+	0x74, 0x81, 0x27, 0x13, 0x0f, 0xfb, 0x97, 0xd2, 0x83, 0x74, 0xf6, 0x16, 0xa3, 0x33, 0xf8, 0x18,
+	0x07, 0x41, 0x42, 0x18, 0x73, 0xe7, 0x24, 0x61, 0x11, 0x8d, 0xa5, 0x3d, 0x05, 0x34, 0xab, 0xed,
+	0xd7, 0xea, 0x8e, 0x4e, 0x55, 0x23, 0xf3, 0x8e, 0x32, 0xab, 0x53, 0xc5, 0x5b, 0xf8, 0x58, 0xf9,
+	0xfd, 0xe3, 0xa8, 0xbe, 0xeb, 0xe1, 0xf9, 0x0b, 0xed, 0xc3, 0x16, 0x14, 0x37, 0x15, 0x9e, 0xcf,
+	0xbc, 0x53, 0xb2, 0x40, 0x22, 0x2c, 0x5e, 0x92, 0x45, 0x5a, 0xdc, 0xbe, 0x73, 0x7d, 0x3c, 0x2e,
+	0x7d, 0xfd, 0xd6, 0x10, 0x5a, 0x21, 0xac, 0x6e, 0xe7, 0xa1, 0x06, 0x7c, 0x61, 0x98, 0xa6, 0x63,
+	0x0d, 0x87, 0xee, 0xc8, 0x72, 0x86, 0xf6, 0xa0, 0xef, 0xbe, 0xef, 0x0f, 0xcf, 0xad, 0x13, 0xbb,
+	0x6b, 0x5b, 0xa6, 0x28, 0xa0, 0x03, 0x88, 0xee, 0x1a, 0x46, 0xba, 0x08, 0x76, 0xf2, 0x6d, 0xb1,
+	0x50, 0x2b, 0x7d, 0xfe, 0x2e, 0x0b, 0xad, 0x08, 0x56, 0xf2, 0xa5, 0x7a, 0x34, 0x20, 0xa8, 0x0e,
+	0xa5, 0xee, 0xc0, 0xf9, 0x60, 0x38, 0xa6, 0xdb, 0x1b, 0x98, 0xd6, 0x9d, 0x88, 0x1a, 0x3c, 0xd8,
+	0x52, 0xad, 0xbe, 0xe9, 0x76, 0xce, 0x06, 0x27, 0xa7, 0x22, 0xb8, 0xa7, 0xd9, 0xbd, 0x9e, 0x65,
+	0xda, 0xc6, 0x3b, 0xeb, 0x26, 0xaa, 0x63, 0xfd, 0x5a, 0xc9, 0x60, 0xb9, 0x92, 0xc1, 0xbf, 0x95,
+	0x0c, 0xbe, 0xac, 0x65, 0x61, 0xb9, 0x96, 0x85, 0x3f, 0x6b, 0x59, 0xf8, 0xf8, 0x26, 0x8c, 0xf8,
+	0x78, 0xe6, 0xa9, 0x3e, 0xbd, 0xd2, 0xd2, 0xea, 0x8f, 0x30, 0x63, 0x84, 0xb3, 0xad, 0x8f, 0xdf,
+	0xd6, 0xf8, 0x62, 0x4a, 0x98, 0xb7, 0x97, 0xfe, 0xc8, 0xb7, 0xff, 0x07, 0x00, 0xe4, 0xa6, 0x58,
+	0x3b, 0x1c, 0x03, 0x00, 0x00,
 }
 
 func (m *ForwardingAccount) Marshal() (dAtA []byte, err error) {
@@ -191,6 +279,11 @@ func (m *ForwardingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AddressVersion != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Fallback) > 0 {
 		i -= len(m.Fallback)
 		copy(dAtA[i:], m.Fallback)
@@ -298,6 +391,9 @@ func (m *ForwardingAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovAccount(uint64(m.AddressVersion))
+	}
 	return n
 }
 
@@ -500,6 +596,25 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Fallback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= AddressVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -25,11 +25,60 @@ import (
 	tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 )
 
-func ParseChainId(rawClientState exported.ClientState) string {
-	switch clientState := rawClientState.(type) {
-	case *tendermint.ClientState:
-		return clientState.ChainId
-	default:
-		return "UNKNOWN"
+// UnknownChainId is reported when the chain ID of a counterparty chain can't
+// be resolved from its light client.
+const UnknownChainId = "UNKNOWN"
+
+// ChainIdResolver extracts the chain ID of the counterparty chain from the
+// client state of a light client, returning false if it can't be resolved.
+type ChainIdResolver func(clientState exported.ClientState) (string, bool)
+
+// ChainIdResolvers is a registry of chain ID resolvers, keyed by client type.
+type ChainIdResolvers map[string]ChainIdResolver
+
+// DefaultChainIdResolvers returns the chain ID resolvers for the light
+// clients provided by ibc-go that track a chain ID.
+//
+// NOTE: Solo machines don't track a chain ID, and 08-wasm clients wrap an
+// opaque client state, so neither is supported out of the box. Channels using
+// them report an unknown chain ID, unless the app registers a resolver.
+func DefaultChainIdResolvers() ChainIdResolvers {
+	return ChainIdResolvers{
+		exported.Tendermint: resolveTendermintChainId,
+	}
+}
+
+// Resolve returns the chain ID of the counterparty chain tracked by a light
+// client, using the resolver registered for its client type.
+func (r ChainIdResolvers) Resolve(clientState exported.ClientState) string {
+	if clientState == nil {
+		return UnknownChainId
+	}
+
+	resolver, found := r[clientState.ClientType()]
+	if !found {
+		return UnknownChainId
 	}
+
+	chainId, ok := resolver(clientState)
+	if !ok || chainId == "" {
+		return UnknownChainId
+	}
+
+	return chainId
+}
+
+// ParseChainId returns the chain ID of the counterparty chain tracked by a
+// light client, using the default resolvers.
+func ParseChainId(clientState exported.ClientState) string {
+	return DefaultChainIdResolvers().Resolve(clientState)
+}
+
+func resolveTendermintChainId(rawClientState exported.ClientState) (string, bool) {
+	clientState, ok := rawClientState.(*tendermint.ClientState)
+	if !ok {
+		return "", false
+	}
+
+	return clientState.ChainId, true
 }
@@ -43,6 +43,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterAccount{}, "noble/forwarding/RegisterAccount", nil)
 	cdc.RegisterConcrete(&MsgClearAccount{}, "noble/forwarding/ClearAccount", nil)
 	cdc.RegisterConcrete(&MsgSetAllowedDenoms{}, "noble/forwarding/SetAllowedDenoms", nil)
+	cdc.RegisterConcrete(&MsgSetHistoryRetention{}, "noble/forwarding/SetHistoryRetention", nil)
+	cdc.RegisterConcrete(&MsgSetStatsRetention{}, "noble/forwarding/SetStatsRetention", nil)
+	cdc.RegisterConcrete(&MsgSetChainChannel{}, "noble/forwarding/SetChainChannel", nil)
+	cdc.RegisterConcrete(&MsgSetRegistrationMode{}, "noble/forwarding/SetRegistrationMode", nil)
+	cdc.RegisterConcrete(&MsgSetForwardMode{}, "noble/forwarding/SetForwardMode", nil)
+	cdc.RegisterConcrete(&MsgSetSignerlessFee{}, "noble/forwarding/SetSignerlessFee", nil)
+	cdc.RegisterConcrete(&MsgSetRegistrationFee{}, "noble/forwarding/SetRegistrationFee", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -55,6 +62,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterAccount{},
 		&MsgClearAccount{},
 		&MsgSetAllowedDenoms{},
+		&MsgSetHistoryRetention{},
+		&MsgSetStatsRetention{},
+		&MsgSetChainChannel{},
+		&MsgSetRegistrationMode{},
+		&MsgSetForwardMode{},
+		&MsgSetSignerlessFee{},
+		&MsgSetRegistrationFee{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
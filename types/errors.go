@@ -22,7 +22,26 @@ package types
 
 import "cosmossdk.io/errors"
 
+// NOTE: Error acknowledgements only contain the ABCI code of an error, so
+// these codes are part of the IBC interface of this module, and must not be
+// changed or reused.
 var (
-	ErrInvalidAuthority = errors.Register(ModuleName, 1, "signer is not authority")
-	ErrInvalidDenoms    = errors.Register(ModuleName, 2, "invalid allowed denoms")
+	ErrInvalidAuthority         = errors.Register(ModuleName, 1, "signer is not authority")
+	ErrInvalidDenoms            = errors.Register(ModuleName, 2, "invalid allowed denoms")
+	ErrInvalidChannel           = errors.Register(ModuleName, 3, "invalid channel")
+	ErrChannelNotFound          = errors.Register(ModuleName, 4, "channel does not exist")
+	ErrChannelNotOpen           = errors.Register(ModuleName, 5, "channel is not open")
+	ErrInvalidRecipient         = errors.Register(ModuleName, 6, "invalid recipient")
+	ErrInvalidFallback          = errors.Register(ModuleName, 7, "invalid fallback address")
+	ErrInvalidAddressVersion    = errors.Register(ModuleName, 8, "invalid address version")
+	ErrInvalidMemo              = errors.Register(ModuleName, 9, "invalid memo")
+	ErrUnknownChain             = errors.Register(ModuleName, 10, "unknown chain")
+	ErrChainMismatch            = errors.Register(ModuleName, 11, "chain mismatch")
+	ErrExistingAccount          = errors.Register(ModuleName, 12, "existing user account")
+	ErrAccountAlreadyRegistered = errors.Register(ModuleName, 13, "account has already been registered")
+	ErrUnsupportedAccountType   = errors.Register(ModuleName, 14, "unsupported account type")
+	ErrDenomNotAllowed          = errors.Register(ModuleName, 15, "denom is not allowed")
+	ErrForwardFailed            = errors.Register(ModuleName, 16, "forward failed")
+	ErrForwardTimedOut          = errors.Register(ModuleName, 17, "forward timed out")
+	ErrUnsupportedSignerlessTx  = errors.Register(ModuleName, 18, "unsupported signerless transaction")
 )
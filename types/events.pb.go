@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,6 +25,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueueTrigger is the action that caused a forwarding account to be queued.
+type QueueTrigger int32
+
+const (
+	// QUEUE_TRIGGER_UNSPECIFIED is the default value.
+	QUEUE_TRIGGER_UNSPECIFIED QueueTrigger = 0
+	// QUEUE_TRIGGER_BANK_SEND indicates a deposit via a bank send.
+	QUEUE_TRIGGER_BANK_SEND QueueTrigger = 1
+	// QUEUE_TRIGGER_IBC_RECEIVE indicates a deposit via an incoming IBC transfer.
+	QUEUE_TRIGGER_IBC_RECEIVE QueueTrigger = 2
+	// QUEUE_TRIGGER_MANUAL_CLEAR indicates a manual clearing of the account.
+	QUEUE_TRIGGER_MANUAL_CLEAR QueueTrigger = 3
+	// QUEUE_TRIGGER_REGISTRATION indicates the registration of an account that already holds a balance.
+	QUEUE_TRIGGER_REGISTRATION QueueTrigger = 4
+)
+
+var QueueTrigger_name = map[int32]string{
+	0: "QUEUE_TRIGGER_UNSPECIFIED",
+	1: "QUEUE_TRIGGER_BANK_SEND",
+	2: "QUEUE_TRIGGER_IBC_RECEIVE",
+	3: "QUEUE_TRIGGER_MANUAL_CLEAR",
+	4: "QUEUE_TRIGGER_REGISTRATION",
+}
+
+var QueueTrigger_value = map[string]int32{
+	"QUEUE_TRIGGER_UNSPECIFIED":  0,
+	"QUEUE_TRIGGER_BANK_SEND":    1,
+	"QUEUE_TRIGGER_IBC_RECEIVE":  2,
+	"QUEUE_TRIGGER_MANUAL_CLEAR": 3,
+	"QUEUE_TRIGGER_REGISTRATION": 4,
+}
+
+func (x QueueTrigger) String() string {
+	return proto.EnumName(QueueTrigger_name, int32(x))
+}
+
+func (QueueTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{0}
+}
+
 // AccountRegistered is emitted whenever a new forwarding account is registered.
 type AccountRegistered struct {
 	// address is the address of the forwarding account.
@@ -269,315 +312,4523 @@ func (m *MemoSet) GetMemo() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AccountRegistered)(nil), "noble.forwarding.v1.AccountRegistered")
-	proto.RegisterType((*AccountCleared)(nil), "noble.forwarding.v1.AccountCleared")
-	proto.RegisterType((*AllowedDenomsConfigured)(nil), "noble.forwarding.v1.AllowedDenomsConfigured")
-	proto.RegisterType((*MemoSet)(nil), "noble.forwarding.v1.MemoSet")
+// HistoryRetentionConfigured is emitted whenever the forward history retention is updated.
+type HistoryRetentionConfigured struct {
+	// previous_retention is the previous number of records kept per account.
+	PreviousRetention uint64 `protobuf:"varint,1,opt,name=previous_retention,json=previousRetention,proto3" json:"previous_retention,omitempty"`
+	// current_retention is the current number of records kept per account.
+	CurrentRetention uint64 `protobuf:"varint,2,opt,name=current_retention,json=currentRetention,proto3" json:"current_retention,omitempty"`
 }
 
-func init() { proto.RegisterFile("noble/forwarding/v1/events.proto", fileDescriptor_f58759da7cd78060) }
-
-var fileDescriptor_f58759da7cd78060 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xcf, 0x4a, 0xc3, 0x30,
-	0x1c, 0x5e, 0xb7, 0xe9, 0x5c, 0xc0, 0x89, 0x51, 0xb0, 0x88, 0x94, 0x51, 0x10, 0x07, 0x62, 0xcb,
-	0xf4, 0x09, 0xe6, 0x14, 0xbc, 0xec, 0x32, 0x6f, 0x5e, 0x24, 0x4d, 0x7f, 0xeb, 0x82, 0x69, 0x52,
-	0x92, 0xb4, 0xc3, 0xa3, 0x6f, 0xe0, 0x63, 0x79, 0xdc, 0xd1, 0xa3, 0x6c, 0x2f, 0x22, 0x4b, 0x3b,
-	0xc7, 0x3c, 0xec, 0xd6, 0xef, 0x4f, 0x7f, 0xdf, 0x47, 0x3e, 0xd4, 0x15, 0x32, 0xe2, 0x10, 0x4e,
-	0xa4, 0x9a, 0x11, 0x15, 0x33, 0x91, 0x84, 0x45, 0x3f, 0x84, 0x02, 0x84, 0xd1, 0x41, 0xa6, 0xa4,
-	0x91, 0xf8, 0xc4, 0x3a, 0x82, 0x8d, 0x23, 0x28, 0xfa, 0xfe, 0x87, 0x83, 0x8e, 0x07, 0x94, 0xca,
-	0x5c, 0x98, 0x31, 0x24, 0x4c, 0x1b, 0x50, 0x10, 0x63, 0x17, 0xb5, 0x48, 0x1c, 0x2b, 0xd0, 0xda,
-	0x75, 0xba, 0x4e, 0xaf, 0x3d, 0x5e, 0xc3, 0x95, 0x42, 0xa7, 0x44, 0x08, 0xe0, 0x6e, 0xbd, 0x54,
-	0x2a, 0x88, 0x2f, 0x50, 0x5b, 0x01, 0x65, 0x19, 0x03, 0x61, 0xdc, 0x86, 0xd5, 0x36, 0x04, 0x3e,
-	0x47, 0x07, 0x13, 0xc2, 0x79, 0x44, 0xe8, 0x9b, 0xdb, 0xb4, 0xe2, 0x1f, 0xf6, 0x9f, 0x50, 0xa7,
-	0xaa, 0x30, 0xe4, 0x40, 0x76, 0xe7, 0x6f, 0xa5, 0xd4, 0xff, 0xa5, 0xf8, 0x0c, 0x9d, 0x0d, 0x38,
-	0x97, 0x33, 0x88, 0x1f, 0x40, 0xc8, 0x54, 0x0f, 0xa5, 0x98, 0xb0, 0x24, 0x5f, 0x9d, 0xbc, 0x42,
-	0x47, 0x99, 0x82, 0x82, 0xc9, 0x5c, 0xbf, 0xc6, 0x56, 0x74, 0x9d, 0x6e, 0xa3, 0xd7, 0x1e, 0x77,
-	0xd6, 0x74, 0xf9, 0x0b, 0xbe, 0x44, 0x1d, 0x9a, 0x2b, 0x05, 0xc2, 0xac, 0x7d, 0x75, 0xeb, 0x3b,
-	0xac, 0xd8, 0xd2, 0xe6, 0x8f, 0x50, 0x6b, 0x04, 0xa9, 0x7c, 0x06, 0xb3, 0xa3, 0xed, 0x29, 0xda,
-	0xb3, 0x37, 0xaa, 0xa6, 0x25, 0xc0, 0x18, 0x35, 0x53, 0x48, 0x65, 0xf5, 0x48, 0xf6, 0xfb, 0xfe,
-	0xf1, 0x6b, 0xe1, 0x39, 0xf3, 0x85, 0xe7, 0xfc, 0x2c, 0x3c, 0xe7, 0x73, 0xe9, 0xd5, 0xe6, 0x4b,
-	0xaf, 0xf6, 0xbd, 0xf4, 0x6a, 0x2f, 0xd7, 0x09, 0x33, 0xd3, 0x3c, 0x0a, 0xa8, 0x4c, 0x43, 0xbb,
-	0xe0, 0x0d, 0xd1, 0x1a, 0x8c, 0xde, 0x9a, 0xfa, 0x36, 0x34, 0xef, 0x19, 0xe8, 0x68, 0xdf, 0x4e,
-	0x7d, 0xf7, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x4e, 0x9b, 0x78, 0x0e, 0x02, 0x00, 0x00,
+func (m *HistoryRetentionConfigured) Reset()         { *m = HistoryRetentionConfigured{} }
+func (m *HistoryRetentionConfigured) String() string { return proto.CompactTextString(m) }
+func (*HistoryRetentionConfigured) ProtoMessage()    {}
+func (*HistoryRetentionConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{4}
 }
-
-func (m *AccountRegistered) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HistoryRetentionConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryRetentionConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryRetentionConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AccountRegistered) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HistoryRetentionConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRetentionConfigured.Merge(m, src)
+}
+func (m *HistoryRetentionConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryRetentionConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRetentionConfigured.DiscardUnknown(m)
 }
 
-func (m *AccountRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fallback) > 0 {
-		i -= len(m.Fallback)
-		copy(dAtA[i:], m.Fallback)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fallback)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_HistoryRetentionConfigured proto.InternalMessageInfo
+
+func (m *HistoryRetentionConfigured) GetPreviousRetention() uint64 {
+	if m != nil {
+		return m.PreviousRetention
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AccountCleared) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HistoryRetentionConfigured) GetCurrentRetention() uint64 {
+	if m != nil {
+		return m.CurrentRetention
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AccountCleared) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StatsRetentionConfigured is emitted whenever the stats history retention is updated.
+type StatsRetentionConfigured struct {
+	// previous_retention is the previous number of days of stats kept.
+	PreviousRetention uint64 `protobuf:"varint,1,opt,name=previous_retention,json=previousRetention,proto3" json:"previous_retention,omitempty"`
+	// current_retention is the current number of days of stats kept.
+	CurrentRetention uint64 `protobuf:"varint,2,opt,name=current_retention,json=currentRetention,proto3" json:"current_retention,omitempty"`
 }
 
-func (m *AccountCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
+func (m *StatsRetentionConfigured) Reset()         { *m = StatsRetentionConfigured{} }
+func (m *StatsRetentionConfigured) String() string { return proto.CompactTextString(m) }
+func (*StatsRetentionConfigured) ProtoMessage()    {}
+func (*StatsRetentionConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{5}
+}
+func (m *StatsRetentionConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatsRetentionConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatsRetentionConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *StatsRetentionConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsRetentionConfigured.Merge(m, src)
+}
+func (m *StatsRetentionConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatsRetentionConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsRetentionConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsRetentionConfigured proto.InternalMessageInfo
+
+func (m *StatsRetentionConfigured) GetPreviousRetention() uint64 {
+	if m != nil {
+		return m.PreviousRetention
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AllowedDenomsConfigured) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StatsRetentionConfigured) GetCurrentRetention() uint64 {
+	if m != nil {
+		return m.CurrentRetention
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AllowedDenomsConfigured) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChainChannelConfigured is emitted whenever the channel registered for a
+// counterparty chain is updated.
+type ChainChannelConfigured struct {
+	// chain_id is the chain id of the counterparty chain.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// previous_channel is the previously registered channel, if any.
+	PreviousChannel string `protobuf:"bytes,2,opt,name=previous_channel,json=previousChannel,proto3" json:"previous_channel,omitempty"`
+	// current_channel is the currently registered channel, if any.
+	CurrentChannel string `protobuf:"bytes,3,opt,name=current_channel,json=currentChannel,proto3" json:"current_channel,omitempty"`
 }
 
-func (m *AllowedDenomsConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentDenoms) > 0 {
-		for iNdEx := len(m.CurrentDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CurrentDenoms[iNdEx])
-			copy(dAtA[i:], m.CurrentDenoms[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.PreviousDenoms) > 0 {
-		for iNdEx := len(m.PreviousDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PreviousDenoms[iNdEx])
-			copy(dAtA[i:], m.PreviousDenoms[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *ChainChannelConfigured) Reset()         { *m = ChainChannelConfigured{} }
+func (m *ChainChannelConfigured) String() string { return proto.CompactTextString(m) }
+func (*ChainChannelConfigured) ProtoMessage()    {}
+func (*ChainChannelConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{6}
+}
+func (m *ChainChannelConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainChannelConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainChannelConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ChainChannelConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainChannelConfigured.Merge(m, src)
+}
+func (m *ChainChannelConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainChannelConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainChannelConfigured.DiscardUnknown(m)
 }
 
-func (m *MemoSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChainChannelConfigured proto.InternalMessageInfo
+
+func (m *ChainChannelConfigured) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MemoSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChainChannelConfigured) GetPreviousChannel() string {
+	if m != nil {
+		return m.PreviousChannel
+	}
+	return ""
 }
 
-func (m *MemoSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *ChainChannelConfigured) GetCurrentChannel() string {
+	if m != nil {
+		return m.CurrentChannel
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// RegistrationModeConfigured is emitted whenever the registration mode is updated.
+type RegistrationModeConfigured struct {
+	// previous_mode is the previous registration mode.
+	PreviousMode RegistrationMode `protobuf:"varint,1,opt,name=previous_mode,json=previousMode,proto3,enum=noble.forwarding.v1.RegistrationMode" json:"previous_mode,omitempty"`
+	// current_mode is the current registration mode.
+	CurrentMode RegistrationMode `protobuf:"varint,2,opt,name=current_mode,json=currentMode,proto3,enum=noble.forwarding.v1.RegistrationMode" json:"current_mode,omitempty"`
+}
+
+func (m *RegistrationModeConfigured) Reset()         { *m = RegistrationModeConfigured{} }
+func (m *RegistrationModeConfigured) String() string { return proto.CompactTextString(m) }
+func (*RegistrationModeConfigured) ProtoMessage()    {}
+func (*RegistrationModeConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{7}
+}
+func (m *RegistrationModeConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationModeConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationModeConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RegistrationModeConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationModeConfigured.Merge(m, src)
+}
+func (m *RegistrationModeConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationModeConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationModeConfigured.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_RegistrationModeConfigured proto.InternalMessageInfo
+
+func (m *RegistrationModeConfigured) GetPreviousMode() RegistrationMode {
+	if m != nil {
+		return m.PreviousMode
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return REGISTRATION_MODE_UNSPECIFIED
 }
-func (m *AccountRegistered) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RegistrationModeConfigured) GetCurrentMode() RegistrationMode {
+	if m != nil {
+		return m.CurrentMode
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return REGISTRATION_MODE_UNSPECIFIED
+}
+
+// ForwardModeConfigured is emitted whenever the forward mode is updated.
+type ForwardModeConfigured struct {
+	// previous_mode is the previous forward mode.
+	PreviousMode ForwardMode `protobuf:"varint,1,opt,name=previous_mode,json=previousMode,proto3,enum=noble.forwarding.v1.ForwardMode" json:"previous_mode,omitempty"`
+	// current_mode is the current forward mode.
+	CurrentMode ForwardMode `protobuf:"varint,2,opt,name=current_mode,json=currentMode,proto3,enum=noble.forwarding.v1.ForwardMode" json:"current_mode,omitempty"`
+}
+
+func (m *ForwardModeConfigured) Reset()         { *m = ForwardModeConfigured{} }
+func (m *ForwardModeConfigured) String() string { return proto.CompactTextString(m) }
+func (*ForwardModeConfigured) ProtoMessage()    {}
+func (*ForwardModeConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{8}
+}
+func (m *ForwardModeConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardModeConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardModeConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *ForwardModeConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardModeConfigured.Merge(m, src)
+}
+func (m *ForwardModeConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardModeConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardModeConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardModeConfigured proto.InternalMessageInfo
+
+func (m *ForwardModeConfigured) GetPreviousMode() ForwardMode {
+	if m != nil {
+		return m.PreviousMode
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return FORWARD_MODE_UNSPECIFIED
+}
+
+func (m *ForwardModeConfigured) GetCurrentMode() ForwardMode {
+	if m != nil {
+		return m.CurrentMode
 	}
-	l = len(m.Fallback)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return FORWARD_MODE_UNSPECIFIED
+}
+
+// SignerlessFeeConfigured is emitted whenever the signerless fee is updated.
+type SignerlessFeeConfigured struct {
+	// previous_fee is the previous signerless fee.
+	PreviousFee types.Coin `protobuf:"bytes,1,opt,name=previous_fee,json=previousFee,proto3" json:"previous_fee"`
+	// current_fee is the current signerless fee.
+	CurrentFee types.Coin `protobuf:"bytes,2,opt,name=current_fee,json=currentFee,proto3" json:"current_fee"`
+}
+
+func (m *SignerlessFeeConfigured) Reset()         { *m = SignerlessFeeConfigured{} }
+func (m *SignerlessFeeConfigured) String() string { return proto.CompactTextString(m) }
+func (*SignerlessFeeConfigured) ProtoMessage()    {}
+func (*SignerlessFeeConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{9}
+}
+func (m *SignerlessFeeConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerlessFeeConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerlessFeeConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SignerlessFeeConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerlessFeeConfigured.Merge(m, src)
+}
+func (m *SignerlessFeeConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerlessFeeConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerlessFeeConfigured.DiscardUnknown(m)
 }
 
-func (m *AccountCleared) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_SignerlessFeeConfigured proto.InternalMessageInfo
+
+func (m *SignerlessFeeConfigured) GetPreviousFee() types.Coin {
+	if m != nil {
+		return m.PreviousFee
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return types.Coin{}
+}
+
+func (m *SignerlessFeeConfigured) GetCurrentFee() types.Coin {
+	if m != nil {
+		return m.CurrentFee
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return types.Coin{}
+}
+
+// RegistrationFeeConfigured is emitted whenever the registration fee is
+// updated.
+type RegistrationFeeConfigured struct {
+	// previous_fee is the previous registration fee.
+	PreviousFee types.Coin `protobuf:"bytes,1,opt,name=previous_fee,json=previousFee,proto3" json:"previous_fee"`
+	// current_fee is the current registration fee.
+	CurrentFee types.Coin `protobuf:"bytes,2,opt,name=current_fee,json=currentFee,proto3" json:"current_fee"`
+}
+
+func (m *RegistrationFeeConfigured) Reset()         { *m = RegistrationFeeConfigured{} }
+func (m *RegistrationFeeConfigured) String() string { return proto.CompactTextString(m) }
+func (*RegistrationFeeConfigured) ProtoMessage()    {}
+func (*RegistrationFeeConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{10}
+}
+func (m *RegistrationFeeConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationFeeConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationFeeConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RegistrationFeeConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationFeeConfigured.Merge(m, src)
+}
+func (m *RegistrationFeeConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationFeeConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationFeeConfigured.DiscardUnknown(m)
 }
 
-func (m *AllowedDenomsConfigured) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RegistrationFeeConfigured proto.InternalMessageInfo
+
+func (m *RegistrationFeeConfigured) GetPreviousFee() types.Coin {
+	if m != nil {
+		return m.PreviousFee
 	}
-	var l int
-	_ = l
-	if len(m.PreviousDenoms) > 0 {
-		for _, s := range m.PreviousDenoms {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	return types.Coin{}
+}
+
+func (m *RegistrationFeeConfigured) GetCurrentFee() types.Coin {
+	if m != nil {
+		return m.CurrentFee
+	}
+	return types.Coin{}
+}
+
+// RegistrationFeePaid is emitted whenever part or all of the registration fee
+// of a forwarding account is paid and burned.
+type RegistrationFeePaid struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount that was burned.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// remaining is the amount of the registration fee that is still unpaid.
+	Remaining types.Coin `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining"`
+	// payer is the address that paid the fee, which is either the forwarding
+	// account itself or the signer of the registration.
+	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty"`
+}
+
+func (m *RegistrationFeePaid) Reset()         { *m = RegistrationFeePaid{} }
+func (m *RegistrationFeePaid) String() string { return proto.CompactTextString(m) }
+func (*RegistrationFeePaid) ProtoMessage()    {}
+func (*RegistrationFeePaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{11}
+}
+func (m *RegistrationFeePaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationFeePaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationFeePaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CurrentDenoms) > 0 {
-		for _, s := range m.CurrentDenoms {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+}
+func (m *RegistrationFeePaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationFeePaid.Merge(m, src)
+}
+func (m *RegistrationFeePaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationFeePaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationFeePaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationFeePaid proto.InternalMessageInfo
+
+func (m *RegistrationFeePaid) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RegistrationFeePaid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *RegistrationFeePaid) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
+	}
+	return types.Coin{}
+}
+
+func (m *RegistrationFeePaid) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+// RegistrationFailed is emitted whenever a registration through a transfer
+// memo fails in soft-fail mode, and the transfer proceeds.
+type RegistrationFailed struct {
+	// address is the receiver of the transfer.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that the transfer was received on.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// recipient is the requested recipient of forwards.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// fallback is the requested fallback address.
+	Fallback string `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// error is the reason the registration failed.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RegistrationFailed) Reset()         { *m = RegistrationFailed{} }
+func (m *RegistrationFailed) String() string { return proto.CompactTextString(m) }
+func (*RegistrationFailed) ProtoMessage()    {}
+func (*RegistrationFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{12}
+}
+func (m *RegistrationFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationFailed.Merge(m, src)
+}
+func (m *RegistrationFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationFailed proto.InternalMessageInfo
+
+func (m *RegistrationFailed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RegistrationFailed) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RegistrationFailed) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *RegistrationFailed) GetFallback() string {
+	if m != nil {
+		return m.Fallback
+	}
+	return ""
+}
+
+func (m *RegistrationFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ForwardRefunded is emitted whenever an atomic forward fails or times out, and
+// the incoming transfer is refunded.
+type ForwardRefunded struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that the incoming transfer was received on.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// sequence is the sequence of the incoming transfer.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// amount is the amount that was refunded.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// reason is the reason the forward failed.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ForwardRefunded) Reset()         { *m = ForwardRefunded{} }
+func (m *ForwardRefunded) String() string { return proto.CompactTextString(m) }
+func (*ForwardRefunded) ProtoMessage()    {}
+func (*ForwardRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{13}
+}
+func (m *ForwardRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRefunded.Merge(m, src)
+}
+func (m *ForwardRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRefunded proto.InternalMessageInfo
+
+func (m *ForwardRefunded) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardRefunded) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardRefunded) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ForwardRefunded) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardRefunded) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ForwardExecuted is emitted whenever an automatic forward is sent.
+type ForwardExecuted struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that funds were forwarded through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// recipient is the address of the recipient of the forward.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount that was forwarded.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// sequence is the packet sequence of the outbound transfer.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// memo is the memo attached to the outbound transfer.
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *ForwardExecuted) Reset()         { *m = ForwardExecuted{} }
+func (m *ForwardExecuted) String() string { return proto.CompactTextString(m) }
+func (*ForwardExecuted) ProtoMessage()    {}
+func (*ForwardExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{14}
+}
+func (m *ForwardExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardExecuted.Merge(m, src)
+}
+func (m *ForwardExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardExecuted proto.InternalMessageInfo
+
+func (m *ForwardExecuted) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardExecuted) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardExecuted) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ForwardExecuted) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardExecuted) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ForwardExecuted) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// ForwardFailed is emitted whenever an automatic forward could not be sent.
+type ForwardFailed struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that funds were to be forwarded through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// recipient is the address of the recipient of the forward.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount that was to be forwarded.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// reason is the error that caused the forward to fail.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ForwardFailed) Reset()         { *m = ForwardFailed{} }
+func (m *ForwardFailed) String() string { return proto.CompactTextString(m) }
+func (*ForwardFailed) ProtoMessage()    {}
+func (*ForwardFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{15}
+}
+func (m *ForwardFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardFailed.Merge(m, src)
+}
+func (m *ForwardFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardFailed proto.InternalMessageInfo
+
+func (m *ForwardFailed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardFailed) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardFailed) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ForwardFailed) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ForwardSkipped is emitted whenever an automatic forward is not attempted.
+type ForwardSkipped struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that funds were to be forwarded through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// denom is the denom that was skipped, empty if the whole account was skipped.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reason is the reason why the forward was skipped.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ForwardSkipped) Reset()         { *m = ForwardSkipped{} }
+func (m *ForwardSkipped) String() string { return proto.CompactTextString(m) }
+func (*ForwardSkipped) ProtoMessage()    {}
+func (*ForwardSkipped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{16}
+}
+func (m *ForwardSkipped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardSkipped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardSkipped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardSkipped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardSkipped.Merge(m, src)
+}
+func (m *ForwardSkipped) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardSkipped) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardSkipped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardSkipped proto.InternalMessageInfo
+
+func (m *ForwardSkipped) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardSkipped) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardSkipped) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ForwardSkipped) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ForwardsSummary is emitted at the end of every block that had pending forwards.
+type ForwardsSummary struct {
+	// num_of_accounts is the number of forwarding accounts that were processed.
+	NumOfAccounts uint64 `protobuf:"varint,1,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
+	// num_of_executed is the number of forwards that were sent.
+	NumOfExecuted uint64 `protobuf:"varint,2,opt,name=num_of_executed,json=numOfExecuted,proto3" json:"num_of_executed,omitempty"`
+	// num_of_failed is the number of forwards that failed.
+	NumOfFailed uint64 `protobuf:"varint,3,opt,name=num_of_failed,json=numOfFailed,proto3" json:"num_of_failed,omitempty"`
+	// num_of_skipped is the number of forwards that were skipped.
+	NumOfSkipped uint64 `protobuf:"varint,4,opt,name=num_of_skipped,json=numOfSkipped,proto3" json:"num_of_skipped,omitempty"`
+}
+
+func (m *ForwardsSummary) Reset()         { *m = ForwardsSummary{} }
+func (m *ForwardsSummary) String() string { return proto.CompactTextString(m) }
+func (*ForwardsSummary) ProtoMessage()    {}
+func (*ForwardsSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{17}
+}
+func (m *ForwardsSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardsSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardsSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardsSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardsSummary.Merge(m, src)
+}
+func (m *ForwardsSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardsSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardsSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardsSummary proto.InternalMessageInfo
+
+func (m *ForwardsSummary) GetNumOfAccounts() uint64 {
+	if m != nil {
+		return m.NumOfAccounts
+	}
+	return 0
+}
+
+func (m *ForwardsSummary) GetNumOfExecuted() uint64 {
+	if m != nil {
+		return m.NumOfExecuted
+	}
+	return 0
+}
+
+func (m *ForwardsSummary) GetNumOfFailed() uint64 {
+	if m != nil {
+		return m.NumOfFailed
+	}
+	return 0
+}
+
+func (m *ForwardsSummary) GetNumOfSkipped() uint64 {
+	if m != nil {
+		return m.NumOfSkipped
+	}
+	return 0
+}
+
+// AccountQueued is emitted whenever a forwarding account is queued for forwarding at the end of the block.
+type AccountQueued struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that funds will be forwarded through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// trigger is the action that caused the account to be queued.
+	Trigger QueueTrigger `protobuf:"varint,3,opt,name=trigger,proto3,enum=noble.forwarding.v1.QueueTrigger" json:"trigger,omitempty"`
+	// depositor is the address that sent funds to the account, if known.
+	Depositor string `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *AccountQueued) Reset()         { *m = AccountQueued{} }
+func (m *AccountQueued) String() string { return proto.CompactTextString(m) }
+func (*AccountQueued) ProtoMessage()    {}
+func (*AccountQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{18}
+}
+func (m *AccountQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountQueued.Merge(m, src)
+}
+func (m *AccountQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountQueued proto.InternalMessageInfo
+
+func (m *AccountQueued) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountQueued) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *AccountQueued) GetTrigger() QueueTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return QUEUE_TRIGGER_UNSPECIFIED
+}
+
+func (m *AccountQueued) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("noble.forwarding.v1.QueueTrigger", QueueTrigger_name, QueueTrigger_value)
+	proto.RegisterType((*AccountRegistered)(nil), "noble.forwarding.v1.AccountRegistered")
+	proto.RegisterType((*AccountCleared)(nil), "noble.forwarding.v1.AccountCleared")
+	proto.RegisterType((*AllowedDenomsConfigured)(nil), "noble.forwarding.v1.AllowedDenomsConfigured")
+	proto.RegisterType((*MemoSet)(nil), "noble.forwarding.v1.MemoSet")
+	proto.RegisterType((*HistoryRetentionConfigured)(nil), "noble.forwarding.v1.HistoryRetentionConfigured")
+	proto.RegisterType((*StatsRetentionConfigured)(nil), "noble.forwarding.v1.StatsRetentionConfigured")
+	proto.RegisterType((*ChainChannelConfigured)(nil), "noble.forwarding.v1.ChainChannelConfigured")
+	proto.RegisterType((*RegistrationModeConfigured)(nil), "noble.forwarding.v1.RegistrationModeConfigured")
+	proto.RegisterType((*ForwardModeConfigured)(nil), "noble.forwarding.v1.ForwardModeConfigured")
+	proto.RegisterType((*SignerlessFeeConfigured)(nil), "noble.forwarding.v1.SignerlessFeeConfigured")
+	proto.RegisterType((*RegistrationFeeConfigured)(nil), "noble.forwarding.v1.RegistrationFeeConfigured")
+	proto.RegisterType((*RegistrationFeePaid)(nil), "noble.forwarding.v1.RegistrationFeePaid")
+	proto.RegisterType((*RegistrationFailed)(nil), "noble.forwarding.v1.RegistrationFailed")
+	proto.RegisterType((*ForwardRefunded)(nil), "noble.forwarding.v1.ForwardRefunded")
+	proto.RegisterType((*ForwardExecuted)(nil), "noble.forwarding.v1.ForwardExecuted")
+	proto.RegisterType((*ForwardFailed)(nil), "noble.forwarding.v1.ForwardFailed")
+	proto.RegisterType((*ForwardSkipped)(nil), "noble.forwarding.v1.ForwardSkipped")
+	proto.RegisterType((*ForwardsSummary)(nil), "noble.forwarding.v1.ForwardsSummary")
+	proto.RegisterType((*AccountQueued)(nil), "noble.forwarding.v1.AccountQueued")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/events.proto", fileDescriptor_f58759da7cd78060) }
+
+var fileDescriptor_f58759da7cd78060 = []byte{
+	// 1083 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0x2d, 0xc7, 0x4d, 0x9b, 0x97, 0xd8, 0x71, 0xd4, 0xd2, 0x38, 0x06, 0x4c, 0xaa, 0xa1,
+	0x50, 0xda, 0xa9, 0x3d, 0x09, 0x47, 0xb8, 0x38, 0x8e, 0x92, 0x18, 0x9a, 0xb4, 0x95, 0x13, 0x0e,
+	0x5c, 0x3c, 0xb2, 0xf4, 0xec, 0xec, 0xc4, 0xda, 0x35, 0xbb, 0x92, 0xdb, 0x1c, 0x39, 0xc1, 0x91,
+	0x23, 0x27, 0x2e, 0xbd, 0xc0, 0xf4, 0x00, 0xc3, 0xa5, 0x1f, 0x81, 0x1e, 0xcb, 0x8d, 0x13, 0xc3,
+	0x24, 0x07, 0xbe, 0x06, 0xa3, 0xd5, 0x4a, 0x96, 0x5d, 0x4f, 0x98, 0x84, 0x49, 0x87, 0x4b, 0x46,
+	0x6f, 0xdf, 0xff, 0xbd, 0xf7, 0xd3, 0xdb, 0x97, 0x5d, 0x19, 0x56, 0x29, 0xeb, 0xf4, 0xb1, 0xd6,
+	0x65, 0xfc, 0x89, 0xcd, 0x5d, 0x42, 0x7b, 0xb5, 0xe1, 0x5a, 0x0d, 0x87, 0x48, 0x7d, 0x51, 0x1d,
+	0x70, 0xe6, 0x33, 0xfd, 0xba, 0x54, 0x54, 0x47, 0x8a, 0xea, 0x70, 0xad, 0xbc, 0x64, 0x7b, 0x84,
+	0xb2, 0x9a, 0xfc, 0x1b, 0xe9, 0xca, 0x15, 0x87, 0x09, 0x8f, 0x89, 0x5a, 0xc7, 0x16, 0x58, 0x1b,
+	0xae, 0x75, 0xd0, 0xb7, 0xd7, 0x6a, 0x0e, 0x23, 0x54, 0xf9, 0x6f, 0xf4, 0x58, 0x8f, 0xc9, 0xc7,
+	0x5a, 0xf8, 0xa4, 0x56, 0x6f, 0x4d, 0xab, 0x6f, 0x3b, 0x0e, 0x0b, 0xa8, 0xaf, 0x24, 0x53, 0x11,
+	0x07, 0xb6, 0x73, 0x84, 0x4a, 0x61, 0x7c, 0xad, 0xc1, 0x52, 0x3d, 0x8a, 0xb1, 0xb0, 0x47, 0x84,
+	0x8f, 0x1c, 0x5d, 0xbd, 0x04, 0x57, 0x6d, 0xd7, 0xe5, 0x28, 0x44, 0x49, 0x5b, 0xd5, 0xee, 0xcc,
+	0x59, 0xb1, 0x19, 0x7a, 0x9c, 0x43, 0x9b, 0x52, 0xec, 0x97, 0xb2, 0x91, 0x47, 0x99, 0xfa, 0x3b,
+	0x30, 0xc7, 0xd1, 0x21, 0x03, 0x82, 0xd4, 0x2f, 0xcd, 0x48, 0xdf, 0x68, 0x41, 0x2f, 0xc3, 0xb5,
+	0xae, 0xdd, 0xef, 0x77, 0x6c, 0xe7, 0xa8, 0x94, 0x93, 0xce, 0xc4, 0x36, 0x76, 0xa0, 0xa0, 0x10,
+	0x1a, 0x7d, 0xb4, 0xcf, 0xae, 0x3f, 0x56, 0x25, 0x3b, 0x51, 0xc5, 0x20, 0xb0, 0x5c, 0xef, 0xf7,
+	0xd9, 0x13, 0x74, 0x37, 0x91, 0x32, 0x4f, 0x34, 0x18, 0xed, 0x92, 0x5e, 0x10, 0xa6, 0xfc, 0x10,
+	0x16, 0x07, 0x1c, 0x87, 0x84, 0x05, 0xa2, 0xed, 0x4a, 0x67, 0x49, 0x5b, 0x9d, 0xb9, 0x33, 0x67,
+	0x15, 0xe2, 0xe5, 0x28, 0x44, 0xbf, 0x0d, 0x05, 0x27, 0xe0, 0x1c, 0xa9, 0x1f, 0xeb, 0xb2, 0x52,
+	0x97, 0x57, 0xab, 0x91, 0xcc, 0xd8, 0x85, 0xab, 0xbb, 0xe8, 0xb1, 0x16, 0xfa, 0x67, 0xd0, 0xde,
+	0x80, 0x2b, 0x32, 0x87, 0x22, 0x8d, 0x0c, 0x5d, 0x87, 0x9c, 0x87, 0x1e, 0x53, 0x4d, 0x92, 0xcf,
+	0xc6, 0x53, 0x28, 0xef, 0x10, 0xe1, 0x33, 0x7e, 0x6c, 0xa1, 0x8f, 0xd4, 0x27, 0x8c, 0xa6, 0xe0,
+	0xef, 0x83, 0x9e, 0xc0, 0xf3, 0xd8, 0x2f, 0x8b, 0xe5, 0xac, 0xa5, 0xd8, 0x93, 0x04, 0xea, 0xf7,
+	0x60, 0x29, 0x7e, 0x85, 0x91, 0x3a, 0x2b, 0xd5, 0x45, 0xe5, 0x48, 0xc4, 0xc6, 0x10, 0x4a, 0x2d,
+	0xdf, 0xf6, 0xc5, 0x9b, 0xae, 0xfb, 0x8d, 0x06, 0x37, 0x1b, 0x87, 0x36, 0xa1, 0x8d, 0x68, 0x80,
+	0x52, 0x65, 0x57, 0xe0, 0x9a, 0x13, 0x7a, 0xda, 0xc4, 0x8d, 0x3b, 0x2a, 0xed, 0xa6, 0xab, 0x7f,
+	0x04, 0xc5, 0x84, 0x68, 0x7c, 0x10, 0x93, 0xed, 0x55, 0xf9, 0xc2, 0x1d, 0x8f, 0x69, 0x62, 0x65,
+	0xd4, 0xf1, 0x78, 0x7f, 0x95, 0xd0, 0xf8, 0x55, 0x83, 0x72, 0x34, 0xfc, 0xdc, 0x0e, 0xd1, 0x76,
+	0x99, 0x8b, 0x29, 0x9a, 0xcf, 0x20, 0x9f, 0x94, 0xf4, 0x98, 0x8b, 0x12, 0xa9, 0xb0, 0x7e, 0xbb,
+	0x3a, 0xe5, 0xbf, 0xbb, 0x3a, 0x99, 0xc7, 0x5a, 0x88, 0x63, 0x43, 0x4b, 0xdf, 0x81, 0x85, 0x98,
+	0x49, 0xa6, 0xca, 0x9e, 0x27, 0xd5, 0xbc, 0x0a, 0x0d, 0x0d, 0xe3, 0x99, 0x06, 0x6f, 0x6d, 0x45,
+	0xfa, 0x09, 0x5e, 0x73, 0x3a, 0xef, 0xea, 0xd4, 0x22, 0xa9, 0x14, 0x13, 0xa8, 0x8d, 0xa9, 0xa8,
+	0xff, 0x9e, 0x65, 0x8c, 0xf2, 0x27, 0x0d, 0x96, 0x5b, 0xa4, 0x47, 0x91, 0xf7, 0x51, 0x88, 0x2d,
+	0x4c, 0x73, 0x6e, 0x43, 0x52, 0xb0, 0xdd, 0xc5, 0x08, 0x73, 0x7e, 0x7d, 0xa5, 0x1a, 0x1d, 0x86,
+	0xd5, 0xf0, 0x30, 0xac, 0xaa, 0xc3, 0xb0, 0xda, 0x60, 0x84, 0x6e, 0xcc, 0xbd, 0xfc, 0xf3, 0xbd,
+	0xcc, 0x8f, 0x7f, 0xff, 0x72, 0x57, 0xb3, 0xe6, 0xe3, 0xc8, 0x2d, 0x44, 0xdd, 0x84, 0xb8, 0xa6,
+	0xcc, 0x93, 0x3d, 0x47, 0x1e, 0x50, 0x81, 0x5b, 0x88, 0xc6, 0x73, 0x0d, 0x56, 0xd2, 0x3d, 0xff,
+	0x7f, 0xd3, 0xfe, 0xa6, 0xc1, 0xf5, 0x09, 0xda, 0x47, 0x36, 0x39, 0xeb, 0xe8, 0xfc, 0x14, 0x66,
+	0x6d, 0x2f, 0x3c, 0x65, 0xcf, 0x55, 0x53, 0xc5, 0xe8, 0x1b, 0xe1, 0xc1, 0xeb, 0xd9, 0x84, 0x12,
+	0xda, 0x2b, 0xcd, 0x9c, 0x23, 0xc1, 0x28, 0x2c, 0x3c, 0x0e, 0x07, 0xf6, 0x31, 0x72, 0x75, 0x03,
+	0x44, 0x86, 0xf1, 0xbd, 0x06, 0xfa, 0xd8, 0x9b, 0xd8, 0xa4, 0xff, 0xa6, 0xef, 0xa0, 0x10, 0x0d,
+	0x39, 0x67, 0xbc, 0x74, 0x25, 0x42, 0x93, 0x86, 0xf1, 0x42, 0x83, 0x45, 0x35, 0xdb, 0x16, 0x76,
+	0x03, 0xea, 0x5e, 0x90, 0xab, 0x0c, 0xd7, 0x04, 0x7e, 0x15, 0x20, 0x75, 0x50, 0x62, 0xe5, 0xac,
+	0xc4, 0x4e, 0x6d, 0x4b, 0xee, 0x02, 0xdb, 0x72, 0x13, 0x66, 0x39, 0xda, 0x82, 0x51, 0x05, 0xae,
+	0x2c, 0xe3, 0xf7, 0x11, 0xb9, 0xf9, 0x14, 0x9d, 0xc0, 0xbf, 0x94, 0x8e, 0xfe, 0x37, 0xf6, 0x74,
+	0x57, 0xae, 0x4c, 0x74, 0x25, 0xbe, 0x23, 0x67, 0x53, 0x77, 0xe4, 0x0b, 0x0d, 0xf2, 0xea, 0x9d,
+	0x2e, 0x6d, 0x46, 0x2e, 0x67, 0x37, 0x38, 0x14, 0x14, 0x78, 0xeb, 0x88, 0x0c, 0x06, 0x17, 0x24,
+	0x4f, 0xbe, 0x26, 0x66, 0xd2, 0x5f, 0x13, 0xa3, 0x9a, 0xb9, 0xb1, 0x9a, 0xcf, 0x47, 0x13, 0x20,
+	0x5a, 0x81, 0xe7, 0xd9, 0xfc, 0x58, 0xff, 0x00, 0x16, 0x69, 0xe0, 0xb5, 0x59, 0xb7, 0xad, 0xbe,
+	0x13, 0x85, 0xba, 0xcc, 0xf3, 0x34, 0xf0, 0x1e, 0x76, 0xd5, 0x57, 0x98, 0x48, 0xe9, 0x50, 0x0d,
+	0x4f, 0x29, 0x9b, 0xd2, 0x25, 0x13, 0x65, 0x40, 0x5e, 0xe9, 0xba, 0x72, 0x43, 0xd4, 0x70, 0xcf,
+	0x4b, 0x95, 0xda, 0xa3, 0xf7, 0xa1, 0xa0, 0x34, 0x22, 0x7a, 0x77, 0xc9, 0x99, 0xb3, 0x16, 0xa4,
+	0x48, 0xf5, 0xc3, 0xf8, 0x41, 0x83, 0xbc, 0x2a, 0xff, 0x38, 0xc0, 0xe0, 0x82, 0x1d, 0xfa, 0x04,
+	0xae, 0xfa, 0x9c, 0xf4, 0x7a, 0xc8, 0x25, 0x49, 0x61, 0xfd, 0xd6, 0xd4, 0xeb, 0x4a, 0x56, 0xd8,
+	0x8f, 0x84, 0x56, 0x1c, 0x11, 0x0e, 0x86, 0x8b, 0x03, 0x26, 0x88, 0xcf, 0xe2, 0x13, 0x6a, 0xb4,
+	0x70, 0xf7, 0x67, 0x0d, 0x16, 0xd2, 0x71, 0xfa, 0xbb, 0xb0, 0xf2, 0xf8, 0xc0, 0x3c, 0x30, 0xdb,
+	0xfb, 0x56, 0x73, 0x7b, 0xdb, 0xb4, 0xda, 0x07, 0x7b, 0xad, 0x47, 0x66, 0xa3, 0xb9, 0xd5, 0x34,
+	0x37, 0x8b, 0x19, 0xfd, 0x6d, 0x58, 0x1e, 0x77, 0x6f, 0xd4, 0xf7, 0x3e, 0x6f, 0xb7, 0xcc, 0xbd,
+	0xcd, 0xa2, 0xf6, 0x7a, 0x6c, 0x73, 0xa3, 0xd1, 0xb6, 0xcc, 0x86, 0xd9, 0xfc, 0xc2, 0x2c, 0x66,
+	0xf5, 0x0a, 0x94, 0xc7, 0xdd, 0xbb, 0xf5, 0xbd, 0x83, 0xfa, 0x83, 0x76, 0xe3, 0x81, 0x59, 0xb7,
+	0x8a, 0x33, 0xaf, 0xfb, 0x2d, 0x73, 0xbb, 0xd9, 0xda, 0xb7, 0xea, 0xfb, 0xcd, 0x87, 0x7b, 0xc5,
+	0x5c, 0x39, 0xf7, 0xed, 0xb3, 0x4a, 0x66, 0xc3, 0x7c, 0x79, 0x52, 0xd1, 0x5e, 0x9d, 0x54, 0xb4,
+	0xbf, 0x4e, 0x2a, 0xda, 0x77, 0xa7, 0x95, 0xcc, 0xab, 0xd3, 0x4a, 0xe6, 0x8f, 0xd3, 0x4a, 0xe6,
+	0xcb, 0x7b, 0x3d, 0xe2, 0x1f, 0x06, 0x9d, 0xaa, 0xc3, 0xbc, 0x9a, 0xec, 0xcf, 0x7d, 0x5b, 0x08,
+	0xf4, 0xc5, 0xd8, 0x0f, 0x85, 0xf5, 0x9a, 0x7f, 0x3c, 0x40, 0xd1, 0x99, 0x95, 0x3f, 0x14, 0x3e,
+	0xfe, 0x67, 0x00, 0x89, 0x25, 0x4b, 0x62, 0xef, 0x0c, 0x00, 0x00,
+}
+
+func (m *AccountRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedDenomsConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedDenomsConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedDenomsConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentDenoms) > 0 {
+		for iNdEx := len(m.CurrentDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentDenoms[iNdEx])
+			copy(dAtA[i:], m.CurrentDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PreviousDenoms) > 0 {
+		for iNdEx := len(m.PreviousDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousDenoms[iNdEx])
+			copy(dAtA[i:], m.PreviousDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemoSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryRetentionConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRetentionConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryRetentionConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentRetention != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentRetention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousRetention != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatsRetentionConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsRetentionConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatsRetentionConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentRetention != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentRetention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousRetention != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainChannelConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainChannelConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainChannelConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentChannel) > 0 {
+		i -= len(m.CurrentChannel)
+		copy(dAtA[i:], m.CurrentChannel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousChannel) > 0 {
+		i -= len(m.PreviousChannel)
+		copy(dAtA[i:], m.PreviousChannel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationModeConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationModeConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationModeConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardModeConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardModeConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardModeConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerlessFeeConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerlessFeeConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerlessFeeConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PreviousFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationFeeConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationFeeConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationFeeConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PreviousFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationFeePaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationFeePaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationFeePaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardSkipped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardSkipped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardSkipped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardsSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardsSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfSkipped != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfSkipped))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumOfFailed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfFailed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumOfExecuted != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfExecuted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumOfAccounts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfAccounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Trigger != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *AllowedDenomsConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreviousDenoms) > 0 {
+		for _, s := range m.PreviousDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CurrentDenoms) > 0 {
+		for _, s := range m.CurrentDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemoSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryRetentionConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousRetention != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousRetention))
+	}
+	if m.CurrentRetention != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentRetention))
+	}
+	return n
+}
+
+func (m *StatsRetentionConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousRetention != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousRetention))
+	}
+	if m.CurrentRetention != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentRetention))
+	}
+	return n
+}
+
+func (m *ChainChannelConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousChannel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CurrentChannel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *RegistrationModeConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousMode != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousMode))
+	}
+	if m.CurrentMode != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentMode))
+	}
+	return n
+}
+
+func (m *ForwardModeConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousMode != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousMode))
+	}
+	if m.CurrentMode != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentMode))
+	}
+	return n
+}
+
+func (m *SignerlessFeeConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PreviousFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *RegistrationFeeConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PreviousFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *RegistrationFeePaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *RegistrationFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardSkipped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardsSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfAccounts != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfAccounts))
+	}
+	if m.NumOfExecuted != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfExecuted))
+	}
+	if m.NumOfFailed != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfFailed))
+	}
+	if m.NumOfSkipped != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfSkipped))
+	}
+	return n
+}
+
+func (m *AccountQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Trigger != 0 {
+		n += 1 + sovEvents(uint64(m.Trigger))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountCleared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountCleared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedDenomsConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedDenomsConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousDenoms = append(m.PreviousDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentDenoms = append(m.CurrentDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryRetentionConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryRetentionConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryRetentionConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRetention", wireType)
+			}
+			m.PreviousRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRetention", wireType)
+			}
+			m.CurrentRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatsRetentionConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsRetentionConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsRetentionConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRetention", wireType)
+			}
+			m.PreviousRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRetention", wireType)
+			}
+			m.CurrentRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainChannelConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainChannelConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainChannelConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationModeConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationModeConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationModeConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMode", wireType)
+			}
+			m.PreviousMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousMode |= RegistrationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMode", wireType)
+			}
+			m.CurrentMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentMode |= RegistrationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardModeConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardModeConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardModeConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMode", wireType)
+			}
+			m.PreviousMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousMode |= ForwardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMode", wireType)
+			}
+			m.CurrentMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentMode |= ForwardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerlessFeeConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerlessFeeConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerlessFeeConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationFeeConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationFeeConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationFeeConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationFeePaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationFeePaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationFeePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardRefunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardRefunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MemoSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
+func (m *ForwardFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -600,10 +4851,10 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountRegistered: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -704,7 +4955,40 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -732,7 +5016,7 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fallback = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -755,7 +5039,7 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountCleared) Unmarshal(dAtA []byte) error {
+func (m *ForwardSkipped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -778,10 +5062,10 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountCleared: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardSkipped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardSkipped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -818,7 +5102,7 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -846,7 +5130,71 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -869,7 +5217,7 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
+func (m *ForwardsSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -892,17 +5240,17 @@ func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedDenomsConfigured: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardsSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedDenomsConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardsSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDenoms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
 			}
-			var stringLen uint64
+			m.NumOfAccounts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -912,29 +5260,16 @@ func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumOfAccounts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousDenoms = append(m.PreviousDenoms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDenoms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfExecuted", wireType)
 			}
-			var stringLen uint64
+			m.NumOfExecuted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -944,24 +5279,49 @@ func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumOfExecuted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfFailed", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.NumOfFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfSkipped", wireType)
+			}
+			m.NumOfSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfSkipped |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.CurrentDenoms = append(m.CurrentDenoms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -983,7 +5343,7 @@ func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoSet) Unmarshal(dAtA []byte) error {
+func (m *AccountQueued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,10 +5366,10 @@ func (m *MemoSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountQueued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountQueued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1046,7 +5406,7 @@ func (m *MemoSet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1074,11 +5434,30 @@ func (m *MemoSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			m.Trigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trigger |= QueueTrigger(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1106,7 +5485,7 @@ func (m *MemoSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23,13 +23,30 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 )
 
+// DefaultHistoryRetention is the default number of forward history records
+// kept per forwarding account.
+const DefaultHistoryRetention uint64 = 20
+
+// DefaultStatsRetention is the default number of days of time-bucketed
+// forwarding statistics kept.
+const DefaultStatsRetention uint64 = 90
+
+// SecondsPerDay is the width of a single time-bucketed statistics entry.
+const SecondsPerDay uint64 = 24 * 60 * 60
+
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		HistoryRetention: DefaultHistoryRetention,
+		StatsRetention:   DefaultStatsRetention,
+		RegistrationMode: REGISTRATION_MODE_STRICT,
+		ForwardMode:      FORWARD_MODE_END_BLOCK,
+	}
 }
 
 func (gen *GenesisState) Validate() error {
@@ -59,6 +76,124 @@ func (gen *GenesisState) Validate() error {
 		}
 	}
 
+	for address, stats := range gen.AccountStats {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errors.New("invalid account address")
+		}
+
+		if err := stats.TotalForwarded.Validate(); err != nil {
+			return errors.New("invalid coins")
+		}
+	}
+
+	for _, bucket := range gen.StatsBuckets {
+		if !channeltypes.IsValidChannelID(bucket.Channel) {
+			return errors.New("invalid channel")
+		}
+
+		if err := sdk.ValidateDenom(bucket.Denom); err != nil {
+			return fmt.Errorf("denom %s is invalid: %w", bucket.Denom, err)
+		}
+
+		if bucket.TotalForwarded.IsNil() || bucket.TotalForwarded.IsNegative() {
+			return errors.New("invalid total forwarded")
+		}
+	}
+
+	for chainID, channel := range gen.ChainChannels {
+		if chainID == "" {
+			return errors.New("invalid chain id")
+		}
+
+		if !channeltypes.IsValidChannelID(channel) {
+			return errors.New("invalid channel")
+		}
+	}
+
+	if err := ValidateRegistrationMode(gen.RegistrationMode); err != nil {
+		return err
+	}
+
+	for channel := range gen.NumOfRegistrationFailures {
+		if !channeltypes.IsValidChannelID(channel) {
+			return errors.New("invalid channel")
+		}
+	}
+
+	for _, packet := range gen.InFlightPackets {
+		if _, err := sdk.AccAddressFromBech32(packet.Address); err != nil {
+			return errors.New("invalid account address")
+		}
+
+		if !channeltypes.IsValidChannelID(packet.ForwardChannel) || !channeltypes.IsValidChannelID(packet.DestinationChannel) {
+			return errors.New("invalid channel")
+		}
+
+		if err := packet.Amount.Validate(); err != nil {
+			return errors.New("invalid coins")
+		}
+
+		if !packet.RegistrationFee.IsNil() && packet.RegistrationFee.Validate() != nil {
+			return errors.New("invalid coins")
+		}
+	}
+
+	if err := ValidateForwardMode(gen.ForwardMode); err != nil {
+		return err
+	}
+
+	if !gen.SignerlessFee.IsNil() && !gen.SignerlessFee.IsZero() {
+		if err := gen.SignerlessFee.Validate(); err != nil {
+			return fmt.Errorf("invalid signerless fee: %w", err)
+		}
+
+		if !slices.Contains(gen.AllowedDenoms, gen.SignerlessFee.Denom) {
+			return fmt.Errorf("signerless fee denom %s is not allowed", gen.SignerlessFee.Denom)
+		}
+	}
+
+	if !gen.RegistrationFee.IsNil() && !gen.RegistrationFee.IsZero() {
+		if err := gen.RegistrationFee.Validate(); err != nil {
+			return fmt.Errorf("invalid registration fee: %w", err)
+		}
+
+		if !slices.Contains(gen.AllowedDenoms, gen.RegistrationFee.Denom) {
+			return fmt.Errorf("registration fee denom %s is not allowed", gen.RegistrationFee.Denom)
+		}
+	}
+
+	for address, fee := range gen.UnpaidRegistrationFees {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errors.New("invalid account address")
+		}
+
+		if err := fee.Validate(); err != nil || !fee.IsPositive() {
+			return errors.New("invalid unpaid registration fee")
+		}
+	}
+
+	for address := range gen.HistorySequence {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errors.New("invalid account address")
+		}
+	}
+
+	for _, entry := range gen.ForwardRecords {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return errors.New("invalid account address")
+		}
+
+		// NOTE: Records at or beyond the history sequence of their account
+		// would be overwritten by its next forward.
+		if entry.Index >= gen.HistorySequence[entry.Address] {
+			return errors.New("forward record index must be below the history sequence")
+		}
+
+		if err := entry.Record.Amount.Validate(); err != nil {
+			return errors.New("invalid coins")
+		}
+	}
+
 	return nil
 }
 
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,10 +25,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	AllowedDenoms  []string          `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
-	NumOfAccounts  map[string]uint64 `protobuf:"bytes,2,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	NumOfForwards  map[string]uint64 `protobuf:"bytes,3,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	TotalForwarded map[string]string `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AllowedDenoms             []string                `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	NumOfAccounts             map[string]uint64       `protobuf:"bytes,2,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfForwards             map[string]uint64       `protobuf:"bytes,3,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TotalForwarded            map[string]string       `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistoryRetention          uint64                  `protobuf:"varint,5,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	AccountStats              map[string]AccountStats `protobuf:"bytes,6,rep,name=account_stats,json=accountStats,proto3" json:"account_stats" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StatsRetention            uint64                  `protobuf:"varint,7,opt,name=stats_retention,json=statsRetention,proto3" json:"stats_retention,omitempty"`
+	StatsBuckets              []StatsBucket           `protobuf:"bytes,8,rep,name=stats_buckets,json=statsBuckets,proto3" json:"stats_buckets"`
+	ChainChannels             map[string]string       `protobuf:"bytes,9,rep,name=chain_channels,json=chainChannels,proto3" json:"chain_channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RegistrationMode          RegistrationMode        `protobuf:"varint,10,opt,name=registration_mode,json=registrationMode,proto3,enum=noble.forwarding.v1.RegistrationMode" json:"registration_mode,omitempty"`
+	NumOfRegistrationFailures map[string]uint64       `protobuf:"bytes,11,rep,name=num_of_registration_failures,json=numOfRegistrationFailures,proto3" json:"num_of_registration_failures,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	InFlightPackets           []InFlightPacket        `protobuf:"bytes,12,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
+	ForwardMode               ForwardMode             `protobuf:"varint,13,opt,name=forward_mode,json=forwardMode,proto3,enum=noble.forwarding.v1.ForwardMode" json:"forward_mode,omitempty"`
+	SignerlessFee             types.Coin              `protobuf:"bytes,14,opt,name=signerless_fee,json=signerlessFee,proto3" json:"signerless_fee"`
+	RegistrationFee           types.Coin              `protobuf:"bytes,15,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee"`
+	UnpaidRegistrationFees    map[string]types.Coin   `protobuf:"bytes,16,rep,name=unpaid_registration_fees,json=unpaidRegistrationFees,proto3" json:"unpaid_registration_fees" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySequence           map[string]uint64       `protobuf:"bytes,17,rep,name=history_sequence,json=historySequence,proto3" json:"history_sequence,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ForwardRecords            []ForwardRecordEntry    `protobuf:"bytes,18,rep,name=forward_records,json=forwardRecords,proto3" json:"forward_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,39 +106,174 @@ func (m *GenesisState) GetTotalForwarded() map[string]string {
 	return nil
 }
 
+func (m *GenesisState) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAccountStats() map[string]AccountStats {
+	if m != nil {
+		return m.AccountStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStatsRetention() uint64 {
+	if m != nil {
+		return m.StatsRetention
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStatsBuckets() []StatsBucket {
+	if m != nil {
+		return m.StatsBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainChannels() map[string]string {
+	if m != nil {
+		return m.ChainChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegistrationMode() RegistrationMode {
+	if m != nil {
+		return m.RegistrationMode
+	}
+	return REGISTRATION_MODE_UNSPECIFIED
+}
+
+func (m *GenesisState) GetNumOfRegistrationFailures() map[string]uint64 {
+	if m != nil {
+		return m.NumOfRegistrationFailures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInFlightPackets() []InFlightPacket {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForwardMode() ForwardMode {
+	if m != nil {
+		return m.ForwardMode
+	}
+	return FORWARD_MODE_UNSPECIFIED
+}
+
+func (m *GenesisState) GetSignerlessFee() types.Coin {
+	if m != nil {
+		return m.SignerlessFee
+	}
+	return types.Coin{}
+}
+
+func (m *GenesisState) GetRegistrationFee() types.Coin {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return types.Coin{}
+}
+
+func (m *GenesisState) GetUnpaidRegistrationFees() map[string]types.Coin {
+	if m != nil {
+		return m.UnpaidRegistrationFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistorySequence() map[string]uint64 {
+	if m != nil {
+		return m.HistorySequence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForwardRecords() []ForwardRecordEntry {
+	if m != nil {
+		return m.ForwardRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.forwarding.v1.GenesisState")
+	proto.RegisterMapType((map[string]AccountStats)(nil), "noble.forwarding.v1.GenesisState.AccountStatsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "noble.forwarding.v1.GenesisState.ChainChannelsEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.GenesisState.HistorySequenceEntry")
 	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.GenesisState.NumOfAccountsEntry")
 	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.GenesisState.NumOfForwardsEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.GenesisState.NumOfRegistrationFailuresEntry")
 	proto.RegisterMapType((map[string]string)(nil), "noble.forwarding.v1.GenesisState.TotalForwardedEntry")
+	proto.RegisterMapType((map[string]types.Coin)(nil), "noble.forwarding.v1.GenesisState.UnpaidRegistrationFeesEntry")
 }
 
 func init() { proto.RegisterFile("noble/forwarding/v1/genesis.proto", fileDescriptor_672c6f172b8b6a10) }
 
 var fileDescriptor_672c6f172b8b6a10 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x4f, 0xcb, 0x2f, 0x2a, 0x4f, 0x2c, 0x4a, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06,
-	0x2b, 0xd1, 0x43, 0x28, 0xd1, 0x2b, 0x33, 0x54, 0x9a, 0xc1, 0xc2, 0xc5, 0xe3, 0x0e, 0x51, 0x16,
-	0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4, 0xca, 0xc5, 0x97, 0x98, 0x93, 0x93, 0x5f, 0x9e, 0x9a, 0x12,
-	0x9f, 0x92, 0x9a, 0x97, 0x9f, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x19, 0xc4, 0x0b, 0x15,
-	0x75, 0x01, 0x0b, 0x0a, 0xc5, 0x70, 0xf1, 0xe7, 0x95, 0xe6, 0xc6, 0xe7, 0xa7, 0xc5, 0x27, 0x26,
-	0x27, 0xe7, 0x97, 0xe6, 0x95, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x99, 0xe8, 0x61,
-	0xb1, 0x46, 0x0f, 0xd9, 0x0a, 0x3d, 0xbf, 0xd2, 0x5c, 0xff, 0x34, 0x47, 0xa8, 0x36, 0xd7, 0xbc,
-	0x92, 0xa2, 0xca, 0x20, 0xde, 0x3c, 0x64, 0x31, 0x24, 0xd3, 0xa1, 0xc6, 0x14, 0x4b, 0x30, 0x93,
-	0x64, 0xba, 0x1b, 0x54, 0x1b, 0xb2, 0xe9, 0x30, 0x31, 0xa1, 0x38, 0x2e, 0xfe, 0x92, 0xfc, 0x92,
-	0xc4, 0x1c, 0x98, 0xe1, 0xa9, 0x29, 0x12, 0x2c, 0x60, 0xd3, 0x4d, 0x09, 0x9b, 0x1e, 0x02, 0xd2,
-	0xe8, 0x06, 0xd3, 0x07, 0x31, 0x9e, 0xaf, 0x04, 0x45, 0x50, 0xca, 0x81, 0x4b, 0x08, 0xd3, 0x8b,
-	0x42, 0x02, 0x5c, 0xcc, 0xd9, 0xa9, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20, 0xa6,
-	0x90, 0x08, 0x17, 0x6b, 0x59, 0x62, 0x4e, 0x69, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b, 0x10,
-	0x84, 0x63, 0xc5, 0x64, 0xc1, 0x08, 0x37, 0x01, 0xc5, 0x1b, 0x24, 0x99, 0xe0, 0xc8, 0x25, 0x8c,
-	0xc5, 0xa9, 0x84, 0x8c, 0xe0, 0x44, 0x32, 0xc2, 0xc9, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xc1, 0x21, 0xa6, 0x9b, 0x58, 0x5c, 0x9c, 0x5a, 0x52, 0x8c, 0x92, 0xfc, 0x8c, 0xf4, 0x4b, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xa9, 0xcf, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xd4,
-	0x7d, 0x19, 0xa2, 0x02, 0x00, 0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x4b, 0x6f, 0xdc, 0x36,
+	0x10, 0x80, 0x2d, 0xdb, 0x49, 0x6b, 0xae, 0xf7, 0xc5, 0x18, 0x05, 0xe3, 0x16, 0x8a, 0xd2, 0x22,
+	0xc8, 0x02, 0x41, 0x25, 0x78, 0xd3, 0x17, 0x0a, 0x14, 0x48, 0xec, 0x66, 0xfb, 0x7e, 0x40, 0x69,
+	0x7a, 0x68, 0x8b, 0x08, 0x5c, 0x69, 0xa4, 0x15, 0xa2, 0x25, 0x5d, 0x91, 0x72, 0xe0, 0x43, 0x0f,
+	0xfd, 0x07, 0xfd, 0x59, 0x39, 0xe6, 0x98, 0x53, 0x51, 0xd8, 0x7f, 0xa4, 0x10, 0x45, 0x65, 0x29,
+	0x5b, 0xd9, 0xf5, 0xde, 0xc4, 0xe1, 0xcc, 0x37, 0x4f, 0x0e, 0x84, 0x6e, 0x33, 0x3e, 0xcd, 0xc0,
+	0x8b, 0x79, 0xfe, 0x9c, 0xe6, 0x51, 0xca, 0x12, 0xef, 0xe4, 0xc0, 0x4b, 0x80, 0x81, 0x48, 0x85,
+	0x7b, 0x9c, 0x73, 0xc9, 0xf1, 0x0d, 0xa5, 0xe2, 0x2e, 0x54, 0xdc, 0x93, 0x83, 0x7d, 0x3b, 0xe4,
+	0x62, 0xce, 0x85, 0x37, 0xa5, 0x02, 0xbc, 0x93, 0x83, 0x29, 0x48, 0x7a, 0xe0, 0x85, 0x3c, 0x65,
+	0x95, 0xd1, 0xfe, 0x5e, 0xc2, 0x13, 0xae, 0x3e, 0xbd, 0xf2, 0x4b, 0x4b, 0x5b, 0xbd, 0xd1, 0x30,
+	0xe4, 0x05, 0x93, 0xcb, 0x54, 0x66, 0xa9, 0x90, 0x3c, 0x3f, 0xd5, 0x2a, 0x4e, 0x9b, 0xca, 0x31,
+	0x0d, 0x9f, 0x41, 0x0d, 0xb9, 0xd5, 0xa6, 0x21, 0x24, 0x95, 0x3a, 0xa7, 0xf7, 0x5f, 0x0d, 0xd0,
+	0xee, 0x57, 0x55, 0x96, 0x8f, 0x25, 0x95, 0x80, 0xef, 0xa0, 0x1e, 0xcd, 0x32, 0xfe, 0x1c, 0xa2,
+	0x20, 0x02, 0xc6, 0xe7, 0x82, 0x58, 0xce, 0xd6, 0x68, 0xc7, 0xef, 0x6a, 0xe9, 0x97, 0x4a, 0x88,
+	0xff, 0x40, 0x7d, 0x56, 0xcc, 0x03, 0x1e, 0x07, 0x3a, 0x6a, 0x41, 0x36, 0x9d, 0xad, 0x51, 0x67,
+	0xfc, 0x91, 0xdb, 0x52, 0x25, 0xd7, 0x74, 0xe1, 0xfe, 0x58, 0xcc, 0x7f, 0x8a, 0x1f, 0x6a, 0xb3,
+	0x47, 0x4c, 0xe6, 0xa7, 0x7e, 0x97, 0x99, 0x32, 0x83, 0xae, 0x31, 0x82, 0x6c, 0xad, 0x45, 0x9f,
+	0x68, 0x33, 0x93, 0x5e, 0xcb, 0xf0, 0x53, 0xd4, 0x97, 0x5c, 0xd2, 0xac, 0x86, 0x43, 0x44, 0xb6,
+	0x15, 0xfd, 0xe3, 0xd5, 0xf4, 0x5f, 0x4a, 0xc3, 0x49, 0x6d, 0x57, 0xe1, 0x7b, 0xb2, 0x21, 0xc4,
+	0xf7, 0xd0, 0x50, 0xf7, 0x29, 0xc8, 0x41, 0x02, 0x93, 0x29, 0x67, 0xe4, 0x9a, 0x63, 0x8d, 0xb6,
+	0xfd, 0x81, 0xbe, 0xf0, 0x6b, 0x39, 0x7e, 0x8a, 0xba, 0xba, 0x82, 0x81, 0xea, 0x0b, 0xb9, 0xae,
+	0x42, 0xb9, 0xbf, 0x3a, 0x14, 0x5d, 0xad, 0xf2, 0x50, 0xe5, 0x79, 0xb8, 0xfd, 0xe2, 0xdf, 0x5b,
+	0x1b, 0xfe, 0x2e, 0x35, 0x2e, 0xf0, 0x5d, 0xd4, 0x57, 0x5c, 0x23, 0x94, 0xb7, 0x54, 0x28, 0x3d,
+	0x25, 0x5e, 0x04, 0xf2, 0x1d, 0xea, 0x56, 0x8a, 0xd3, 0xa2, 0x1c, 0x20, 0x41, 0xde, 0x56, 0x81,
+	0x38, 0xad, 0x81, 0x28, 0xf6, 0xa1, 0x52, 0xac, 0xbd, 0x8a, 0x85, 0x48, 0xe0, 0xdf, 0x51, 0x2f,
+	0x9c, 0xd1, 0x94, 0x05, 0xe1, 0x8c, 0x32, 0x06, 0x99, 0x20, 0x3b, 0x57, 0xed, 0xdf, 0x51, 0x69,
+	0x77, 0xa4, 0xcd, 0x74, 0xff, 0x42, 0x53, 0x86, 0x7d, 0x34, 0xcc, 0x21, 0x49, 0x85, 0xcc, 0x69,
+	0x19, 0x79, 0x30, 0xe7, 0x11, 0x10, 0xe4, 0x58, 0xa3, 0xde, 0xf8, 0x4e, 0x2b, 0xdf, 0x37, 0xb4,
+	0x7f, 0xe0, 0x11, 0xf8, 0x83, 0xfc, 0x82, 0x04, 0xff, 0x6d, 0xa1, 0xf7, 0xf4, 0xc8, 0x35, 0xd8,
+	0x31, 0x4d, 0xb3, 0x22, 0x07, 0x41, 0x3a, 0x2a, 0xfe, 0x07, 0x57, 0x9c, 0x3f, 0xd3, 0xe3, 0x44,
+	0x23, 0xaa, 0x5c, 0x6e, 0xb2, 0x37, 0xdd, 0xe3, 0x27, 0x68, 0x98, 0xb2, 0x20, 0xce, 0xd2, 0x64,
+	0x26, 0x83, 0xea, 0x19, 0x0b, 0xb2, 0xab, 0xfc, 0x7e, 0xd0, 0xea, 0xf7, 0x1b, 0x36, 0x51, 0xca,
+	0x3f, 0x53, 0xa3, 0x11, 0xfd, 0xb4, 0x21, 0x15, 0xf8, 0x08, 0xed, 0x6a, 0xb3, 0xaa, 0x52, 0x5d,
+	0x55, 0xa9, 0xf6, 0xbe, 0xea, 0x21, 0x56, 0x45, 0xea, 0xc4, 0x8b, 0x03, 0x9e, 0xa0, 0x9e, 0x48,
+	0x13, 0x06, 0x79, 0x06, 0x42, 0x04, 0x31, 0x00, 0xe9, 0x39, 0xd6, 0xa8, 0x33, 0xbe, 0xe9, 0x56,
+	0xfb, 0xcf, 0x2d, 0xf7, 0x9f, 0xab, 0xf7, 0x9f, 0x7b, 0xc4, 0x53, 0xa6, 0xc3, 0xe9, 0x2e, 0xcc,
+	0x26, 0x00, 0xf8, 0x5b, 0x34, 0x68, 0xd6, 0x17, 0x80, 0xf4, 0xaf, 0x46, 0xea, 0x9b, 0x86, 0x25,
+	0xeb, 0x2f, 0x44, 0x0a, 0x76, 0x4c, 0xd3, 0x28, 0xb8, 0x88, 0x14, 0x64, 0xa0, 0xca, 0xf6, 0xc5,
+	0xea, 0x76, 0x3d, 0x51, 0x04, 0xbf, 0x89, 0x6e, 0xbc, 0xa7, 0x77, 0x8a, 0x56, 0x15, 0x4c, 0x51,
+	0xfd, 0x9a, 0x03, 0x01, 0x7f, 0x16, 0xc0, 0x42, 0x20, 0x43, 0xe5, 0xf6, 0x93, 0xd5, 0x6e, 0xbf,
+	0xae, 0x2c, 0x1f, 0x6b, 0xc3, 0x6a, 0x36, 0xfa, 0xb3, 0xa6, 0x14, 0xff, 0x8a, 0xfa, 0x75, 0xeb,
+	0x72, 0x08, 0x79, 0xb9, 0x07, 0xb1, 0xf2, 0x70, 0x77, 0x59, 0xf7, 0x7c, 0xa5, 0x6a, 0xa6, 0xd0,
+	0x8b, 0xcd, 0x1b, 0xb1, 0xff, 0x00, 0xe1, 0xcb, 0x4b, 0x18, 0x0f, 0xd0, 0xd6, 0x33, 0x38, 0x25,
+	0x96, 0x63, 0x8d, 0x76, 0xfc, 0xf2, 0x13, 0xef, 0xa1, 0x6b, 0x27, 0x34, 0x2b, 0x80, 0x6c, 0xaa,
+	0x95, 0x51, 0x1d, 0x3e, 0xdf, 0xfc, 0xcc, 0x7a, 0x4d, 0x68, 0x2c, 0xda, 0xb5, 0x08, 0x0f, 0xd1,
+	0x8d, 0x96, 0x65, 0xba, 0x0a, 0xb1, 0x63, 0x22, 0xa6, 0x68, 0x78, 0x69, 0x09, 0xb6, 0x00, 0x3e,
+	0x35, 0x01, 0x9d, 0xf1, 0xed, 0xd6, 0xda, 0x99, 0xa0, 0x0b, 0x89, 0x5e, 0xde, 0x48, 0x6b, 0x45,
+	0xf9, 0x3d, 0xb2, 0x97, 0xef, 0x84, 0xb5, 0xca, 0x16, 0xa1, 0x77, 0x97, 0x8c, 0x6c, 0x0b, 0xca,
+	0x6b, 0x66, 0xff, 0xe6, 0x67, 0x66, 0x7a, 0x39, 0x44, 0x7b, 0x6d, 0x13, 0xba, 0x4e, 0xa4, 0x87,
+	0x8f, 0x5e, 0x9c, 0xd9, 0xd6, 0xcb, 0x33, 0xdb, 0xfa, 0xef, 0xcc, 0xb6, 0xfe, 0x39, 0xb7, 0x37,
+	0x5e, 0x9e, 0xdb, 0x1b, 0xaf, 0xce, 0xed, 0x8d, 0xdf, 0xee, 0x25, 0xa9, 0x9c, 0x15, 0x53, 0x37,
+	0xe4, 0x73, 0x4f, 0xf5, 0xe2, 0x43, 0x2a, 0x04, 0x48, 0xd1, 0xf8, 0x4f, 0x19, 0x7b, 0xf2, 0xf4,
+	0x18, 0xc4, 0xf4, 0xba, 0xfa, 0x51, 0xb9, 0xff, 0xff, 0x00, 0x31, 0xff, 0xcb, 0x2c, 0xa1, 0x09,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +296,195 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForwardRecords) > 0 {
+		for iNdEx := len(m.ForwardRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.HistorySequence) > 0 {
+		for k := range m.HistorySequence {
+			v := m.HistorySequence[k]
+			baseI := i
+			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.UnpaidRegistrationFees) > 0 {
+		for k := range m.UnpaidRegistrationFees {
+			v := m.UnpaidRegistrationFees[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.RegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.SignerlessFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.ForwardMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ForwardMode))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.NumOfRegistrationFailures) > 0 {
+		for k := range m.NumOfRegistrationFailures {
+			v := m.NumOfRegistrationFailures[k]
+			baseI := i
+			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.RegistrationMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RegistrationMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ChainChannels) > 0 {
+		for k := range m.ChainChannels {
+			v := m.ChainChannels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.StatsBuckets) > 0 {
+		for iNdEx := len(m.StatsBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatsBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.StatsRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StatsRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AccountStats) > 0 {
+		for k := range m.AccountStats {
+			v := m.AccountStats[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.HistoryRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.TotalForwarded) > 0 {
 		for k := range m.TotalForwarded {
 			v := m.TotalForwarded[k]
@@ -257,6 +597,82 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryRetention))
+	}
+	if len(m.AccountStats) > 0 {
+		for k, v := range m.AccountStats {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if m.StatsRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.StatsRetention))
+	}
+	if len(m.StatsBuckets) > 0 {
+		for _, e := range m.StatsBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainChannels) > 0 {
+		for k, v := range m.ChainChannels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if m.RegistrationMode != 0 {
+		n += 1 + sovGenesis(uint64(m.RegistrationMode))
+	}
+	if len(m.NumOfRegistrationFailures) > 0 {
+		for k, v := range m.NumOfRegistrationFailures {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + sovGenesis(uint64(v))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ForwardMode != 0 {
+		n += 1 + sovGenesis(uint64(m.ForwardMode))
+	}
+	l = m.SignerlessFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RegistrationFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UnpaidRegistrationFees) > 0 {
+		for k, v := range m.UnpaidRegistrationFees {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 2 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.HistorySequence) > 0 {
+		for k, v := range m.HistorySequence {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + sovGenesis(uint64(v))
+			n += mapEntrySize + 2 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ForwardRecords) > 0 {
+		for _, e := range m.ForwardRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -680,6 +1096,861 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.TotalForwarded[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountStats == nil {
+				m.AccountStats = make(map[string]AccountStats)
+			}
+			var mapkey string
+			mapvalue := &AccountStats{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AccountStats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AccountStats[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsRetention", wireType)
+			}
+			m.StatsRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatsRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsBuckets = append(m.StatsBuckets, StatsBucket{})
+			if err := m.StatsBuckets[len(m.StatsBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainChannels == nil {
+				m.ChainChannels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChainChannels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationMode", wireType)
+			}
+			m.RegistrationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationMode |= RegistrationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfRegistrationFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumOfRegistrationFailures == nil {
+				m.NumOfRegistrationFailures = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumOfRegistrationFailures[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardMode", wireType)
+			}
+			m.ForwardMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardMode |= ForwardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerlessFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerlessFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpaidRegistrationFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnpaidRegistrationFees == nil {
+				m.UnpaidRegistrationFees = make(map[string]types.Coin)
+			}
+			var mapkey string
+			mapvalue := &types.Coin{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Coin{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.UnpaidRegistrationFees[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySequence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistorySequence == nil {
+				m.HistorySequence = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HistorySequence[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRecords = append(m.ForwardRecords, ForwardRecordEntry{})
+			if err := m.ForwardRecords[len(m.ForwardRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
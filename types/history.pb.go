@@ -0,0 +1,743 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/forwarding/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForwardOutcome is the outcome of an automatic forward.
+type ForwardOutcome int32
+
+const (
+	// FORWARD_OUTCOME_UNSPECIFIED is the default value.
+	FORWARD_OUTCOME_UNSPECIFIED ForwardOutcome = 0
+	// FORWARD_OUTCOME_SENT indicates that the outbound transfer was sent.
+	FORWARD_OUTCOME_SENT ForwardOutcome = 1
+	// FORWARD_OUTCOME_FAILED indicates that the outbound transfer could not be sent.
+	FORWARD_OUTCOME_FAILED ForwardOutcome = 2
+)
+
+var ForwardOutcome_name = map[int32]string{
+	0: "FORWARD_OUTCOME_UNSPECIFIED",
+	1: "FORWARD_OUTCOME_SENT",
+	2: "FORWARD_OUTCOME_FAILED",
+}
+
+var ForwardOutcome_value = map[string]int32{
+	"FORWARD_OUTCOME_UNSPECIFIED": 0,
+	"FORWARD_OUTCOME_SENT":        1,
+	"FORWARD_OUTCOME_FAILED":      2,
+}
+
+func (x ForwardOutcome) String() string {
+	return proto.EnumName(ForwardOutcome_name, int32(x))
+}
+
+func (ForwardOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_273a6633776b5cd9, []int{0}
+}
+
+// ForwardRecord is an entry in the forward history of a forwarding account.
+type ForwardRecord struct {
+	// height is the block height at which the forward was executed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the amount that was forwarded.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// sequence is the packet sequence of the outbound transfer, if sent.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// outcome is the outcome of the forward.
+	Outcome ForwardOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=noble.forwarding.v1.ForwardOutcome" json:"outcome,omitempty"`
+}
+
+func (m *ForwardRecord) Reset()         { *m = ForwardRecord{} }
+func (m *ForwardRecord) String() string { return proto.CompactTextString(m) }
+func (*ForwardRecord) ProtoMessage()    {}
+func (*ForwardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273a6633776b5cd9, []int{0}
+}
+func (m *ForwardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRecord.Merge(m, src)
+}
+func (m *ForwardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRecord proto.InternalMessageInfo
+
+func (m *ForwardRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ForwardRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ForwardRecord) GetOutcome() ForwardOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return FORWARD_OUTCOME_UNSPECIFIED
+}
+
+// ForwardRecordEntry is a forward record of a forwarding account, keyed by its
+// index in the account's forward history, used when exporting genesis.
+type ForwardRecordEntry struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// index is the position of the record in the account's forward history.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// record is the forward record.
+	Record ForwardRecord `protobuf:"bytes,3,opt,name=record,proto3" json:"record"`
+}
+
+func (m *ForwardRecordEntry) Reset()         { *m = ForwardRecordEntry{} }
+func (m *ForwardRecordEntry) String() string { return proto.CompactTextString(m) }
+func (*ForwardRecordEntry) ProtoMessage()    {}
+func (*ForwardRecordEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273a6633776b5cd9, []int{1}
+}
+func (m *ForwardRecordEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRecordEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRecordEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardRecordEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRecordEntry.Merge(m, src)
+}
+func (m *ForwardRecordEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRecordEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRecordEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRecordEntry proto.InternalMessageInfo
+
+func (m *ForwardRecordEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardRecordEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ForwardRecordEntry) GetRecord() ForwardRecord {
+	if m != nil {
+		return m.Record
+	}
+	return ForwardRecord{}
+}
+
+func init() {
+	proto.RegisterEnum("noble.forwarding.v1.ForwardOutcome", ForwardOutcome_name, ForwardOutcome_value)
+	proto.RegisterType((*ForwardRecord)(nil), "noble.forwarding.v1.ForwardRecord")
+	proto.RegisterType((*ForwardRecordEntry)(nil), "noble.forwarding.v1.ForwardRecordEntry")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/history.proto", fileDescriptor_273a6633776b5cd9) }
+
+var fileDescriptor_273a6633776b5cd9 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x6b, 0x13, 0x41,
+	0x1c, 0xdd, 0x69, 0xd7, 0xd4, 0x4e, 0xb1, 0xc4, 0x31, 0x94, 0x75, 0x85, 0x6d, 0x8c, 0x97, 0x50,
+	0x71, 0x86, 0xc4, 0xab, 0x82, 0x4d, 0xb2, 0x81, 0x80, 0x36, 0x32, 0x6d, 0x11, 0xbc, 0x94, 0xfd,
+	0x33, 0x6e, 0x16, 0xdc, 0xf9, 0xd5, 0x9d, 0xd9, 0x68, 0xbe, 0x80, 0x78, 0xf4, 0x3b, 0x78, 0xf1,
+	0xe8, 0x47, 0xf0, 0xd8, 0x63, 0x8f, 0x9e, 0x44, 0x92, 0x83, 0x5f, 0x43, 0x32, 0xbb, 0x55, 0x12,
+	0xa4, 0x97, 0xe5, 0xf7, 0xf6, 0xf7, 0xde, 0xbc, 0xf7, 0xe0, 0x87, 0xef, 0x4b, 0x08, 0xdf, 0x0a,
+	0xf6, 0x06, 0xf2, 0xf7, 0x41, 0x1e, 0xa7, 0x32, 0x61, 0xd3, 0x0e, 0x9b, 0xa4, 0x4a, 0x43, 0x3e,
+	0xa3, 0xe7, 0x39, 0x68, 0x20, 0x77, 0x0c, 0x85, 0xfe, 0xa3, 0xd0, 0x69, 0xc7, 0xbd, 0x1d, 0x64,
+	0xa9, 0x04, 0x66, 0xbe, 0x25, 0xcf, 0xf5, 0x22, 0x50, 0x19, 0x28, 0x16, 0x06, 0x4a, 0xb0, 0x69,
+	0x27, 0x14, 0x3a, 0xe8, 0xb0, 0x08, 0x52, 0x59, 0xed, 0x1b, 0x09, 0x24, 0x60, 0x46, 0xb6, 0x9c,
+	0xca, 0xbf, 0xad, 0xef, 0x08, 0xdf, 0x1a, 0x96, 0x4f, 0x73, 0x11, 0x41, 0x1e, 0x93, 0x3d, 0x5c,
+	0x9b, 0x88, 0x34, 0x99, 0x68, 0x07, 0x35, 0x51, 0x7b, 0x93, 0x57, 0x88, 0x3c, 0xc1, 0xb5, 0x20,
+	0x83, 0x42, 0x6a, 0x67, 0xa3, 0x89, 0xda, 0x3b, 0xdd, 0xbb, 0xb4, 0x34, 0xa4, 0x4b, 0x43, 0x5a,
+	0x19, 0xd2, 0x3e, 0xa4, 0xb2, 0xb7, 0x7d, 0xf1, 0x73, 0xdf, 0xfa, 0xfa, 0xfb, 0xdb, 0x01, 0xe2,
+	0x95, 0x86, 0xb8, 0xf8, 0xa6, 0x12, 0xef, 0x0a, 0x21, 0x23, 0xe1, 0x6c, 0x36, 0x51, 0xdb, 0xe6,
+	0x7f, 0x31, 0x79, 0x8a, 0xb7, 0xa0, 0xd0, 0x11, 0x64, 0xc2, 0xb1, 0x9b, 0xa8, 0xbd, 0xdb, 0x7d,
+	0x40, 0xff, 0xd3, 0x99, 0x56, 0x31, 0xc7, 0x25, 0x95, 0x5f, 0x69, 0x5a, 0x1f, 0x11, 0x26, 0x2b,
+	0x15, 0x7c, 0xa9, 0xf3, 0x19, 0x71, 0xf0, 0x56, 0x10, 0xc7, 0xb9, 0x50, 0xca, 0x14, 0xd9, 0xe6,
+	0x57, 0x90, 0x34, 0xf0, 0x8d, 0x54, 0xc6, 0xe2, 0x83, 0x29, 0x62, 0xf3, 0x12, 0x90, 0x67, 0xb8,
+	0x96, 0x1b, 0xb9, 0xc9, 0xb7, 0xd3, 0x6d, 0x5d, 0x17, 0xa2, 0x34, 0xea, 0xd9, 0xcb, 0xa2, 0xbc,
+	0xd2, 0x1d, 0x64, 0x78, 0x77, 0x35, 0x23, 0xd9, 0xc7, 0xf7, 0x86, 0x63, 0xfe, 0xea, 0x90, 0x0f,
+	0xce, 0xc6, 0xa7, 0x27, 0xfd, 0xf1, 0x0b, 0xff, 0xec, 0xf4, 0xe8, 0xf8, 0xa5, 0xdf, 0x1f, 0x0d,
+	0x47, 0xfe, 0xa0, 0x6e, 0x11, 0x07, 0x37, 0xd6, 0x09, 0xc7, 0xfe, 0xd1, 0x49, 0x1d, 0x11, 0x17,
+	0xef, 0xad, 0x6f, 0x86, 0x87, 0xa3, 0xe7, 0xfe, 0xa0, 0xbe, 0xe1, 0xda, 0x9f, 0xbe, 0x78, 0x56,
+	0xcf, 0xbf, 0x98, 0x7b, 0xe8, 0x72, 0xee, 0xa1, 0x5f, 0x73, 0x0f, 0x7d, 0x5e, 0x78, 0xd6, 0xe5,
+	0xc2, 0xb3, 0x7e, 0x2c, 0x3c, 0xeb, 0xf5, 0xc3, 0x24, 0xd5, 0x93, 0x22, 0xa4, 0x11, 0x64, 0xcc,
+	0x94, 0x78, 0x14, 0x28, 0x25, 0xb4, 0x5a, 0xb9, 0xb3, 0x2e, 0xd3, 0xb3, 0x73, 0xa1, 0xc2, 0x9a,
+	0x39, 0x84, 0xc7, 0x7f, 0x06, 0x00, 0x5f, 0x66, 0x45, 0x7b, 0x8b, 0x02, 0x00, 0x00,
+}
+
+func (m *ForwardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardRecordEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRecordEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRecordEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForwardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovHistory(uint64(m.Sequence))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovHistory(uint64(m.Outcome))
+	}
+	return n
+}
+
+func (m *ForwardRecordEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovHistory(uint64(m.Index))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= ForwardOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardRecordEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardRecordEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardRecordEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
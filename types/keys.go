@@ -27,10 +27,27 @@ const (
 )
 
 var (
-	AllowedDenomsPrefix   = []byte("allowed_denoms")
-	NumOfAccountsPrefix   = []byte("num_of_accounts")
-	NumOfForwardsPrefix   = []byte("num_of_forwards")
-	TotalForwardedPrefix  = []byte("total_forwarded")
-	PendingForwardsPrefix = []byte("pending_forwards")
-	MemosPrefix           = []byte("memos")
+	AllowedDenomsPrefix          = []byte("allowed_denoms")
+	NumOfAccountsPrefix          = []byte("num_of_accounts")
+	NumOfForwardsPrefix          = []byte("num_of_forwards")
+	TotalForwardedPrefix         = []byte("total_forwarded")
+	PendingForwardsPrefix        = []byte("pending_forwards")
+	MemosPrefix                  = []byte("memos")
+	RegisteredAccountsPrefix     = []byte("registered_accounts")
+	RecipientAccountsPrefix      = []byte("recipient_accounts")
+	FallbackAccountsPrefix       = []byte("fallback_accounts")
+	HistoryRetentionPrefix       = []byte("history_retention")
+	HistorySequencePrefix        = []byte("history_sequence")
+	ForwardRecordsPrefix         = []byte("forward_records")
+	AccountStatsPrefix           = []byte("account_stats")
+	StatsRetentionPrefix         = []byte("stats_retention")
+	StatsBucketsPrefix           = []byte("stats_buckets")
+	ChainChannelsPrefix          = []byte("chain_channels")
+	RegistrationModePrefix       = []byte("registration_mode")
+	RegistrationFailuresPrefix   = []byte("registration_failures")
+	ForwardModePrefix            = []byte("forward_mode")
+	SignerlessFeePrefix          = []byte("signerless_fee")
+	RegistrationFeePrefix        = []byte("registration_fee")
+	UnpaidRegistrationFeesPrefix = []byte("unpaid_registration_fees")
+	InFlightPacketsPrefix        = []byte("in_flight_packets")
 )
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// Metric keys emitted through the Cosmos SDK telemetry package.
+const (
+	MetricKeyForwards             = "forwards"
+	MetricKeyPendingForwards      = "pending_forwards"
+	MetricKeyExecuteForwards      = "execute_forwards"
+	MetricKeyQueued               = "queued"
+	MetricKeyRegistrations        = "registrations"
+	MetricKeyRegistrationFailures = "registration_failures"
+	MetricKeyMemoLookupErrors     = "memo_lookup_errors"
+)
+
+// Metric labels emitted through the Cosmos SDK telemetry package.
+const (
+	MetricLabelChannel = "channel"
+	MetricLabelDenom   = "denom"
+	MetricLabelOutcome = "outcome"
+	MetricLabelPath    = "path"
+	MetricLabelTrigger = "trigger"
+)
+
+// Values of the outcome label of forward metrics.
+const (
+	ForwardOutcomeExecuted = "executed"
+	ForwardOutcomeFailed   = "failed"
+	ForwardOutcomeSkipped  = "skipped"
+)
+
+// Values of the path label of registration metrics.
+const (
+	RegistrationPathMessage    = "message"
+	RegistrationPathSignerless = "signerless"
+	RegistrationPathMemo       = "memo"
+	RegistrationPathPacket     = "packet"
+)
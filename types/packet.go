@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// RegisterAccountAcknowledgementVersion is the current version of the
+// RegisterAccountAcknowledgement format.
+const RegisterAccountAcknowledgementVersion = 1
+
+// NewRegisterAccountAcknowledgement returns a result acknowledgement for a
+// RegisterAccountData packet, containing a JSON encoded
+// RegisterAccountAcknowledgement.
+func NewRegisterAccountAcknowledgement(address string, created bool, channel string, fallback string) channeltypes.Acknowledgement {
+	bz := ModuleCdc.MustMarshalJSON(&RegisterAccountAcknowledgement{
+		Version:  RegisterAccountAcknowledgementVersion,
+		Address:  address,
+		Created:  created,
+		Channel:  channel,
+		Fallback: fallback,
+	})
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// ValidateRegistrationMode checks that a registration mode is known.
+func ValidateRegistrationMode(mode RegistrationMode) error {
+	if _, found := RegistrationMode_name[int32(mode)]; !found {
+		return fmt.Errorf("unknown registration mode: %d", mode)
+	}
+
+	return nil
+}
+
+// ResolveRegistrationMode returns the given registration mode, where an
+// unspecified mode behaves as strict.
+func ResolveRegistrationMode(mode RegistrationMode) RegistrationMode {
+	if mode == REGISTRATION_MODE_UNSPECIFIED {
+		return REGISTRATION_MODE_STRICT
+	}
+
+	return mode
+}
+
+// NewInFlightPacket returns an in-flight record of an incoming packet, whose
+// received amount, less the registration fee, was forwarded through the given
+// channel and sequence.
+func NewInFlightPacket(address string, forwardChannel string, forwardSequence uint64, packet channeltypes.Packet, amount sdk.Coin, unescrowed bool, memo string, registrationFee sdk.Coin) InFlightPacket {
+	return InFlightPacket{
+		Address:               address,
+		ForwardChannel:        forwardChannel,
+		ForwardSequence:       forwardSequence,
+		SourcePort:            packet.SourcePort,
+		SourceChannel:         packet.SourceChannel,
+		DestinationPort:       packet.DestinationPort,
+		DestinationChannel:    packet.DestinationChannel,
+		Sequence:              packet.Sequence,
+		Data:                  packet.Data,
+		TimeoutRevisionNumber: packet.TimeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: packet.TimeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      packet.TimeoutTimestamp,
+		Amount:                amount,
+		Unescrowed:            unescrowed,
+		Memo:                  memo,
+		RegistrationFee:       registrationFee,
+	}
+}
+
+// ForwardedAmount returns the amount that was forwarded, which is the received
+// amount less the registration fee held by the module.
+func (p InFlightPacket) ForwardedAmount() sdk.Coin {
+	if p.RegistrationFee.IsNil() || p.RegistrationFee.IsZero() {
+		return p.Amount
+	}
+
+	return p.Amount.Sub(p.RegistrationFee)
+}
+
+// Packet returns the incoming packet of an in-flight record.
+func (p InFlightPacket) Packet() channeltypes.Packet {
+	return channeltypes.NewPacket(
+		p.Data,
+		p.Sequence,
+		p.SourcePort,
+		p.SourceChannel,
+		p.DestinationPort,
+		p.DestinationChannel,
+		clienttypes.NewHeight(p.TimeoutRevisionNumber, p.TimeoutRevisionHeight),
+		p.TimeoutTimestamp,
+	)
+}
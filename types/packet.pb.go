@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,10 +24,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RegistrationMode determines how failed registrations through transfer memos
+// are handled.
+type RegistrationMode int32
+
+const (
+	// REGISTRATION_MODE_UNSPECIFIED is the default value, and behaves as strict.
+	REGISTRATION_MODE_UNSPECIFIED RegistrationMode = 0
+	// REGISTRATION_MODE_STRICT rejects the incoming transfer with an error acknowledgement.
+	REGISTRATION_MODE_STRICT RegistrationMode = 1
+	// REGISTRATION_MODE_SOFT_FAIL emits an event and lets the incoming transfer proceed.
+	REGISTRATION_MODE_SOFT_FAIL RegistrationMode = 2
+)
+
+var RegistrationMode_name = map[int32]string{
+	0: "REGISTRATION_MODE_UNSPECIFIED",
+	1: "REGISTRATION_MODE_STRICT",
+	2: "REGISTRATION_MODE_SOFT_FAIL",
+}
+
+var RegistrationMode_value = map[string]int32{
+	"REGISTRATION_MODE_UNSPECIFIED": 0,
+	"REGISTRATION_MODE_STRICT":      1,
+	"REGISTRATION_MODE_SOFT_FAIL":   2,
+}
+
+func (x RegistrationMode) String() string {
+	return proto.EnumName(RegistrationMode_name, int32(x))
+}
+
+func (RegistrationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a0a2a88e68b1d25, []int{0}
+}
+
 type RegisterAccountData struct {
 	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Channel   string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 	Fallback  string `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// memos are the initial per-denom memos of the forwarding account.
+	Memos []MemoEntry `protobuf:"bytes,4,rep,name=memos,proto3" json:"memos"`
 }
 
 func (m *RegisterAccountData) Reset()         { *m = RegisterAccountData{} }
@@ -82,6 +119,96 @@ func (m *RegisterAccountData) GetFallback() string {
 	return ""
 }
 
+func (m *RegisterAccountData) GetMemos() []MemoEntry {
+	if m != nil {
+		return m.Memos
+	}
+	return nil
+}
+
+// RegisterAccountAcknowledgement is the JSON encoded result of a successful
+// RegisterAccountData packet.
+type RegisterAccountAcknowledgement struct {
+	// version is the version of the acknowledgement format.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// created is false if the forwarding account already existed.
+	Created bool `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// channel is the channel that the forwarding account forwards to.
+	Channel string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	// fallback is the fallback address of the forwarding account.
+	Fallback string `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+}
+
+func (m *RegisterAccountAcknowledgement) Reset()         { *m = RegisterAccountAcknowledgement{} }
+func (m *RegisterAccountAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*RegisterAccountAcknowledgement) ProtoMessage()    {}
+func (*RegisterAccountAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a0a2a88e68b1d25, []int{1}
+}
+func (m *RegisterAccountAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterAccountAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterAccountAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterAccountAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterAccountAcknowledgement.Merge(m, src)
+}
+func (m *RegisterAccountAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterAccountAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterAccountAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterAccountAcknowledgement proto.InternalMessageInfo
+
+func (m *RegisterAccountAcknowledgement) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RegisterAccountAcknowledgement) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RegisterAccountAcknowledgement) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *RegisterAccountAcknowledgement) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RegisterAccountAcknowledgement) GetFallback() string {
+	if m != nil {
+		return m.Fallback
+	}
+	return ""
+}
+
 type RegisterAccountMemo struct {
 	Noble *RegisterAccountMemo_RegisterAccountDataWrapper `protobuf:"bytes,1,opt,name=noble,proto3" json:"noble,omitempty"`
 }
@@ -90,7 +217,7 @@ func (m *RegisterAccountMemo) Reset()         { *m = RegisterAccountMemo{} }
 func (m *RegisterAccountMemo) String() string { return proto.CompactTextString(m) }
 func (*RegisterAccountMemo) ProtoMessage()    {}
 func (*RegisterAccountMemo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a0a2a88e68b1d25, []int{1}
+	return fileDescriptor_9a0a2a88e68b1d25, []int{2}
 }
 func (m *RegisterAccountMemo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,6 +255,10 @@ func (m *RegisterAccountMemo) GetNoble() *RegisterAccountMemo_RegisterAccountDat
 
 type RegisterAccountMemo_RegisterAccountDataWrapper struct {
 	Forwarding *RegisterAccountData `protobuf:"bytes,1,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// atomic_forward opts into forwarding the transfer synchronously, where
+	// the transfer is only acknowledged once the forward is acknowledged, and
+	// refunded if the forward fails or times out.
+	AtomicForward bool `protobuf:"varint,2,opt,name=atomic_forward,json=atomicForward,proto3" json:"atomic_forward,omitempty"`
 }
 
 func (m *RegisterAccountMemo_RegisterAccountDataWrapper) Reset() {
@@ -138,7 +269,7 @@ func (m *RegisterAccountMemo_RegisterAccountDataWrapper) String() string {
 }
 func (*RegisterAccountMemo_RegisterAccountDataWrapper) ProtoMessage() {}
 func (*RegisterAccountMemo_RegisterAccountDataWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a0a2a88e68b1d25, []int{1, 0}
+	return fileDescriptor_9a0a2a88e68b1d25, []int{2, 0}
 }
 func (m *RegisterAccountMemo_RegisterAccountDataWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,34 +305,261 @@ func (m *RegisterAccountMemo_RegisterAccountDataWrapper) GetForwarding() *Regist
 	return nil
 }
 
+func (m *RegisterAccountMemo_RegisterAccountDataWrapper) GetAtomicForward() bool {
+	if m != nil {
+		return m.AtomicForward
+	}
+	return false
+}
+
+// InFlightPacket is an incoming transfer that was forwarded atomically, whose
+// acknowledgement is pending on the acknowledgement of the forward.
+type InFlightPacket struct {
+	// address is the forwarding account that received the transfer.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// forward_channel is the channel that the transfer was forwarded through.
+	ForwardChannel string `protobuf:"bytes,2,opt,name=forward_channel,json=forwardChannel,proto3" json:"forward_channel,omitempty"`
+	// forward_sequence is the sequence of the forward packet.
+	ForwardSequence uint64 `protobuf:"varint,3,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// source_port is the source port of the incoming packet.
+	SourcePort string `protobuf:"bytes,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// source_channel is the source channel of the incoming packet.
+	SourceChannel string `protobuf:"bytes,5,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// destination_port is the destination port of the incoming packet.
+	DestinationPort string `protobuf:"bytes,6,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	// destination_channel is the destination channel of the incoming packet.
+	DestinationChannel string `protobuf:"bytes,7,opt,name=destination_channel,json=destinationChannel,proto3" json:"destination_channel,omitempty"`
+	// sequence is the sequence of the incoming packet.
+	Sequence uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the data of the incoming packet.
+	Data []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	// timeout_revision_number is the timeout height revision of the incoming packet.
+	TimeoutRevisionNumber uint64 `protobuf:"varint,10,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	// timeout_revision_height is the timeout height of the incoming packet.
+	TimeoutRevisionHeight uint64 `protobuf:"varint,11,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	// timeout_timestamp is the timeout timestamp of the incoming packet.
+	TimeoutTimestamp uint64 `protobuf:"varint,12,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// amount is the amount that was received, and forwarded.
+	Amount types.Coin `protobuf:"bytes,13,opt,name=amount,proto3" json:"amount"`
+	// unescrowed is true if the amount was unescrowed when received, rather
+	// than minted as a voucher.
+	Unescrowed bool `protobuf:"varint,14,opt,name=unescrowed,proto3" json:"unescrowed,omitempty"`
+	// memo is the memo of the forward, recorded once it's acknowledged.
+	Memo string `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	// registration_fee is the part of the amount that was deducted for the
+	// registration fee, which is held by the module until the forward is
+	// acknowledged.
+	RegistrationFee types.Coin `protobuf:"bytes,16,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a0a2a88e68b1d25, []int{3}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardChannel() string {
+	if m != nil {
+		return m.ForwardChannel
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *InFlightPacket) GetUnescrowed() bool {
+	if m != nil {
+		return m.Unescrowed
+	}
+	return false
+}
+
+func (m *InFlightPacket) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRegistrationFee() types.Coin {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return types.Coin{}
+}
+
 func init() {
+	proto.RegisterEnum("noble.forwarding.v1.RegistrationMode", RegistrationMode_name, RegistrationMode_value)
 	proto.RegisterType((*RegisterAccountData)(nil), "noble.forwarding.v1.RegisterAccountData")
+	proto.RegisterType((*RegisterAccountAcknowledgement)(nil), "noble.forwarding.v1.RegisterAccountAcknowledgement")
 	proto.RegisterType((*RegisterAccountMemo)(nil), "noble.forwarding.v1.RegisterAccountMemo")
 	proto.RegisterType((*RegisterAccountMemo_RegisterAccountDataWrapper)(nil), "noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper")
+	proto.RegisterType((*InFlightPacket)(nil), "noble.forwarding.v1.InFlightPacket")
 }
 
 func init() { proto.RegisterFile("noble/forwarding/v1/packet.proto", fileDescriptor_9a0a2a88e68b1d25) }
 
 var fileDescriptor_9a0a2a88e68b1d25 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcd, 0x4a, 0x03, 0x31,
-	0x14, 0x85, 0x1b, 0xc5, 0x9f, 0xc6, 0x5d, 0xba, 0x19, 0x06, 0x09, 0xa5, 0xab, 0x82, 0x98, 0xd0,
-	0xfa, 0x04, 0xfe, 0x81, 0x1b, 0x37, 0xb3, 0x11, 0xdd, 0x65, 0xe2, 0x9d, 0x69, 0xe8, 0x34, 0x09,
-	0x49, 0x5a, 0xf1, 0x2d, 0x7c, 0x2c, 0x97, 0x5d, 0x76, 0x29, 0x33, 0x2f, 0x22, 0xc6, 0x9f, 0x51,
-	0x1c, 0xc1, 0xe5, 0xbd, 0xf7, 0xdc, 0xf3, 0x1d, 0x38, 0x78, 0xa8, 0x4d, 0x5e, 0x01, 0x2f, 0x8c,
-	0x7b, 0x10, 0xee, 0x5e, 0xe9, 0x92, 0xaf, 0x26, 0xdc, 0x0a, 0x39, 0x87, 0xc0, 0xac, 0x33, 0xc1,
-	0x90, 0x41, 0x54, 0xb0, 0x56, 0xc1, 0x56, 0x93, 0x91, 0xc2, 0x83, 0x0c, 0x4a, 0xe5, 0x03, 0xb8,
-	0x53, 0x29, 0xcd, 0x52, 0x87, 0x0b, 0x11, 0x04, 0x39, 0xc4, 0x7d, 0x07, 0x52, 0x59, 0x05, 0x3a,
-	0x24, 0x68, 0x88, 0xc6, 0xfd, 0xac, 0x5d, 0x90, 0x04, 0xef, 0xc9, 0x99, 0xd0, 0x1a, 0xaa, 0x64,
-	0x2b, 0xde, 0x3e, 0x47, 0x92, 0xe2, 0xfd, 0x42, 0x54, 0x55, 0x2e, 0xe4, 0x3c, 0xd9, 0x8e, 0xa7,
-	0xaf, 0x79, 0xb4, 0x41, 0xbf, 0x58, 0xd7, 0xb0, 0x30, 0xe4, 0x16, 0xef, 0xc4, 0x64, 0x91, 0x73,
-	0x30, 0x3d, 0x67, 0x1d, 0x39, 0x59, 0xc7, 0x23, 0xeb, 0x08, 0x7e, 0xe3, 0x84, 0xb5, 0xe0, 0xb2,
-	0x77, 0xc7, 0xb4, 0xc0, 0xe9, 0xdf, 0x22, 0x72, 0x85, 0x71, 0x0b, 0xf9, 0xa0, 0x8f, 0xff, 0x43,
-	0x7f, 0x33, 0xc9, 0xbe, 0xfd, 0x9e, 0x5d, 0x3e, 0xd7, 0x14, 0xad, 0x6b, 0x8a, 0x5e, 0x6a, 0x8a,
-	0x9e, 0x1a, 0xda, 0x5b, 0x37, 0xb4, 0xb7, 0x69, 0x68, 0xef, 0xee, 0xa8, 0x54, 0x61, 0xb6, 0xcc,
-	0x99, 0x34, 0x0b, 0x1e, 0x9d, 0x8f, 0x85, 0xf7, 0x10, 0xfc, 0x8f, 0xa2, 0xa6, 0x3c, 0x3c, 0x5a,
-	0xf0, 0xf9, 0x6e, 0x2c, 0xea, 0xe4, 0x35, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x8b, 0xd8, 0x9a, 0xcc,
-	0x01, 0x00, 0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xbb, 0x69, 0xb7, 0x9d, 0x6c, 0x12, 0x33, 0x05, 0x61, 0xc2, 0xe2, 0x86, 0x48, 0x88,
+	0xc0, 0x0a, 0x5b, 0x29, 0x12, 0x48, 0xdc, 0xb2, 0x69, 0xc2, 0x06, 0xd1, 0x3f, 0x72, 0x82, 0x10,
+	0x5c, 0xac, 0x89, 0xfd, 0x9a, 0x58, 0x8d, 0x67, 0xcc, 0xcc, 0x24, 0xd5, 0x72, 0xe2, 0xc8, 0x05,
+	0x89, 0xef, 0x00, 0x48, 0x7c, 0x94, 0x3d, 0xee, 0x91, 0x13, 0x42, 0xed, 0x17, 0x41, 0x1e, 0x8f,
+	0x1b, 0x67, 0xd7, 0x2b, 0xf5, 0xe4, 0x79, 0xbf, 0xdf, 0xfb, 0xf7, 0x7b, 0xf3, 0x3c, 0xa8, 0x4d,
+	0xd9, 0x6c, 0x09, 0xee, 0x25, 0xe3, 0xd7, 0x84, 0x87, 0x11, 0x9d, 0xbb, 0xeb, 0x9e, 0x9b, 0x90,
+	0xe0, 0x0a, 0xa4, 0x93, 0x70, 0x26, 0x19, 0x3e, 0x54, 0x1e, 0xce, 0xc6, 0xc3, 0x59, 0xf7, 0x5a,
+	0x76, 0xc0, 0x44, 0xcc, 0x84, 0x3b, 0x23, 0x02, 0xdc, 0x75, 0x6f, 0x06, 0x92, 0xf4, 0xdc, 0x80,
+	0x45, 0x34, 0x0b, 0x6a, 0xbd, 0x3d, 0x67, 0x73, 0xa6, 0x8e, 0x6e, 0x7a, 0xd2, 0xa8, 0x5d, 0x56,
+	0x2c, 0x86, 0x58, 0xf3, 0x9d, 0xbf, 0x0c, 0x74, 0xe8, 0xc1, 0x3c, 0x12, 0x12, 0x78, 0x3f, 0x08,
+	0xd8, 0x8a, 0xca, 0x13, 0x22, 0x09, 0x7e, 0x8c, 0x0e, 0x38, 0x04, 0x51, 0x12, 0x01, 0x95, 0x96,
+	0xd1, 0x36, 0xba, 0x07, 0xde, 0x06, 0xc0, 0x16, 0x7a, 0x18, 0x2c, 0x08, 0xa5, 0xb0, 0xb4, 0x76,
+	0x14, 0x97, 0x9b, 0xb8, 0x85, 0xf6, 0x2f, 0xc9, 0x72, 0x39, 0x23, 0xc1, 0x95, 0xf5, 0x40, 0x51,
+	0x77, 0x36, 0xfe, 0x0a, 0xed, 0xa6, 0x95, 0x85, 0x55, 0x6d, 0x3f, 0xe8, 0xd6, 0x8e, 0x6d, 0xa7,
+	0x44, 0xa6, 0x73, 0x0a, 0x31, 0x1b, 0x52, 0xc9, 0x9f, 0x3f, 0xad, 0xbe, 0xf8, 0xf7, 0xa8, 0xe2,
+	0x65, 0x21, 0x9d, 0x3f, 0x0d, 0x64, 0xbf, 0xd2, 0x67, 0x3f, 0xb8, 0xa2, 0xec, 0x7a, 0x09, 0xe1,
+	0x1c, 0x62, 0xdd, 0xd4, 0x1a, 0xb8, 0x88, 0x18, 0x55, 0x0d, 0xd7, 0xbd, 0xdc, 0x4c, 0x19, 0x12,
+	0x86, 0x1c, 0x84, 0xc8, 0xdb, 0xd5, 0xa6, 0x12, 0xc2, 0x81, 0x48, 0x08, 0x55, 0xb7, 0xfb, 0x5e,
+	0x6e, 0x16, 0x25, 0x56, 0xdf, 0x2c, 0x71, 0x77, 0x5b, 0x62, 0xe7, 0x97, 0x9d, 0xd7, 0xc6, 0x99,
+	0x0a, 0xc2, 0x3f, 0xa0, 0x5d, 0x25, 0x56, 0x75, 0x56, 0x3b, 0x1e, 0x94, 0x4a, 0x2f, 0x09, 0x74,
+	0x4a, 0xee, 0xe6, 0x7b, 0x4e, 0x92, 0x04, 0xb8, 0x97, 0x65, 0x6c, 0xfd, 0x66, 0xa0, 0xd6, 0x9b,
+	0xbd, 0xf0, 0x33, 0x84, 0x36, 0x55, 0x74, 0xf9, 0xee, 0x7d, 0xca, 0xa7, 0x49, 0xbc, 0x42, 0x2c,
+	0xfe, 0x08, 0x35, 0x88, 0x64, 0x71, 0x14, 0xf8, 0x1a, 0x54, 0xc3, 0xdc, 0xf7, 0xea, 0x19, 0x3a,
+	0xca, 0xc0, 0xce, 0xdf, 0xbb, 0xa8, 0x31, 0xa6, 0xa3, 0x65, 0x34, 0x5f, 0xc8, 0x0b, 0xb5, 0xd5,
+	0xc5, 0xf9, 0x1b, 0xdb, 0xf3, 0xff, 0x18, 0x35, 0x75, 0x32, 0x7f, 0x7b, 0xa1, 0x1a, 0x1a, 0x1e,
+	0xe8, 0xa1, 0x7f, 0x82, 0xcc, 0xdc, 0x51, 0xc0, 0x4f, 0x2b, 0xa0, 0x01, 0xa8, 0x1b, 0xab, 0x7a,
+	0x79, 0x82, 0x89, 0x86, 0xf1, 0x11, 0xaa, 0x09, 0xb6, 0xe2, 0x01, 0xf8, 0x09, 0xe3, 0x52, 0xdf,
+	0x1e, 0xca, 0xa0, 0x0b, 0xc6, 0x65, 0x2a, 0x44, 0x3b, 0xe4, 0x35, 0xb3, 0x6b, 0xac, 0x67, 0x68,
+	0xa1, 0x64, 0x08, 0x42, 0x46, 0x94, 0xc8, 0x88, 0xd1, 0x2c, 0xd9, 0x9e, 0x72, 0x6c, 0x16, 0x70,
+	0x95, 0xd1, 0x45, 0x87, 0x45, 0xd7, 0x3c, 0xed, 0x43, 0xe5, 0x8d, 0x0b, 0xd4, 0x60, 0xb3, 0x43,
+	0x77, 0x32, 0xf6, 0x95, 0x8c, 0x3b, 0x1b, 0x63, 0x54, 0x0d, 0x89, 0x24, 0xd6, 0x41, 0xdb, 0xe8,
+	0x3e, 0xf2, 0xd4, 0x19, 0x7f, 0x81, 0xde, 0x95, 0x51, 0x0c, 0x6c, 0x25, 0x7d, 0x0e, 0xeb, 0x28,
+	0xdd, 0x6a, 0x9f, 0xae, 0xe2, 0x19, 0x70, 0x0b, 0xa9, 0xf0, 0x77, 0x34, 0xed, 0x69, 0xf6, 0x4c,
+	0x91, 0xa5, 0x71, 0x0b, 0x48, 0xaf, 0xc6, 0xaa, 0x95, 0xc6, 0x3d, 0x53, 0x24, 0x7e, 0x82, 0xde,
+	0xca, 0xe3, 0xd2, 0xaf, 0x90, 0x24, 0x4e, 0xac, 0x47, 0x2a, 0xc2, 0xd4, 0xc4, 0x34, 0xc7, 0xf1,
+	0x97, 0x68, 0x8f, 0xc4, 0xe9, 0xca, 0x58, 0x75, 0xb5, 0x5e, 0xef, 0x39, 0xd9, 0x53, 0xe5, 0xa4,
+	0x4f, 0x95, 0xa3, 0x9f, 0x2a, 0x67, 0xc0, 0x22, 0xaa, 0xff, 0x69, 0xed, 0x8e, 0x6d, 0x84, 0x56,
+	0x14, 0x44, 0xc0, 0xd9, 0x35, 0x84, 0x56, 0x43, 0x6d, 0x53, 0x01, 0x49, 0x27, 0x91, 0xfe, 0xfd,
+	0x56, 0x53, 0xcd, 0x51, 0x9d, 0xf1, 0x37, 0xc8, 0xe4, 0x6a, 0x51, 0x79, 0x36, 0xeb, 0x4b, 0x00,
+	0xcb, 0xbc, 0x5f, 0xd9, 0x66, 0x31, 0x70, 0x04, 0xf0, 0xe9, 0xcf, 0xc8, 0xf4, 0x0a, 0xd0, 0x29,
+	0x0b, 0x01, 0x7f, 0x88, 0x3e, 0xf0, 0x86, 0x5f, 0x8f, 0x27, 0x53, 0xaf, 0x3f, 0x1d, 0x9f, 0x9f,
+	0xf9, 0xa7, 0xe7, 0x27, 0x43, 0xff, 0xbb, 0xb3, 0xc9, 0xc5, 0x70, 0x30, 0x1e, 0x8d, 0x87, 0x27,
+	0x66, 0x05, 0x3f, 0x46, 0xd6, 0xeb, 0x2e, 0x93, 0xa9, 0x37, 0x1e, 0x4c, 0x4d, 0x03, 0x1f, 0xa1,
+	0xf7, 0x4b, 0xd8, 0xf3, 0xd1, 0xd4, 0x1f, 0xf5, 0xc7, 0xdf, 0x9a, 0x3b, 0xad, 0xea, 0xaf, 0x7f,
+	0xd8, 0x95, 0xa7, 0xc3, 0x17, 0x37, 0xb6, 0xf1, 0xf2, 0xc6, 0x36, 0xfe, 0xbb, 0xb1, 0x8d, 0xdf,
+	0x6f, 0xed, 0xca, 0xcb, 0x5b, 0xbb, 0xf2, 0xcf, 0xad, 0x5d, 0xf9, 0xf1, 0xc9, 0x3c, 0x92, 0x8b,
+	0xd5, 0xcc, 0x09, 0x58, 0xec, 0xaa, 0xff, 0xf4, 0x33, 0x22, 0x04, 0x48, 0xb1, 0xf5, 0x88, 0x1f,
+	0xbb, 0xf2, 0x79, 0x02, 0x62, 0xb6, 0xa7, 0x9e, 0xf1, 0xcf, 0xff, 0x1f, 0x00, 0x87, 0x2d, 0xb9,
+	0xc9, 0x55, 0x06, 0x00, 0x00,
 }
 
 func (m *RegisterAccountData) Marshal() (dAtA []byte, err error) {
@@ -224,6 +582,20 @@ func (m *RegisterAccountData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memos) > 0 {
+		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Fallback) > 0 {
 		i -= len(m.Fallback)
 		copy(dAtA[i:], m.Fallback)
@@ -248,6 +620,65 @@ func (m *RegisterAccountData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RegisterAccountAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterAccountAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterAccountAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Created {
+		i--
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterAccountMemo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -303,6 +734,16 @@ func (m *RegisterAccountMemo_RegisterAccountDataWrapper) MarshalToSizedBuffer(dA
 	_ = i
 	var l int
 	_ = l
+	if m.AtomicForward {
+		i--
+		if m.AtomicForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Forwarding != nil {
 		{
 			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
@@ -318,39 +759,208 @@ func (m *RegisterAccountMemo_RegisterAccountDataWrapper) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RegisterAccountData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	{
+		size, err := m.RegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	l = len(m.Fallback)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	if m.Unescrowed {
+		i--
+		if m.Unescrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	return n
-}
-
-func (m *RegisterAccountMemo) Size() (n int) {
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ForwardSequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ForwardChannel) > 0 {
+		i -= len(m.ForwardChannel)
+		copy(dAtA[i:], m.ForwardChannel)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ForwardChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisterAccountData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Memos) > 0 {
+		for _, e := range m.Memos {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterAccountAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Created {
+		n += 2
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterAccountMemo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -373,6 +983,72 @@ func (m *RegisterAccountMemo_RegisterAccountDataWrapper) Size() (n int) {
 		l = m.Forwarding.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.AtomicForward {
+		n += 2
+	}
+	return n
+}
+
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ForwardChannel)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovPacket(uint64(m.ForwardSequence))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPacket(uint64(m.Sequence))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		n += 1 + sovPacket(uint64(m.TimeoutRevisionNumber))
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		n += 1 + sovPacket(uint64(m.TimeoutRevisionHeight))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovPacket(uint64(m.TimeoutTimestamp))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.Unescrowed {
+		n += 2
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = m.RegistrationFee.Size()
+	n += 2 + l + sovPacket(uint64(l))
 	return n
 }
 
@@ -507,59 +1183,9 @@ func (m *RegisterAccountData) Unmarshal(dAtA []byte) error {
 			}
 			m.Fallback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPacket(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RegisterAccountMemo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPacket
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAccountMemo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAccountMemo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Noble", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -586,10 +1212,8 @@ func (m *RegisterAccountMemo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Noble == nil {
-				m.Noble = &RegisterAccountMemo_RegisterAccountDataWrapper{}
-			}
-			if err := m.Noble.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Memos = append(m.Memos, MemoEntry{})
+			if err := m.Memos[len(m.Memos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -614,7 +1238,7 @@ func (m *RegisterAccountMemo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterAccountMemo_RegisterAccountDataWrapper) Unmarshal(dAtA []byte) error {
+func (m *RegisterAccountAcknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -637,17 +1261,36 @@ func (m *RegisterAccountMemo_RegisterAccountDataWrapper) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAccountDataWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterAccountAcknowledgement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAccountDataWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterAccountAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -657,25 +1300,786 @@ func (m *RegisterAccountMemo_RegisterAccountDataWrapper) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forwarding == nil {
-				m.Forwarding = &RegisterAccountData{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterAccountMemo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterAccountMemo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterAccountMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Noble", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Noble == nil {
+				m.Noble = &RegisterAccountMemo_RegisterAccountDataWrapper{}
+			}
+			if err := m.Noble.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterAccountMemo_RegisterAccountDataWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterAccountDataWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterAccountDataWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forwarding == nil {
+				m.Forwarding = &RegisterAccountData{}
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AtomicForward = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+			}
+			m.TimeoutRevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+			}
+			m.TimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unescrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unescrowed = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -118,6 +118,12 @@ type QueryAddress struct {
 	Channel   string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Fallback  string `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// address_version is the scheme used to derive the address, defaulting to
+	// the latest version if unspecified.
+	AddressVersion AddressVersion `protobuf:"varint,4,opt,name=address_version,json=addressVersion,proto3,enum=noble.forwarding.v1.AddressVersion" json:"address_version,omitempty"`
+	// chain_id optionally identifies the counterparty chain, whose registered
+	// channel is used if the channel is unspecified.
+	ChainId string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryAddress) Reset()         { *m = QueryAddress{} }
@@ -154,8 +160,10 @@ func (m *QueryAddress) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryAddress proto.InternalMessageInfo
 
 type QueryAddressResponse struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Exists  bool   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	Address        string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Exists         bool           `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	AddressVersion AddressVersion `protobuf:"varint,3,opt,name=address_version,json=addressVersion,proto3,enum=noble.forwarding.v1.AddressVersion" json:"address_version,omitempty"`
+	Channel        string         `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
 func (m *QueryAddressResponse) Reset()         { *m = QueryAddressResponse{} }
@@ -205,6 +213,173 @@ func (m *QueryAddressResponse) GetExists() bool {
 	return false
 }
 
+func (m *QueryAddressResponse) GetAddressVersion() AddressVersion {
+	if m != nil {
+		return m.AddressVersion
+	}
+	return ADDRESS_VERSION_UNSPECIFIED
+}
+
+func (m *QueryAddressResponse) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type QueryAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccount) Reset()         { *m = QueryAccount{} }
+func (m *QueryAccount) String() string { return proto.CompactTextString(m) }
+func (*QueryAccount) ProtoMessage()    {}
+func (*QueryAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{4}
+}
+func (m *QueryAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccount.Merge(m, src)
+}
+func (m *QueryAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccount proto.InternalMessageInfo
+
+type QueryAccountResponse struct {
+	Address        string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Channel        string                                   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Recipient      string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Fallback       string                                   `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	CreatedAt      int64                                    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Balances       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	Memos          []MemoEntry                              `protobuf:"bytes,7,rep,name=memos,proto3" json:"memos"`
+	ChainId        string                                   `protobuf:"bytes,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ChannelState   string                                   `protobuf:"bytes,9,opt,name=channel_state,json=channelState,proto3" json:"channel_state,omitempty"`
+	AddressVersion AddressVersion                           `protobuf:"varint,10,opt,name=address_version,json=addressVersion,proto3,enum=noble.forwarding.v1.AddressVersion" json:"address_version,omitempty"`
+}
+
+func (m *QueryAccountResponse) Reset()         { *m = QueryAccountResponse{} }
+func (m *QueryAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountResponse) ProtoMessage()    {}
+func (*QueryAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{5}
+}
+func (m *QueryAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountResponse.Merge(m, src)
+}
+func (m *QueryAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountResponse proto.InternalMessageInfo
+
+func (m *QueryAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetFallback() string {
+	if m != nil {
+		return m.Fallback
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *QueryAccountResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QueryAccountResponse) GetMemos() []MemoEntry {
+	if m != nil {
+		return m.Memos
+	}
+	return nil
+}
+
+func (m *QueryAccountResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetChannelState() string {
+	if m != nil {
+		return m.ChannelState
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetAddressVersion() AddressVersion {
+	if m != nil {
+		return m.AddressVersion
+	}
+	return ADDRESS_VERSION_UNSPECIFIED
+}
+
 type QueryStats struct {
 }
 
@@ -212,7 +387,7 @@ func (m *QueryStats) Reset()         { *m = QueryStats{} }
 func (m *QueryStats) String() string { return proto.CompactTextString(m) }
 func (*QueryStats) ProtoMessage()    {}
 func (*QueryStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{4}
+	return fileDescriptor_fc601bfb5b0b1c63, []int{6}
 }
 func (m *QueryStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +424,7 @@ func (m *QueryStatsResponse) Reset()         { *m = QueryStatsResponse{} }
 func (m *QueryStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStatsResponse) ProtoMessage()    {}
 func (*QueryStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{5}
+	return fileDescriptor_fc601bfb5b0b1c63, []int{7}
 }
 func (m *QueryStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +468,7 @@ func (m *QueryStatsByChannel) Reset()         { *m = QueryStatsByChannel{} }
 func (m *QueryStatsByChannel) String() string { return proto.CompactTextString(m) }
 func (*QueryStatsByChannel) ProtoMessage()    {}
 func (*QueryStatsByChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{6}
+	return fileDescriptor_fc601bfb5b0b1c63, []int{8}
 }
 func (m *QueryStatsByChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,13 +501,16 @@ type QueryStatsByChannelResponse struct {
 	NumOfAccounts  uint64                                   `protobuf:"varint,1,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
 	NumOfForwards  uint64                                   `protobuf:"varint,2,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
 	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	// num_of_registration_failures is the number of soft-failed registrations
+	// through transfer memos received on the channel.
+	NumOfRegistrationFailures uint64 `protobuf:"varint,4,opt,name=num_of_registration_failures,json=numOfRegistrationFailures,proto3" json:"num_of_registration_failures,omitempty"`
 }
 
 func (m *QueryStatsByChannelResponse) Reset()         { *m = QueryStatsByChannelResponse{} }
 func (m *QueryStatsByChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStatsByChannelResponse) ProtoMessage()    {}
 func (*QueryStatsByChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{7}
+	return fileDescriptor_fc601bfb5b0b1c63, []int{9}
 }
 func (m *QueryStatsByChannelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,25 +560,29 @@ func (m *QueryStatsByChannelResponse) GetTotalForwarded() github_com_cosmos_cosm
 	return nil
 }
 
-type Stats struct {
-	ChainId        string                                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	NumOfAccounts  uint64                                   `protobuf:"varint,2,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
-	NumOfForwards  uint64                                   `protobuf:"varint,3,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
-	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+func (m *QueryStatsByChannelResponse) GetNumOfRegistrationFailures() uint64 {
+	if m != nil {
+		return m.NumOfRegistrationFailures
+	}
+	return 0
 }
 
-func (m *Stats) Reset()         { *m = Stats{} }
-func (m *Stats) String() string { return proto.CompactTextString(m) }
-func (*Stats) ProtoMessage()    {}
-func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{8}
+type QueryStatsByChain struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
-func (m *Stats) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryStatsByChain) Reset()         { *m = QueryStatsByChain{} }
+func (m *QueryStatsByChain) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsByChain) ProtoMessage()    {}
+func (*QueryStatsByChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{10}
+}
+func (m *QueryStatsByChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStatsByChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStatsByChain.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -410,63 +592,114 @@ func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Stats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stats.Merge(m, src)
+func (m *QueryStatsByChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsByChain.Merge(m, src)
 }
-func (m *Stats) XXX_Size() int {
+func (m *QueryStatsByChain) XXX_Size() int {
 	return m.Size()
 }
-func (m *Stats) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stats.DiscardUnknown(m)
+func (m *QueryStatsByChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsByChain.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stats proto.InternalMessageInfo
+var xxx_messageInfo_QueryStatsByChain proto.InternalMessageInfo
 
-func (m *Stats) GetChainId() string {
-	if m != nil {
-		return m.ChainId
+type QueryStatsByChainResponse struct {
+	NumOfAccounts  uint64                                   `protobuf:"varint,1,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
+	NumOfForwards  uint64                                   `protobuf:"varint,2,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	// channels are the channels connected to the chain that have accounts or
+	// registration failures.
+	Channels []string `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	// num_of_registration_failures is the number of soft-failed registrations
+	// through transfer memos received on the channels.
+	NumOfRegistrationFailures uint64 `protobuf:"varint,5,opt,name=num_of_registration_failures,json=numOfRegistrationFailures,proto3" json:"num_of_registration_failures,omitempty"`
+}
+
+func (m *QueryStatsByChainResponse) Reset()         { *m = QueryStatsByChainResponse{} }
+func (m *QueryStatsByChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsByChainResponse) ProtoMessage()    {}
+func (*QueryStatsByChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{11}
+}
+func (m *QueryStatsByChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsByChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryStatsByChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsByChainResponse.Merge(m, src)
+}
+func (m *QueryStatsByChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsByChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsByChainResponse.DiscardUnknown(m)
 }
 
-func (m *Stats) GetNumOfAccounts() uint64 {
+var xxx_messageInfo_QueryStatsByChainResponse proto.InternalMessageInfo
+
+func (m *QueryStatsByChainResponse) GetNumOfAccounts() uint64 {
 	if m != nil {
 		return m.NumOfAccounts
 	}
 	return 0
 }
 
-func (m *Stats) GetNumOfForwards() uint64 {
+func (m *QueryStatsByChainResponse) GetNumOfForwards() uint64 {
 	if m != nil {
 		return m.NumOfForwards
 	}
 	return 0
 }
 
-func (m *Stats) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryStatsByChainResponse) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.TotalForwarded
 	}
 	return nil
 }
 
-type QueryMemo struct {
+func (m *QueryStatsByChainResponse) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *QueryStatsByChainResponse) GetNumOfRegistrationFailures() uint64 {
+	if m != nil {
+		return m.NumOfRegistrationFailures
+	}
+	return 0
+}
+
+type QueryStatsByAccount struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryMemo) Reset()         { *m = QueryMemo{} }
-func (m *QueryMemo) String() string { return proto.CompactTextString(m) }
-func (*QueryMemo) ProtoMessage()    {}
-func (*QueryMemo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{9}
+func (m *QueryStatsByAccount) Reset()         { *m = QueryStatsByAccount{} }
+func (m *QueryStatsByAccount) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsByAccount) ProtoMessage()    {}
+func (*QueryStatsByAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{12}
 }
-func (m *QueryMemo) XXX_Unmarshal(b []byte) error {
+func (m *QueryStatsByAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStatsByAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMemo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStatsByAccount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -476,34 +709,37 @@ func (m *QueryMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueryMemo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMemo.Merge(m, src)
+func (m *QueryStatsByAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsByAccount.Merge(m, src)
 }
-func (m *QueryMemo) XXX_Size() int {
+func (m *QueryStatsByAccount) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMemo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMemo.DiscardUnknown(m)
+func (m *QueryStatsByAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsByAccount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMemo proto.InternalMessageInfo
+var xxx_messageInfo_QueryStatsByAccount proto.InternalMessageInfo
 
-type QueryMemoResponse struct {
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+type QueryStatsByAccountResponse struct {
+	NumOfForwards      uint64                                   `protobuf:"varint,1,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+	TotalForwarded     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	FirstForwardHeight int64                                    `protobuf:"varint,3,opt,name=first_forward_height,json=firstForwardHeight,proto3" json:"first_forward_height,omitempty"`
+	LastForwardHeight  int64                                    `protobuf:"varint,4,opt,name=last_forward_height,json=lastForwardHeight,proto3" json:"last_forward_height,omitempty"`
 }
 
-func (m *QueryMemoResponse) Reset()         { *m = QueryMemoResponse{} }
-func (m *QueryMemoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMemoResponse) ProtoMessage()    {}
-func (*QueryMemoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{10}
+func (m *QueryStatsByAccountResponse) Reset()         { *m = QueryStatsByAccountResponse{} }
+func (m *QueryStatsByAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsByAccountResponse) ProtoMessage()    {}
+func (*QueryStatsByAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{13}
 }
-func (m *QueryMemoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStatsByAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMemoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStatsByAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMemoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStatsByAccountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -513,43 +749,69 @@ func (m *QueryMemoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryMemoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMemoResponse.Merge(m, src)
+func (m *QueryStatsByAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsByAccountResponse.Merge(m, src)
 }
-func (m *QueryMemoResponse) XXX_Size() int {
+func (m *QueryStatsByAccountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMemoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMemoResponse.DiscardUnknown(m)
+func (m *QueryStatsByAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsByAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMemoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStatsByAccountResponse proto.InternalMessageInfo
 
-func (m *QueryMemoResponse) GetMemo() string {
+func (m *QueryStatsByAccountResponse) GetNumOfForwards() uint64 {
 	if m != nil {
-		return m.Memo
+		return m.NumOfForwards
 	}
-	return ""
+	return 0
 }
 
-type QueryMemos struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *QueryStatsByAccountResponse) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalForwarded
+	}
+	return nil
+}
+
+func (m *QueryStatsByAccountResponse) GetFirstForwardHeight() int64 {
+	if m != nil {
+		return m.FirstForwardHeight
+	}
+	return 0
+}
+
+func (m *QueryStatsByAccountResponse) GetLastForwardHeight() int64 {
+	if m != nil {
+		return m.LastForwardHeight
+	}
+	return 0
+}
+
+type QueryStatsHistory struct {
+	// start_day is the first day (inclusive) of the range, in days since the Unix epoch.
+	StartDay uint64 `protobuf:"varint,1,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	// end_day is the last day (inclusive) of the range, in days since the Unix epoch.
+	EndDay uint64 `protobuf:"varint,2,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	// channel optionally restricts the results to a single channel.
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
 	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMemos) Reset()         { *m = QueryMemos{} }
-func (m *QueryMemos) String() string { return proto.CompactTextString(m) }
-func (*QueryMemos) ProtoMessage()    {}
-func (*QueryMemos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{11}
+func (m *QueryStatsHistory) Reset()         { *m = QueryStatsHistory{} }
+func (m *QueryStatsHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsHistory) ProtoMessage()    {}
+func (*QueryStatsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{14}
 }
-func (m *QueryMemos) XXX_Unmarshal(b []byte) error {
+func (m *QueryStatsHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMemos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStatsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMemos.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStatsHistory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -559,36 +821,36 @@ func (m *QueryMemos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueryMemos) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMemos.Merge(m, src)
+func (m *QueryStatsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsHistory.Merge(m, src)
 }
-func (m *QueryMemos) XXX_Size() int {
+func (m *QueryStatsHistory) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMemos) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMemos.DiscardUnknown(m)
+func (m *QueryStatsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMemos proto.InternalMessageInfo
+var xxx_messageInfo_QueryStatsHistory proto.InternalMessageInfo
 
-type QueryMemosResponse struct {
-	Memos []MemoEntry `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos"`
+type QueryStatsHistoryResponse struct {
+	Buckets []StatsBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMemosResponse) Reset()         { *m = QueryMemosResponse{} }
-func (m *QueryMemosResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMemosResponse) ProtoMessage()    {}
-func (*QueryMemosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc601bfb5b0b1c63, []int{12}
+func (m *QueryStatsHistoryResponse) Reset()         { *m = QueryStatsHistoryResponse{} }
+func (m *QueryStatsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsHistoryResponse) ProtoMessage()    {}
+func (*QueryStatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{15}
 }
-func (m *QueryMemosResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStatsHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMemosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStatsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMemosResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStatsHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -598,1109 +860,6934 @@ func (m *QueryMemosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryMemosResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMemosResponse.Merge(m, src)
+func (m *QueryStatsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsHistoryResponse.Merge(m, src)
 }
-func (m *QueryMemosResponse) XXX_Size() int {
+func (m *QueryStatsHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMemosResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMemosResponse.DiscardUnknown(m)
+func (m *QueryStatsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMemosResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStatsHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryMemosResponse) GetMemos() []MemoEntry {
+func (m *QueryStatsHistoryResponse) GetBuckets() []StatsBucket {
 	if m != nil {
-		return m.Memos
+		return m.Buckets
 	}
 	return nil
 }
 
-func (m *QueryMemosResponse) GetPagination() *query.PageResponse {
+func (m *QueryStatsHistoryResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryDenoms)(nil), "noble.forwarding.v1.QueryDenoms")
-	proto.RegisterType((*QueryDenomsResponse)(nil), "noble.forwarding.v1.QueryDenomsResponse")
-	proto.RegisterType((*QueryAddress)(nil), "noble.forwarding.v1.QueryAddress")
-	proto.RegisterType((*QueryAddressResponse)(nil), "noble.forwarding.v1.QueryAddressResponse")
-	proto.RegisterType((*QueryStats)(nil), "noble.forwarding.v1.QueryStats")
-	proto.RegisterType((*QueryStatsResponse)(nil), "noble.forwarding.v1.QueryStatsResponse")
-	proto.RegisterMapType((map[string]Stats)(nil), "noble.forwarding.v1.QueryStatsResponse.StatsEntry")
-	proto.RegisterType((*QueryStatsByChannel)(nil), "noble.forwarding.v1.QueryStatsByChannel")
-	proto.RegisterType((*QueryStatsByChannelResponse)(nil), "noble.forwarding.v1.QueryStatsByChannelResponse")
-	proto.RegisterType((*Stats)(nil), "noble.forwarding.v1.Stats")
-	proto.RegisterType((*QueryMemo)(nil), "noble.forwarding.v1.QueryMemo")
-	proto.RegisterType((*QueryMemoResponse)(nil), "noble.forwarding.v1.QueryMemoResponse")
-	proto.RegisterType((*QueryMemos)(nil), "noble.forwarding.v1.QueryMemos")
-	proto.RegisterType((*QueryMemosResponse)(nil), "noble.forwarding.v1.QueryMemosResponse")
+type Stats struct {
+	ChainId        string                                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	NumOfAccounts  uint64                                   `protobuf:"varint,2,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
+	NumOfForwards  uint64                                   `protobuf:"varint,3,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	// client_type is the type of the channel's light client, if found.
+	ClientType string `protobuf:"bytes,5,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// num_of_registration_failures is the number of soft-failed registrations
+	// through transfer memos received on the channel.
+	NumOfRegistrationFailures uint64 `protobuf:"varint,6,opt,name=num_of_registration_failures,json=numOfRegistrationFailures,proto3" json:"num_of_registration_failures,omitempty"`
 }
 
-func init() { proto.RegisterFile("noble/forwarding/v1/query.proto", fileDescriptor_fc601bfb5b0b1c63) }
-
-var fileDescriptor_fc601bfb5b0b1c63 = []byte{
-	// 1016 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x71, 0x7e, 0xbc, 0xa4, 0x29, 0x9d, 0xe6, 0xe0, 0x6c, 0xd2, 0x75, 0x58, 0xa1,
-	0xd4, 0x04, 0xbc, 0x53, 0x9b, 0x0b, 0x44, 0x42, 0xa8, 0x0e, 0x4d, 0x05, 0x12, 0xbf, 0x5c, 0x4e,
-	0x5c, 0xac, 0xf1, 0x7a, 0xb2, 0x59, 0x65, 0x3d, 0xe3, 0x7a, 0xd6, 0x29, 0x56, 0x14, 0x0e, 0x5c,
-	0x88, 0x90, 0x90, 0x10, 0x70, 0x81, 0x0b, 0x95, 0xb8, 0x20, 0x4e, 0x3d, 0x70, 0xe2, 0x2f, 0xe8,
-	0xb1, 0x82, 0x0b, 0x27, 0xa8, 0x12, 0xa4, 0xf2, 0x67, 0xa0, 0x9d, 0x99, 0x5d, 0xaf, 0xc3, 0x3a,
-	0x4e, 0x7b, 0xe9, 0xc5, 0xde, 0x99, 0xf7, 0xbd, 0xf7, 0xbe, 0xfd, 0xde, 0xbc, 0x37, 0x0b, 0x25,
-	0xc6, 0x5b, 0x01, 0xc5, 0xbb, 0xbc, 0x77, 0x8f, 0xf4, 0xda, 0x3e, 0xf3, 0xf0, 0x41, 0x15, 0xdf,
-	0xed, 0xd3, 0xde, 0xc0, 0xe9, 0xf6, 0x78, 0xc8, 0xd1, 0x55, 0x09, 0x70, 0x86, 0x00, 0xe7, 0xa0,
-	0x6a, 0x5e, 0x21, 0x1d, 0x9f, 0x71, 0x2c, 0x7f, 0x15, 0xce, 0xdc, 0x74, 0xb9, 0xe8, 0x70, 0x81,
-	0x5b, 0x44, 0x50, 0x15, 0x00, 0x1f, 0x54, 0x5b, 0x34, 0x24, 0x55, 0xdc, 0x25, 0x9e, 0xcf, 0x48,
-	0xe8, 0x73, 0xa6, 0xb1, 0x56, 0x1a, 0x1b, 0xa3, 0x5c, 0xee, 0xc7, 0xf6, 0x55, 0x6d, 0x8f, 0xc3,
-	0xa4, 0x09, 0x99, 0x2b, 0xca, 0xd8, 0x94, 0x2b, 0xac, 0x16, 0xda, 0xb4, 0xec, 0x71, 0x8f, 0xab,
-	0xfd, 0xe8, 0x49, 0xef, 0xae, 0x79, 0x9c, 0x7b, 0x01, 0xc5, 0xa4, 0xeb, 0x63, 0xc2, 0x18, 0x0f,
-	0x25, 0x95, 0xd8, 0xc7, 0xca, 0x12, 0xa0, 0x43, 0x3b, 0xda, 0xdb, 0xbe, 0x04, 0x0b, 0x1f, 0x45,
-	0xd9, 0xdf, 0xa6, 0x8c, 0x77, 0x84, 0xbd, 0x0d, 0x57, 0x53, 0xcb, 0x06, 0x15, 0x5d, 0xce, 0x04,
-	0x45, 0xaf, 0xc2, 0x12, 0x09, 0x02, 0x7e, 0x8f, 0xb6, 0x9b, 0x6d, 0x69, 0x29, 0x1a, 0xeb, 0x53,
-	0xe5, 0xf9, 0x7a, 0xe1, 0xe7, 0x27, 0x0f, 0x36, 0x8d, 0xc6, 0x25, 0x6d, 0xd4, 0x41, 0x02, 0x58,
-	0x94, 0x41, 0x6e, 0xb6, 0xdb, 0x3d, 0x2a, 0x04, 0x2a, 0xc2, 0xac, 0xbb, 0x47, 0x18, 0xa3, 0x41,
-	0xd1, 0x58, 0x37, 0xca, 0xf3, 0x8d, 0x78, 0x89, 0xd6, 0x60, 0xbe, 0x47, 0x5d, 0xbf, 0xeb, 0x53,
-	0x16, 0x16, 0xf3, 0xd2, 0x36, 0xdc, 0x40, 0x26, 0xcc, 0xed, 0x92, 0x20, 0x68, 0x11, 0x77, 0xbf,
-	0x38, 0x25, 0x8d, 0xc9, 0x7a, 0x6b, 0xee, 0xf8, 0x7e, 0x29, 0xf7, 0xef, 0xfd, 0x52, 0xce, 0xf6,
-	0x61, 0x39, 0x9d, 0x2d, 0xe1, 0x5c, 0x83, 0x59, 0xa2, 0xb6, 0x54, 0xd6, 0x7a, 0xf1, 0xf7, 0x5f,
-	0x2b, 0xcb, 0x5a, 0x50, 0x0d, 0xbe, 0x13, 0xf6, 0x7c, 0xe6, 0x35, 0x62, 0x20, 0xba, 0x06, 0x33,
-	0xf4, 0x53, 0x5f, 0x84, 0x42, 0x92, 0x99, 0x8b, 0xdf, 0x4f, 0x6f, 0xda, 0x8b, 0x00, 0x32, 0xd5,
-	0x9d, 0x90, 0x84, 0xc2, 0xfe, 0xcd, 0x00, 0x34, 0x5c, 0x26, 0x79, 0xdf, 0x87, 0x82, 0x88, 0x36,
-	0xa4, 0x44, 0x0b, 0xb5, 0x9a, 0x93, 0x71, 0xc2, 0x9c, 0xff, 0xfb, 0x39, 0x72, 0x75, 0x8b, 0x85,
-	0xbd, 0x41, 0x7d, 0xfa, 0xe1, 0x5f, 0xa5, 0x5c, 0x43, 0x85, 0x31, 0x3f, 0x06, 0x18, 0x9a, 0xd0,
-	0x0b, 0x30, 0xb5, 0x4f, 0x07, 0x5a, 0xc7, 0xe8, 0x11, 0xdd, 0x80, 0xc2, 0x01, 0x09, 0xfa, 0x54,
-	0x52, 0x5e, 0xa8, 0x99, 0x99, 0xf9, 0x54, 0x2a, 0x05, 0xdc, 0xca, 0xbf, 0x6e, 0xd8, 0x6f, 0xe8,
-	0x42, 0x4b, 0x43, 0x7d, 0xb0, 0xad, 0x0b, 0x32, 0xb6, 0x54, 0x29, 0xc1, 0x8f, 0xf3, 0xb0, 0x9a,
-	0xe1, 0x9b, 0x08, 0x50, 0x81, 0xcb, 0xac, 0xdf, 0x69, 0xf2, 0xdd, 0x26, 0x71, 0x5d, 0xde, 0x67,
-	0xa1, 0x2a, 0xc0, 0x74, 0x72, 0x5a, 0x58, 0xbf, 0xf3, 0xc1, 0xee, 0x4d, 0x6d, 0x4b, 0xc1, 0x35,
-	0x65, 0x25, 0xfe, 0x19, 0xf8, 0x8e, 0xb6, 0xa1, 0x2f, 0x0d, 0xb8, 0x1c, 0xf2, 0x90, 0x04, 0x31,
-	0x9c, 0xb6, 0x8b, 0x53, 0x52, 0xe9, 0x15, 0x47, 0x17, 0x37, 0xea, 0x3b, 0x47, 0xf7, 0x9d, 0xb3,
-	0xcd, 0x7d, 0x56, 0xdf, 0x89, 0x04, 0xfd, 0xe5, 0xef, 0x52, 0xd9, 0xf3, 0xc3, 0xbd, 0x7e, 0xcb,
-	0x71, 0x79, 0x47, 0xb7, 0x96, 0xfe, 0xab, 0x88, 0xf6, 0x3e, 0x0e, 0x07, 0x5d, 0x2a, 0xa4, 0x83,
-	0xf8, 0xe1, 0xc9, 0x83, 0xcd, 0xc5, 0x80, 0x7a, 0xc4, 0x1d, 0x34, 0xa3, 0xce, 0x15, 0x8a, 0xcb,
-	0x92, 0xcc, 0xbc, 0x13, 0x27, 0xb6, 0xbf, 0xcb, 0x43, 0x41, 0xaa, 0x80, 0xd6, 0x61, 0xce, 0xdd,
-	0x23, 0x3e, 0x6b, 0xfa, 0x6d, 0x7d, 0xdc, 0x34, 0xfd, 0x59, 0xb9, 0xfd, 0x4e, 0x3b, 0x4b, 0x96,
-	0xfc, 0xd3, 0xc9, 0x32, 0xf5, 0x94, 0xb2, 0x4c, 0x3f, 0x2f, 0x59, 0x9a, 0x30, 0x2f, 0x0f, 0xc8,
-	0x7b, 0xb4, 0xc3, 0x9f, 0xa9, 0x0f, 0x97, 0xa1, 0x20, 0xe7, 0x8c, 0x9e, 0x09, 0x6a, 0x91, 0x3a,
-	0x82, 0x0e, 0x5c, 0x49, 0x12, 0x24, 0xe7, 0x6e, 0x05, 0xa6, 0xa3, 0xc1, 0x36, 0x2a, 0xbf, 0xdc,
-	0xb2, 0xbf, 0x31, 0x74, 0xe7, 0x46, 0x0e, 0xe2, 0x99, 0x28, 0xed, 0x00, 0x0c, 0x07, 0xbd, 0xee,
-	0xb5, 0x8d, 0x11, 0x69, 0xd5, 0x14, 0x8f, 0x05, 0xfe, 0x90, 0x78, 0xb4, 0x41, 0xef, 0xf6, 0xa9,
-	0x08, 0x1b, 0x29, 0xcf, 0xd4, 0x4b, 0x7c, 0x1f, 0xcf, 0x0f, 0x49, 0x2a, 0x79, 0x8d, 0x2d, 0x28,
-	0x44, 0x9c, 0xe3, 0xf9, 0x61, 0x65, 0xf6, 0x73, 0xe4, 0x32, 0x32, 0x2b, 0xa4, 0x0b, 0xba, 0x9d,
-	0x41, 0xf2, 0xfa, 0x44, 0x92, 0x2a, 0x71, 0x9a, 0x65, 0xed, 0xf1, 0x0c, 0x14, 0x24, 0x37, 0xf4,
-	0x19, 0xcc, 0xa8, 0xb1, 0x8e, 0xd6, 0xc7, 0x4f, 0x32, 0x85, 0x30, 0xcb, 0x93, 0x10, 0x71, 0x2e,
-	0xbb, 0x7c, 0x1c, 0x55, 0xe7, 0xf3, 0x3f, 0xfe, 0xf9, 0x36, 0x7f, 0x0d, 0xad, 0xe2, 0xac, 0x4b,
-	0x4a, 0x5d, 0x34, 0xe8, 0x27, 0x03, 0x66, 0xe3, 0x8b, 0xe4, 0xc5, 0xf1, 0xf1, 0x35, 0xc4, 0x7c,
-	0x79, 0x22, 0x24, 0xe1, 0xf0, 0xee, 0x90, 0xc3, 0x5b, 0xe8, 0xcd, 0x4c, 0x0e, 0xba, 0xf8, 0xf8,
-	0x50, 0x4f, 0xc1, 0x23, 0x7c, 0x98, 0x5c, 0x4f, 0x47, 0xf8, 0x30, 0xbe, 0x8d, 0x8e, 0x50, 0x3f,
-	0x9e, 0x03, 0xa5, 0x09, 0xe3, 0xde, 0xbc, 0x7e, 0xc1, 0xfb, 0xc0, 0xb6, 0x25, 0xb3, 0x35, 0x64,
-	0x66, 0x32, 0x93, 0x77, 0x03, 0xfa, 0xd1, 0x80, 0xa5, 0x33, 0x13, 0xbc, 0x3c, 0x21, 0x7e, 0x82,
-	0x34, 0x6f, 0x5c, 0x14, 0x99, 0x50, 0xaa, 0x0e, 0x15, 0xdb, 0x40, 0x2f, 0x8d, 0xe7, 0x35, 0xd4,
-	0x0b, 0x7d, 0x65, 0xc0, 0xec, 0x6d, 0x1a, 0xca, 0x49, 0x60, 0x8d, 0x4f, 0x18, 0xd9, 0xcd, 0x8d,
-	0xf3, 0xed, 0x09, 0x8d, 0xad, 0x21, 0x0d, 0x8c, 0x2a, 0x78, 0xdc, 0x17, 0x8e, 0xc0, 0x87, 0xba,
-	0x7e, 0x47, 0xb8, 0x35, 0x50, 0x1f, 0x2e, 0xe8, 0x0b, 0x03, 0xe6, 0x34, 0x9f, 0x73, 0x8b, 0x25,
-	0x01, 0xe7, 0x15, 0x6b, 0xa4, 0x69, 0x2f, 0xa2, 0xcc, 0x19, 0x4a, 0xf5, 0x5b, 0x0f, 0x4f, 0x2c,
-	0xe3, 0xd1, 0x89, 0x65, 0x3c, 0x3e, 0xb1, 0x8c, 0xaf, 0x4f, 0xad, 0xdc, 0xa3, 0x53, 0x2b, 0xf7,
-	0xe7, 0xa9, 0x95, 0xfb, 0xe4, 0x95, 0xd4, 0x38, 0x96, 0x91, 0x2a, 0x44, 0x08, 0x1a, 0x8a, 0x91,
-	0x80, 0x35, 0x35, 0x97, 0x5b, 0x33, 0xf2, 0x3b, 0xee, 0xb5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xc1, 0xfe, 0xc6, 0xdb, 0xea, 0x0a, 0x00, 0x00,
+func (m *Stats) Reset()         { *m = Stats{} }
+func (m *Stats) String() string { return proto.CompactTextString(m) }
+func (*Stats) ProtoMessage()    {}
+func (*Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
-	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
-	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
-	StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error)
-	GetMemo(ctx context.Context, in *QueryMemo, opts ...grpc.CallOption) (*QueryMemoResponse, error)
-	GetMemos(ctx context.Context, in *QueryMemos, opts ...grpc.CallOption) (*QueryMemosResponse, error)
+func (m *Stats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
 }
-
-func (c *queryClient) Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
-	out := new(QueryDenomsResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Denoms", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Stats) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats.DiscardUnknown(m)
 }
 
-func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
-	out := new(QueryAddressResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Address", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Stats proto.InternalMessageInfo
+
+func (m *Stats) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
-	out := new(QueryStatsResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Stats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Stats) GetNumOfAccounts() uint64 {
+	if m != nil {
+		return m.NumOfAccounts
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error) {
-	out := new(QueryStatsByChannelResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/StatsByChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Stats) GetNumOfForwards() uint64 {
+	if m != nil {
+		return m.NumOfForwards
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetMemo(ctx context.Context, in *QueryMemo, opts ...grpc.CallOption) (*QueryMemoResponse, error) {
-	out := new(QueryMemoResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/GetMemo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Stats) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalForwarded
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetMemos(ctx context.Context, in *QueryMemos, opts ...grpc.CallOption) (*QueryMemosResponse, error) {
-	out := new(QueryMemosResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/GetMemos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Stats) GetClientType() string {
+	if m != nil {
+		return m.ClientType
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error)
-	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
-	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
-	StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error)
-	GetMemo(context.Context, *QueryMemo) (*QueryMemoResponse, error)
-	GetMemos(context.Context, *QueryMemos) (*QueryMemosResponse, error)
+func (m *Stats) GetNumOfRegistrationFailures() uint64 {
+	if m != nil {
+		return m.NumOfRegistrationFailures
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryMemo struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Denoms(ctx context.Context, req *QueryDenoms) (*QueryDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+func (m *QueryMemo) Reset()         { *m = QueryMemo{} }
+func (m *QueryMemo) String() string { return proto.CompactTextString(m) }
+func (*QueryMemo) ProtoMessage()    {}
+func (*QueryMemo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{17}
 }
-func (*UnimplementedQueryServer) Address(ctx context.Context, req *QueryAddress) (*QueryAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
+func (m *QueryMemo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+func (m *QueryMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) StatsByChannel(ctx context.Context, req *QueryStatsByChannel) (*QueryStatsByChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsByChannel not implemented")
+func (m *QueryMemo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemo.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetMemo(ctx context.Context, req *QueryMemo) (*QueryMemoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
+func (m *QueryMemo) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetMemos(ctx context.Context, req *QueryMemos) (*QueryMemosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMemos not implemented")
+func (m *QueryMemo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemo.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryMemo proto.InternalMessageInfo
 
-func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenoms)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Denoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/Denoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Denoms(ctx, req.(*QueryDenoms))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryMemoResponse struct {
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Address(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/Address",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Address(ctx, req.(*QueryAddress))
+func (m *QueryMemoResponse) Reset()         { *m = QueryMemoResponse{} }
+func (m *QueryMemoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemoResponse) ProtoMessage()    {}
+func (*QueryMemoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{18}
+}
+func (m *QueryMemoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMemoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemoResponse.Merge(m, src)
+}
+func (m *QueryMemoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemoResponse.DiscardUnknown(m)
 }
 
-func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStats)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/Stats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryMemoResponse proto.InternalMessageInfo
 
-func _Query_StatsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatsByChannel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StatsByChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/StatsByChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StatsByChannel(ctx, req.(*QueryStatsByChannel))
+func (m *QueryMemoResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMemo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMemo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/GetMemo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMemo(ctx, req.(*QueryMemo))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryMemos struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMemos)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMemos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/GetMemos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMemos(ctx, req.(*QueryMemos))
+func (m *QueryMemos) Reset()         { *m = QueryMemos{} }
+func (m *QueryMemos) String() string { return proto.CompactTextString(m) }
+func (*QueryMemos) ProtoMessage()    {}
+func (*QueryMemos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{19}
+}
+func (m *QueryMemos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMemos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemos.Merge(m, src)
+}
+func (m *QueryMemos) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemos) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemos.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.forwarding.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Denoms",
-			Handler:    _Query_Denoms_Handler,
-		},
-		{
-			MethodName: "Address",
-			Handler:    _Query_Address_Handler,
-		},
-		{
-			MethodName: "Stats",
-			Handler:    _Query_Stats_Handler,
-		},
-		{
-			MethodName: "StatsByChannel",
-			Handler:    _Query_StatsByChannel_Handler,
-		},
-		{
-			MethodName: "GetMemo",
-			Handler:    _Query_GetMemo_Handler,
-		},
-		{
-			MethodName: "GetMemos",
-			Handler:    _Query_GetMemos_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/forwarding/v1/query.proto",
+var xxx_messageInfo_QueryMemos proto.InternalMessageInfo
+
+type QueryMemosResponse struct {
+	Memos []MemoEntry `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenoms) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMemosResponse) Reset()         { *m = QueryMemosResponse{} }
+func (m *QueryMemosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemosResponse) ProtoMessage()    {}
+func (*QueryMemosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{20}
+}
+func (m *QueryMemosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDenoms) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMemosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemosResponse.Merge(m, src)
+}
+func (m *QueryMemosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemosResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMemosResponse proto.InternalMessageInfo
+
+func (m *QueryMemosResponse) GetMemos() []MemoEntry {
+	if m != nil {
+		return m.Memos
+	}
+	return nil
 }
 
-func (m *QueryDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMemosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccounts struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDenoms) > 0 {
-		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedDenoms[iNdEx])
-			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAccounts) Reset()         { *m = QueryAccounts{} }
+func (m *QueryAccounts) String() string { return proto.CompactTextString(m) }
+func (*QueryAccounts) ProtoMessage()    {}
+func (*QueryAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{21}
+}
+func (m *QueryAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccounts.Merge(m, src)
+}
+func (m *QueryAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccounts.DiscardUnknown(m)
 }
 
-func (m *QueryAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccounts proto.InternalMessageInfo
+
+func (m *QueryAccounts) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountsResponse struct {
+	Accounts []ForwardingAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fallback) > 0 {
-		i -= len(m.Fallback)
-		copy(dAtA[i:], m.Fallback)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fallback)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAccountsResponse) Reset()         { *m = QueryAccountsResponse{} }
+func (m *QueryAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsResponse) ProtoMessage()    {}
+func (*QueryAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{22}
 }
-
-func (m *QueryAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsResponse.Merge(m, src)
+}
+func (m *QueryAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exists {
-		i--
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsResponse) GetAccounts() []ForwardingAccount {
+	if m != nil {
+		return m.Accounts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountsByChannel struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAccountsByChannel) Reset()         { *m = QueryAccountsByChannel{} }
+func (m *QueryAccountsByChannel) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByChannel) ProtoMessage()    {}
+func (*QueryAccountsByChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{23}
 }
-
-func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAccountsByChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByChannel.Merge(m, src)
+}
+func (m *QueryAccountsByChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByChannel.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAccountsByChannel proto.InternalMessageInfo
+
+type QueryAccountsByChannelResponse struct {
+	Accounts []ForwardingAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Stats) > 0 {
-		for k := range m.Stats {
-			v := m.Stats[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAccountsByChannelResponse) Reset()         { *m = QueryAccountsByChannelResponse{} }
+func (m *QueryAccountsByChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByChannelResponse) ProtoMessage()    {}
+func (*QueryAccountsByChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{24}
+}
+func (m *QueryAccountsByChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStatsByChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAccountsByChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByChannelResponse.Merge(m, src)
 }
-
-func (m *QueryStatsByChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountsByChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByChannelResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatsByChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAccountsByChannelResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByChannelResponse) GetAccounts() []ForwardingAccount {
+	if m != nil {
+		return m.Accounts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryStatsByChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStatsByChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountsByRecipient struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStatsByChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalForwarded) > 0 {
-		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryAccountsByRecipient) Reset()         { *m = QueryAccountsByRecipient{} }
+func (m *QueryAccountsByRecipient) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByRecipient) ProtoMessage()    {}
+func (*QueryAccountsByRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{25}
+}
+func (m *QueryAccountsByRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NumOfForwards != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.NumOfAccounts != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Stats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAccountsByRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByRecipient.Merge(m, src)
+}
+func (m *QueryAccountsByRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByRecipient.DiscardUnknown(m)
 }
 
-func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAccountsByRecipient proto.InternalMessageInfo
+
+type QueryAccountsByRecipientResponse struct {
+	Accounts []ForwardingAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalForwarded) > 0 {
-		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *QueryAccountsByRecipientResponse) Reset()         { *m = QueryAccountsByRecipientResponse{} }
+func (m *QueryAccountsByRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByRecipientResponse) ProtoMessage()    {}
+func (*QueryAccountsByRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{26}
+}
+func (m *QueryAccountsByRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NumOfForwards != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.NumOfAccounts != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMemo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAccountsByRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByRecipientResponse.Merge(m, src)
 }
-
-func (m *QueryMemo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountsByRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByRecipientResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAccountsByRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByRecipientResponse) GetAccounts() []ForwardingAccount {
+	if m != nil {
+		return m.Accounts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMemoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByRecipientResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMemoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountsByFallback struct {
+	Fallback string `protobuf:"bytes,1,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMemoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAccountsByFallback) Reset()         { *m = QueryAccountsByFallback{} }
+func (m *QueryAccountsByFallback) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByFallback) ProtoMessage()    {}
+func (*QueryAccountsByFallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{27}
 }
-
-func (m *QueryMemos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByFallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByFallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByFallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAccountsByFallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByFallback.Merge(m, src)
+}
+func (m *QueryAccountsByFallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByFallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByFallback.DiscardUnknown(m)
 }
 
-func (m *QueryMemos) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAccountsByFallback proto.InternalMessageInfo
+
+type QueryAccountsByFallbackResponse struct {
+	Accounts []ForwardingAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMemos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAccountsByFallbackResponse) Reset()         { *m = QueryAccountsByFallbackResponse{} }
+func (m *QueryAccountsByFallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByFallbackResponse) ProtoMessage()    {}
+func (*QueryAccountsByFallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{28}
+}
+func (m *QueryAccountsByFallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByFallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByFallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryAccountsByFallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByFallbackResponse.Merge(m, src)
+}
+func (m *QueryAccountsByFallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByFallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByFallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByFallbackResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByFallbackResponse) GetAccounts() []ForwardingAccount {
+	if m != nil {
+		return m.Accounts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMemosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByFallbackResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMemosResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryForwardHistory struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMemosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Memos) > 0 {
-		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Memos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryForwardHistory) Reset()         { *m = QueryForwardHistory{} }
+func (m *QueryForwardHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardHistory) ProtoMessage()    {}
+func (*QueryForwardHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{29}
+}
+func (m *QueryForwardHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryForwardHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardHistory.Merge(m, src)
 }
-func (m *QueryDenoms) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryForwardHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardHistory.DiscardUnknown(m)
 }
 
-func (m *QueryDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AllowedDenoms) > 0 {
-		for _, s := range m.AllowedDenoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryForwardHistory proto.InternalMessageInfo
+
+type QueryForwardHistoryResponse struct {
+	Records []ForwardRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardHistoryResponse) Reset()         { *m = QueryForwardHistoryResponse{} }
+func (m *QueryForwardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardHistoryResponse) ProtoMessage()    {}
+func (*QueryForwardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{30}
+}
+func (m *QueryForwardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryForwardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardHistoryResponse.Merge(m, src)
+}
+func (m *QueryForwardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Fallback)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryForwardHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryForwardHistoryResponse) GetRecords() []ForwardRecord {
+	if m != nil {
+		return m.Records
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Exists {
-		n += 2
+func (m *QueryForwardHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryChains struct {
 }
 
-func (m *QueryStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChains) Reset()         { *m = QueryChains{} }
+func (m *QueryChains) String() string { return proto.CompactTextString(m) }
+func (*QueryChains) ProtoMessage()    {}
+func (*QueryChains) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{31}
+}
+func (m *QueryChains) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChains.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Stats) > 0 {
-		for k, v := range m.Stats {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + l + sovQuery(uint64(l))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+}
+func (m *QueryChains) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChains.Merge(m, src)
+}
+func (m *QueryChains) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChains) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChains.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChains proto.InternalMessageInfo
+
+type QueryChainsResponse struct {
+	// chain_channels maps counterparty chain ids to their canonical channel.
+	ChainChannels map[string]string `protobuf:"bytes,1,rep,name=chain_channels,json=chainChannels,proto3" json:"chain_channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *QueryChainsResponse) Reset()         { *m = QueryChainsResponse{} }
+func (m *QueryChainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainsResponse) ProtoMessage()    {}
+func (*QueryChainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{32}
+}
+func (m *QueryChainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryChainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainsResponse.Merge(m, src)
+}
+func (m *QueryChainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatsByChannel) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryChainsResponse proto.InternalMessageInfo
+
+func (m *QueryChainsResponse) GetChainChannels() map[string]string {
+	if m != nil {
+		return m.ChainChannels
 	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryAudit struct {
+}
+
+func (m *QueryAudit) Reset()         { *m = QueryAudit{} }
+func (m *QueryAudit) String() string { return proto.CompactTextString(m) }
+func (*QueryAudit) ProtoMessage()    {}
+func (*QueryAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{33}
+}
+func (m *QueryAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAudit.Merge(m, src)
+}
+func (m *QueryAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAudit.DiscardUnknown(m)
 }
 
-func (m *QueryStatsByChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAudit proto.InternalMessageInfo
+
+type QueryAuditResponse struct {
+	Broken        bool          `protobuf:"varint,1,opt,name=broken,proto3" json:"broken,omitempty"`
+	Discrepancies []Discrepancy `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies"`
+}
+
+func (m *QueryAuditResponse) Reset()         { *m = QueryAuditResponse{} }
+func (m *QueryAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditResponse) ProtoMessage()    {}
+func (*QueryAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{34}
+}
+func (m *QueryAuditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.NumOfAccounts != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfAccounts))
+}
+func (m *QueryAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditResponse.Merge(m, src)
+}
+func (m *QueryAuditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditResponse proto.InternalMessageInfo
+
+func (m *QueryAuditResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
 	}
-	if m.NumOfForwards != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	return false
+}
+
+func (m *QueryAuditResponse) GetDiscrepancies() []Discrepancy {
+	if m != nil {
+		return m.Discrepancies
 	}
-	if len(m.TotalForwarded) > 0 {
-		for _, e := range m.TotalForwarded {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type Discrepancy struct {
+	// invariant is the name of the broken invariant.
+	Invariant string `protobuf:"bytes,1,opt,name=invariant,proto3" json:"invariant,omitempty"`
+	// key identifies the offending state entry, e.g. a channel or an address.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// description explains what is inconsistent.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *Discrepancy) Reset()         { *m = Discrepancy{} }
+func (m *Discrepancy) String() string { return proto.CompactTextString(m) }
+func (*Discrepancy) ProtoMessage()    {}
+func (*Discrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{35}
+}
+func (m *Discrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Discrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Discrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Discrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Discrepancy.Merge(m, src)
+}
+func (m *Discrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Discrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Discrepancy.DiscardUnknown(m)
 }
 
-func (m *Stats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_Discrepancy proto.InternalMessageInfo
+
+func (m *Discrepancy) GetInvariant() string {
+	if m != nil {
+		return m.Invariant
 	}
-	if m.NumOfAccounts != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfAccounts))
+	return ""
+}
+
+func (m *Discrepancy) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if m.NumOfForwards != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	return ""
+}
+
+func (m *Discrepancy) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.TotalForwarded) > 0 {
-		for _, e := range m.TotalForwarded {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryDenoms)(nil), "noble.forwarding.v1.QueryDenoms")
+	proto.RegisterType((*QueryDenomsResponse)(nil), "noble.forwarding.v1.QueryDenomsResponse")
+	proto.RegisterType((*QueryAddress)(nil), "noble.forwarding.v1.QueryAddress")
+	proto.RegisterType((*QueryAddressResponse)(nil), "noble.forwarding.v1.QueryAddressResponse")
+	proto.RegisterType((*QueryAccount)(nil), "noble.forwarding.v1.QueryAccount")
+	proto.RegisterType((*QueryAccountResponse)(nil), "noble.forwarding.v1.QueryAccountResponse")
+	proto.RegisterType((*QueryStats)(nil), "noble.forwarding.v1.QueryStats")
+	proto.RegisterType((*QueryStatsResponse)(nil), "noble.forwarding.v1.QueryStatsResponse")
+	proto.RegisterMapType((map[string]Stats)(nil), "noble.forwarding.v1.QueryStatsResponse.StatsEntry")
+	proto.RegisterType((*QueryStatsByChannel)(nil), "noble.forwarding.v1.QueryStatsByChannel")
+	proto.RegisterType((*QueryStatsByChannelResponse)(nil), "noble.forwarding.v1.QueryStatsByChannelResponse")
+	proto.RegisterType((*QueryStatsByChain)(nil), "noble.forwarding.v1.QueryStatsByChain")
+	proto.RegisterType((*QueryStatsByChainResponse)(nil), "noble.forwarding.v1.QueryStatsByChainResponse")
+	proto.RegisterType((*QueryStatsByAccount)(nil), "noble.forwarding.v1.QueryStatsByAccount")
+	proto.RegisterType((*QueryStatsByAccountResponse)(nil), "noble.forwarding.v1.QueryStatsByAccountResponse")
+	proto.RegisterType((*QueryStatsHistory)(nil), "noble.forwarding.v1.QueryStatsHistory")
+	proto.RegisterType((*QueryStatsHistoryResponse)(nil), "noble.forwarding.v1.QueryStatsHistoryResponse")
+	proto.RegisterType((*Stats)(nil), "noble.forwarding.v1.Stats")
+	proto.RegisterType((*QueryMemo)(nil), "noble.forwarding.v1.QueryMemo")
+	proto.RegisterType((*QueryMemoResponse)(nil), "noble.forwarding.v1.QueryMemoResponse")
+	proto.RegisterType((*QueryMemos)(nil), "noble.forwarding.v1.QueryMemos")
+	proto.RegisterType((*QueryMemosResponse)(nil), "noble.forwarding.v1.QueryMemosResponse")
+	proto.RegisterType((*QueryAccounts)(nil), "noble.forwarding.v1.QueryAccounts")
+	proto.RegisterType((*QueryAccountsResponse)(nil), "noble.forwarding.v1.QueryAccountsResponse")
+	proto.RegisterType((*QueryAccountsByChannel)(nil), "noble.forwarding.v1.QueryAccountsByChannel")
+	proto.RegisterType((*QueryAccountsByChannelResponse)(nil), "noble.forwarding.v1.QueryAccountsByChannelResponse")
+	proto.RegisterType((*QueryAccountsByRecipient)(nil), "noble.forwarding.v1.QueryAccountsByRecipient")
+	proto.RegisterType((*QueryAccountsByRecipientResponse)(nil), "noble.forwarding.v1.QueryAccountsByRecipientResponse")
+	proto.RegisterType((*QueryAccountsByFallback)(nil), "noble.forwarding.v1.QueryAccountsByFallback")
+	proto.RegisterType((*QueryAccountsByFallbackResponse)(nil), "noble.forwarding.v1.QueryAccountsByFallbackResponse")
+	proto.RegisterType((*QueryForwardHistory)(nil), "noble.forwarding.v1.QueryForwardHistory")
+	proto.RegisterType((*QueryForwardHistoryResponse)(nil), "noble.forwarding.v1.QueryForwardHistoryResponse")
+	proto.RegisterType((*QueryChains)(nil), "noble.forwarding.v1.QueryChains")
+	proto.RegisterType((*QueryChainsResponse)(nil), "noble.forwarding.v1.QueryChainsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "noble.forwarding.v1.QueryChainsResponse.ChainChannelsEntry")
+	proto.RegisterType((*QueryAudit)(nil), "noble.forwarding.v1.QueryAudit")
+	proto.RegisterType((*QueryAuditResponse)(nil), "noble.forwarding.v1.QueryAuditResponse")
+	proto.RegisterType((*Discrepancy)(nil), "noble.forwarding.v1.Discrepancy")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/query.proto", fileDescriptor_fc601bfb5b0b1c63) }
+
+var fileDescriptor_fc601bfb5b0b1c63 = []byte{
+	// 2102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf0, 0x43, 0xa2, 0x9e, 0x2c, 0xb9, 0x1a, 0xab, 0x0d, 0xb5, 0xb2, 0x29, 0x79, 0x6b,
+	0xc8, 0x8c, 0x6c, 0x71, 0x2d, 0x29, 0x46, 0x13, 0xf7, 0x03, 0xb6, 0xec, 0x30, 0x2e, 0xe0, 0x7e,
+	0xd1, 0x41, 0x0b, 0xe4, 0x42, 0x0c, 0x97, 0x23, 0x6a, 0x21, 0x6a, 0x57, 0xd9, 0x59, 0x2a, 0x15,
+	0x04, 0x05, 0x68, 0x51, 0x34, 0x41, 0x80, 0x02, 0x45, 0x73, 0x28, 0xda, 0x1c, 0x9a, 0x5c, 0xda,
+	0xc2, 0xa7, 0x00, 0x49, 0x80, 0xa2, 0x1f, 0xf7, 0xf4, 0x66, 0xb4, 0x28, 0xd0, 0x53, 0x53, 0xd8,
+	0x05, 0x52, 0xa0, 0xe8, 0xa1, 0xff, 0x41, 0xb1, 0xf3, 0xb5, 0x4b, 0x6a, 0xb9, 0x24, 0x6d, 0xb5,
+	0x31, 0x90, 0x8b, 0xcd, 0x99, 0xf9, 0xcd, 0xcc, 0xef, 0xbd, 0x79, 0xf3, 0xde, 0x9b, 0xb7, 0x82,
+	0x45, 0xd7, 0x6b, 0xb4, 0xa9, 0xb5, 0xe5, 0xf9, 0xaf, 0x10, 0xbf, 0xe9, 0xb8, 0x2d, 0x6b, 0x7f,
+	0xcd, 0x7a, 0xb9, 0x43, 0xfd, 0x83, 0xca, 0x9e, 0xef, 0x05, 0x1e, 0x3e, 0xc3, 0x01, 0x95, 0x08,
+	0x50, 0xd9, 0x5f, 0x33, 0x66, 0xc9, 0xae, 0xe3, 0x7a, 0x16, 0xff, 0x57, 0xe0, 0x8c, 0x15, 0xdb,
+	0x63, 0xbb, 0x1e, 0xb3, 0x1a, 0x84, 0x51, 0xb1, 0x80, 0xb5, 0xbf, 0xd6, 0xa0, 0x01, 0x59, 0xb3,
+	0xf6, 0x48, 0xcb, 0x71, 0x49, 0xe0, 0x78, 0xae, 0xc4, 0x96, 0xe2, 0x58, 0x85, 0xb2, 0x3d, 0x47,
+	0x8d, 0x2f, 0xc8, 0x71, 0xb5, 0x4c, 0x9c, 0x90, 0x31, 0x2f, 0x06, 0xeb, 0xbc, 0x65, 0x89, 0x86,
+	0x1c, 0x9a, 0x6b, 0x79, 0x2d, 0x4f, 0xf4, 0x87, 0xbf, 0x64, 0xef, 0xd9, 0x96, 0xe7, 0xb5, 0xda,
+	0xd4, 0x22, 0x7b, 0x8e, 0x45, 0x5c, 0xd7, 0x0b, 0x38, 0x15, 0x35, 0xe7, 0x7c, 0x92, 0x02, 0x88,
+	0x6d, 0x7b, 0x1d, 0x37, 0x48, 0x83, 0x6c, 0x3b, 0x2c, 0xf0, 0x34, 0xa9, 0x52, 0x12, 0x64, 0x97,
+	0xee, 0x2a, 0x0e, 0x89, 0x6a, 0x66, 0x01, 0x09, 0x24, 0x0d, 0x73, 0x1a, 0xa6, 0xbe, 0x15, 0x0a,
+	0x79, 0x8b, 0xba, 0xde, 0x2e, 0x33, 0x6f, 0xc2, 0x99, 0x58, 0xb3, 0x46, 0xd9, 0x9e, 0xe7, 0x32,
+	0x8a, 0x2f, 0xc3, 0x0c, 0x69, 0xb7, 0xbd, 0x57, 0x68, 0xb3, 0xde, 0xe4, 0x23, 0x45, 0xb4, 0x94,
+	0x2d, 0x4f, 0x6e, 0xe6, 0x7f, 0xfd, 0xf1, 0xbb, 0x2b, 0xa8, 0x36, 0x2d, 0x07, 0xe5, 0x22, 0x7f,
+	0x41, 0x70, 0x8a, 0xaf, 0x72, 0xa3, 0xd9, 0xf4, 0x29, 0x63, 0xb8, 0x08, 0x13, 0xf6, 0x36, 0x71,
+	0x5d, 0xda, 0x2e, 0xa2, 0x25, 0x54, 0x9e, 0xac, 0xa9, 0x26, 0x3e, 0x0b, 0x93, 0x3e, 0xb5, 0x9d,
+	0x3d, 0x87, 0xba, 0x41, 0x31, 0xc3, 0xc7, 0xa2, 0x0e, 0x6c, 0x40, 0x61, 0x8b, 0xb4, 0xdb, 0x0d,
+	0x62, 0xef, 0x14, 0xb3, 0x7c, 0x50, 0xb7, 0xf1, 0x1d, 0x38, 0x4d, 0xc4, 0xf2, 0xf5, 0x7d, 0xea,
+	0x33, 0xc7, 0x73, 0x8b, 0xb9, 0x25, 0x54, 0x9e, 0x59, 0xff, 0x7c, 0x25, 0xc1, 0x72, 0x2a, 0x92,
+	0xca, 0xb7, 0x05, 0xb4, 0x36, 0x43, 0xba, 0xda, 0x78, 0x1e, 0x0a, 0xf6, 0x36, 0x71, 0xdc, 0xba,
+	0xd3, 0x2c, 0xe6, 0x35, 0x45, 0xc7, 0xfd, 0x6a, 0xf3, 0x5a, 0xe1, 0xf5, 0xb7, 0x17, 0xc7, 0xfe,
+	0xf9, 0xf6, 0xe2, 0x98, 0xf9, 0x11, 0x82, 0xb9, 0xb8, 0x5c, 0x5a, 0x3d, 0xeb, 0x30, 0x21, 0xd7,
+	0x13, 0xf2, 0x6d, 0x16, 0xff, 0xf4, 0xfe, 0xea, 0x9c, 0x34, 0x11, 0x09, 0xbe, 0x1b, 0xf8, 0x8e,
+	0xdb, 0xaa, 0x29, 0x20, 0x3e, 0x07, 0xe3, 0xf4, 0xbb, 0x0e, 0x0b, 0x18, 0x17, 0xbb, 0xa0, 0x54,
+	0x29, 0x3b, 0xf1, 0xdd, 0xe3, 0xe2, 0x65, 0x87, 0x16, 0x4f, 0x2d, 0xd6, 0x2b, 0xe5, 0x62, 0x74,
+	0x0e, 0x39, 0xce, 0x53, 0xe2, 0x54, 0xaf, 0x79, 0x47, 0x1d, 0x9c, 0xb0, 0xc3, 0x47, 0x11, 0x2c,
+	0xa6, 0xaf, 0xdf, 0xe7, 0x60, 0x2e, 0xbe, 0xdc, 0x63, 0xe9, 0x2b, 0x66, 0x43, 0x99, 0x14, 0x1b,
+	0xca, 0xf6, 0xda, 0xd0, 0x33, 0x31, 0x1b, 0xca, 0x0d, 0xd8, 0x2c, 0xb2, 0xae, 0x0b, 0x00, 0xb6,
+	0x4f, 0x49, 0x40, 0x9b, 0x75, 0x12, 0x70, 0x8b, 0xc8, 0x2a, 0x65, 0x4d, 0xca, 0x81, 0x1b, 0x01,
+	0x3e, 0x82, 0x42, 0x83, 0xb4, 0x89, 0x6b, 0x53, 0x56, 0x1c, 0x5f, 0xca, 0x96, 0xa7, 0xd6, 0xe7,
+	0x2b, 0x72, 0xe1, 0xd0, 0xc5, 0x54, 0xa4, 0x8b, 0xa9, 0xdc, 0xf4, 0x1c, 0x77, 0xb3, 0xfa, 0xe1,
+	0xdf, 0x16, 0xc7, 0xee, 0x7d, 0xb4, 0x58, 0x6e, 0x39, 0xc1, 0x76, 0xa7, 0x51, 0xb1, 0xbd, 0x5d,
+	0xe9, 0x45, 0xe4, 0x7f, 0xab, 0xac, 0xb9, 0x63, 0x05, 0x07, 0x7b, 0x94, 0xf1, 0x09, 0xec, 0xe7,
+	0x1f, 0xbf, 0xbb, 0x72, 0xaa, 0x4d, 0x5b, 0xc4, 0x3e, 0xa8, 0x87, 0x4e, 0x8a, 0x89, 0xfd, 0xf5,
+	0x96, 0xf8, 0x1a, 0xe4, 0xc3, 0xab, 0xce, 0x8a, 0x13, 0x7c, 0xef, 0x52, 0xa2, 0x65, 0x7c, 0x8d,
+	0xee, 0x7a, 0xcf, 0xbb, 0x81, 0x7f, 0xb0, 0x99, 0x0b, 0x09, 0xd4, 0xc4, 0x14, 0xbc, 0x14, 0x33,
+	0xf8, 0x42, 0xaf, 0x2d, 0x84, 0x76, 0x8f, 0x57, 0x60, 0x5a, 0x6a, 0xb8, 0x1e, 0x3a, 0x0c, 0x5a,
+	0x9c, 0x8c, 0xc3, 0x4e, 0xc9, 0xb1, 0xbb, 0xe1, 0x50, 0x92, 0xb5, 0xc2, 0xe3, 0x5a, 0xab, 0x79,
+	0x0a, 0x80, 0x5b, 0x4f, 0xb8, 0x05, 0x33, 0x7f, 0x8b, 0x00, 0x47, 0x4d, 0x6d, 0x4a, 0x5f, 0x87,
+	0x3c, 0x77, 0x67, 0xdc, 0x21, 0x4d, 0xad, 0xaf, 0x27, 0xee, 0x77, 0x7c, 0x5e, 0x85, 0xb7, 0xba,
+	0xf4, 0xc2, 0x97, 0x31, 0x5e, 0x04, 0x88, 0x86, 0xf0, 0x67, 0x20, 0xbb, 0x43, 0x0f, 0xa4, 0xd3,
+	0x0a, 0x7f, 0xe2, 0x2b, 0x90, 0xdf, 0x27, 0xed, 0x0e, 0xe5, 0x46, 0x38, 0xb5, 0x6e, 0x24, 0xee,
+	0x27, 0xb6, 0x12, 0xc0, 0x6b, 0x99, 0x67, 0x91, 0xf9, 0x9c, 0x74, 0xab, 0x7c, 0x60, 0xf3, 0xe0,
+	0xa6, 0xb4, 0xdc, 0xbe, 0x7e, 0x31, 0x76, 0x89, 0xee, 0x67, 0x60, 0x21, 0x61, 0xae, 0x56, 0xc0,
+	0x2a, 0x9c, 0x76, 0x3b, 0xbb, 0x75, 0x6f, 0xab, 0x2e, 0x83, 0x87, 0xb8, 0x53, 0x39, 0xed, 0x9b,
+	0xdd, 0xce, 0xee, 0x37, 0xb6, 0xe4, 0x0d, 0x64, 0x31, 0xb8, 0xa4, 0x2c, 0xfc, 0x4f, 0x0f, 0xbc,
+	0x2a, 0xc7, 0xf0, 0x1b, 0x08, 0x4e, 0x07, 0x5e, 0x40, 0xda, 0x0a, 0x4e, 0x9b, 0xc5, 0xec, 0xff,
+	0xcb, 0xd2, 0x67, 0xf8, 0xce, 0x55, 0xb5, 0x31, 0xae, 0xc2, 0x59, 0xc9, 0xdd, 0xa7, 0x2d, 0x87,
+	0x05, 0x3e, 0x0f, 0xa8, 0xf5, 0x2d, 0xe2, 0xb4, 0x3b, 0x3e, 0x65, 0xc5, 0x5c, 0x5c, 0x90, 0x79,
+	0x2e, 0x48, 0x2d, 0x06, 0xac, 0x4a, 0x9c, 0xf9, 0x2c, 0xcc, 0xf6, 0x68, 0xd4, 0xe9, 0x8e, 0x00,
+	0xa8, 0x5f, 0x04, 0xf8, 0x4f, 0x06, 0xe6, 0x8f, 0x4d, 0xfd, 0x34, 0x1e, 0xc5, 0x79, 0xae, 0xad,
+	0xd0, 0x10, 0x43, 0xb5, 0xc7, 0x52, 0x01, 0xdd, 0x3d, 0xf0, 0xb4, 0xf2, 0x43, 0x9e, 0xd6, 0xdd,
+	0xee, 0xbb, 0x73, 0x32, 0xa1, 0xe9, 0x8f, 0x3d, 0xb7, 0xaa, 0x37, 0x42, 0x25, 0x9c, 0x0d, 0x1a,
+	0xf1, 0x6c, 0x32, 0x9f, 0xd4, 0xd9, 0x7c, 0x01, 0xe6, 0xb6, 0x1c, 0x9f, 0x05, 0x8a, 0x4b, 0x7d,
+	0x9b, 0x3a, 0xad, 0x6d, 0x11, 0x1a, 0x75, 0x14, 0xc3, 0x1c, 0x22, 0x27, 0xdd, 0xe6, 0x00, 0x7c,
+	0x15, 0xce, 0xb4, 0xc9, 0xf1, 0x79, 0xb9, 0xf8, 0xbc, 0xd9, 0x36, 0xe9, 0x99, 0x66, 0x7e, 0x80,
+	0xe2, 0xf7, 0xe9, 0xb6, 0xc8, 0x4f, 0xf1, 0x02, 0x4c, 0xb2, 0x80, 0xf8, 0x41, 0xbd, 0x49, 0x84,
+	0x03, 0xcd, 0xd5, 0x0a, 0xbc, 0xe3, 0x16, 0x39, 0xc0, 0x4f, 0xc1, 0x04, 0x75, 0x9b, 0x7c, 0x88,
+	0x9b, 0x7c, 0x6d, 0x9c, 0xba, 0xcd, 0x70, 0x20, 0xe6, 0x11, 0xb3, 0xdd, 0x51, 0xbe, 0x0a, 0x10,
+	0xe5, 0xf3, 0x9c, 0xd3, 0xd4, 0xfa, 0x72, 0x97, 0x72, 0x45, 0xb2, 0xae, 0x54, 0xfc, 0x4d, 0xd2,
+	0xa2, 0x35, 0xfa, 0x72, 0x87, 0xb2, 0xa0, 0x16, 0x9b, 0x19, 0xb3, 0x81, 0x5f, 0x22, 0x98, 0x3f,
+	0xc6, 0x5b, 0x5b, 0xc0, 0x75, 0x98, 0x68, 0x74, 0xec, 0x1d, 0xaa, 0x43, 0xcb, 0x52, 0x7f, 0x57,
+	0xbf, 0xc9, 0x81, 0x32, 0x90, 0xa8, 0x69, 0xf8, 0x85, 0x2e, 0xc6, 0x22, 0x5e, 0x5c, 0x1c, 0xc8,
+	0x58, 0x6c, 0x1f, 0xa7, 0x6c, 0xfe, 0x20, 0x0b, 0x79, 0xbe, 0x4f, 0x57, 0xd4, 0x46, 0x89, 0x51,
+	0x3b, 0xc1, 0x07, 0x65, 0x46, 0xf3, 0x41, 0xd9, 0x11, 0xed, 0x3c, 0xf7, 0x49, 0xd9, 0xf9, 0x32,
+	0x4c, 0xd9, 0xed, 0x30, 0xc7, 0xab, 0x87, 0x73, 0x45, 0xda, 0xae, 0x78, 0x83, 0x18, 0x79, 0xf1,
+	0x60, 0x8f, 0x0e, 0x74, 0x44, 0xe3, 0x43, 0x3a, 0xa2, 0x3a, 0x4c, 0x72, 0x73, 0x09, 0x33, 0xaa,
+	0x47, 0x4a, 0x61, 0xe7, 0x20, 0xcf, 0x5f, 0x4f, 0x32, 0x81, 0x15, 0x8d, 0x98, 0x41, 0x56, 0x60,
+	0x56, 0x6f, 0xa0, 0xed, 0x70, 0x1e, 0x72, 0x61, 0xc6, 0xd6, 0x7d, 0xdc, 0xbc, 0xcb, 0xfc, 0x09,
+	0x02, 0xd0, 0x13, 0xd8, 0x23, 0x51, 0xaa, 0x26, 0xd8, 0xe8, 0xe3, 0xdd, 0xaa, 0x9f, 0xa9, 0x3c,
+	0x8d, 0x93, 0xd2, 0x62, 0xe8, 0x5c, 0x15, 0x8d, 0x9e, 0xab, 0x9e, 0xd8, 0x45, 0xfa, 0x0e, 0x4c,
+	0xc7, 0xdf, 0x23, 0xbd, 0xe2, 0xa3, 0x47, 0x15, 0xdf, 0xbc, 0x87, 0xe0, 0xb3, 0x5d, 0x2b, 0x6b,
+	0xb9, 0x6f, 0x43, 0x21, 0x96, 0x0c, 0x64, 0xf9, 0xfa, 0x49, 0xa2, 0x57, 0x75, 0x4b, 0x2e, 0x21,
+	0x55, 0xa0, 0x67, 0x9f, 0xa0, 0x3b, 0x41, 0xf0, 0xb9, 0x2e, 0xb2, 0x43, 0x24, 0xa4, 0xff, 0x03,
+	0x43, 0x79, 0x0f, 0x41, 0x29, 0x99, 0xc6, 0x93, 0xac, 0xbc, 0x37, 0x10, 0x14, 0x7b, 0x58, 0xd7,
+	0xf4, 0x5b, 0xb3, 0xeb, 0x25, 0x8a, 0x7a, 0x5f, 0xa2, 0x27, 0xaf, 0xc2, 0x0f, 0x10, 0x2c, 0xf5,
+	0x23, 0xf3, 0x24, 0x2b, 0xf1, 0x1d, 0x04, 0x4f, 0xf5, 0xf0, 0xae, 0xaa, 0x97, 0x77, 0xfc, 0xbd,
+	0x8e, 0x86, 0x7e, 0xaf, 0x9f, 0xbc, 0x6e, 0xdf, 0x47, 0xb0, 0xd8, 0x87, 0xe3, 0x93, 0xac, 0xda,
+	0xb7, 0x90, 0x4c, 0x97, 0x55, 0x8e, 0x26, 0xd3, 0xb1, 0x27, 0x23, 0x38, 0xdc, 0x43, 0xb0, 0x90,
+	0xc0, 0x4e, 0x2b, 0x74, 0x13, 0x26, 0x7c, 0x6a, 0x7b, 0x7e, 0x53, 0xe9, 0xd3, 0x4c, 0xd3, 0x67,
+	0x8d, 0x43, 0x55, 0xda, 0x25, 0x27, 0x9e, 0x9c, 0x2a, 0x55, 0x69, 0x94, 0x3f, 0xf2, 0x98, 0xf9,
+	0x3b, 0xa5, 0x59, 0xd1, 0xd6, 0x9c, 0x1b, 0x30, 0x23, 0x72, 0x32, 0xfd, 0x20, 0x12, 0xd4, 0xbf,
+	0xd8, 0xbf, 0x14, 0xd1, 0xbd, 0x42, 0x85, 0x37, 0xa5, 0xef, 0x13, 0x85, 0x87, 0xda, 0xb4, 0x1d,
+	0xef, 0x33, 0xae, 0x03, 0x3e, 0x0e, 0x4a, 0xa8, 0x4e, 0xcc, 0xc5, 0xab, 0x13, 0x93, 0xf1, 0x0a,
+	0x84, 0x2a, 0xa6, 0xdc, 0xe8, 0x34, 0x9d, 0xc0, 0xfc, 0x9e, 0x0a, 0xd2, 0xbc, 0xa9, 0x45, 0x39,
+	0x07, 0xe3, 0x0d, 0xdf, 0xdb, 0xa1, 0x22, 0x14, 0x46, 0x35, 0x49, 0xd1, 0x89, 0xef, 0xc0, 0x74,
+	0xd3, 0x61, 0xb6, 0x4f, 0xf7, 0x88, 0x6b, 0x3b, 0x94, 0x15, 0x33, 0x29, 0x89, 0xf1, 0x2d, 0x8d,
+	0x54, 0xd1, 0xbc, 0x7b, 0xb2, 0x59, 0x87, 0xa9, 0x18, 0x26, 0xf4, 0x9d, 0x8e, 0xbb, 0x4f, 0x7c,
+	0x87, 0x44, 0xbe, 0x53, 0x77, 0x28, 0x51, 0x33, 0x91, 0xa8, 0x4b, 0x30, 0xd5, 0xa4, 0xcc, 0xf6,
+	0x9d, 0xbd, 0x40, 0x15, 0x47, 0x27, 0x6b, 0xf1, 0xae, 0xf5, 0x7f, 0xcd, 0x41, 0x9e, 0x0b, 0x89,
+	0x5f, 0x85, 0x71, 0x51, 0x9a, 0xc6, 0x4b, 0xfd, 0x0f, 0x45, 0x20, 0x8c, 0xf2, 0x20, 0x84, 0xd2,
+	0x96, 0x59, 0x7e, 0x3d, 0xd4, 0xce, 0xf7, 0xff, 0xfc, 0x8f, 0x37, 0x33, 0xe7, 0xf0, 0x82, 0x95,
+	0x54, 0x69, 0x17, 0xc5, 0x72, 0xfc, 0x6f, 0x04, 0x13, 0xaa, 0x16, 0x7e, 0xbe, 0xff, 0xfa, 0x12,
+	0x62, 0x3c, 0x3d, 0x10, 0xa2, 0x39, 0xbc, 0x89, 0x22, 0x12, 0xaf, 0xa1, 0x97, 0xaa, 0xf8, 0x56,
+	0x22, 0x0f, 0x79, 0xa3, 0x2d, 0x6e, 0x55, 0xd6, 0xa1, 0x7a, 0x43, 0x1c, 0x59, 0x87, 0x3a, 0x3c,
+	0x1d, 0x59, 0x87, 0xca, 0x9b, 0x1e, 0xe1, 0x2f, 0xa7, 0xae, 0x72, 0x28, 0x2d, 0xbd, 0xef, 0xf4,
+	0xd7, 0x42, 0x79, 0xe5, 0x3b, 0x3d, 0x4d, 0x5e, 0x01, 0x31, 0x9e, 0x1e, 0x08, 0xd1, 0xf2, 0x56,
+	0xb8, 0xa4, 0x65, 0xbc, 0x6c, 0xa5, 0x7c, 0x3e, 0xb1, 0x0e, 0x25, 0xd3, 0x23, 0xdc, 0x51, 0x2f,
+	0xa7, 0xc5, 0x01, 0x85, 0x41, 0xe3, 0xe2, 0x90, 0x95, 0x43, 0xd3, 0xe4, 0x14, 0xce, 0x62, 0xc3,
+	0xea, 0xfb, 0x6d, 0x05, 0xff, 0x02, 0xc1, 0x4c, 0x4f, 0xad, 0xaf, 0x3c, 0x60, 0x7d, 0x8d, 0x34,
+	0xae, 0x0c, 0x8b, 0xd4, 0x94, 0xd6, 0x22, 0x23, 0x58, 0xc6, 0x17, 0xfa, 0xf3, 0x8a, 0x4e, 0x0e,
+	0xff, 0x2a, 0x62, 0xa8, 0x4e, 0x6a, 0x30, 0x43, 0x75, 0x60, 0x57, 0x86, 0x45, 0x6a, 0x86, 0xcf,
+	0x45, 0x0c, 0x2b, 0xf8, 0x72, 0x0a, 0xc3, 0xe3, 0x47, 0xf8, 0x16, 0x82, 0x53, 0x5d, 0x95, 0xba,
+	0xe5, 0x61, 0xf4, 0xe3, 0xb8, 0x46, 0x65, 0x38, 0x9c, 0xe6, 0xb8, 0xc1, 0xe9, 0xad, 0xe2, 0x4b,
+	0x29, 0xf4, 0x7a, 0x2f, 0x10, 0xfe, 0xa9, 0x62, 0xa7, 0x02, 0xed, 0x20, 0x76, 0x12, 0x67, 0x54,
+	0x86, 0xc3, 0x69, 0x76, 0x56, 0xa4, 0xc1, 0x0b, 0xd8, 0x4c, 0xa1, 0x28, 0x3f, 0x10, 0xe2, 0x1f,
+	0x21, 0x98, 0x78, 0x81, 0x06, 0xfc, 0xb5, 0x5a, 0xea, 0xbf, 0x59, 0x38, 0x6e, 0x2c, 0xa7, 0x8f,
+	0x6b, 0x12, 0xd7, 0x22, 0x12, 0x16, 0x5e, 0xb5, 0xfa, 0x7d, 0x7c, 0x64, 0xd1, 0xf1, 0x59, 0x8d,
+	0x03, 0xf1, 0xc9, 0x30, 0x74, 0x0a, 0x05, 0xc9, 0x27, 0xf5, 0x3a, 0x72, 0x80, 0x71, 0x71, 0x00,
+	0x60, 0x14, 0xdb, 0xef, 0xa1, 0x84, 0x7f, 0x88, 0xa0, 0xa0, 0x9f, 0x80, 0xe6, 0x40, 0xe7, 0xc3,
+	0x8c, 0x95, 0xc1, 0x18, 0xcd, 0x67, 0x25, 0xe2, 0xb3, 0x88, 0xcf, 0xa5, 0xb9, 0x29, 0x86, 0xdf,
+	0x43, 0x30, 0x7b, 0xfc, 0x11, 0x76, 0x69, 0xf0, 0x6e, 0x91, 0xb3, 0xd8, 0x18, 0x01, 0xac, 0x39,
+	0x7e, 0x29, 0xe2, 0xb8, 0x86, 0xad, 0x54, 0x8e, 0x96, 0xf4, 0x18, 0x31, 0xd7, 0xf1, 0x07, 0x04,
+	0x67, 0x92, 0x5e, 0x3f, 0xab, 0xc3, 0x50, 0xd1, 0x70, 0xe3, 0xea, 0x48, 0x70, 0xcd, 0xfd, 0x7a,
+	0xc4, 0xfd, 0x2a, 0xde, 0x48, 0xe7, 0xae, 0xa3, 0x53, 0x3c, 0x50, 0xe1, 0xdf, 0x20, 0xc0, 0x09,
+	0x0f, 0x8f, 0xcb, 0xc3, 0xf0, 0x51, 0x68, 0xe3, 0x99, 0x51, 0xd0, 0x9a, 0xfc, 0x57, 0x22, 0xf2,
+	0x1b, 0x78, 0x2d, 0x9d, 0xbc, 0x0a, 0xa7, 0xf1, 0xc0, 0xfa, 0x0e, 0x82, 0x99, 0x9e, 0xc4, 0x3e,
+	0xc5, 0x6b, 0x77, 0x23, 0x8d, 0x2b, 0xc3, 0x22, 0x23, 0x8f, 0x18, 0xd1, 0xed, 0x17, 0x72, 0xa5,
+	0xb7, 0x89, 0xdd, 0xae, 0x57, 0x61, 0x5c, 0xe4, 0xb7, 0x69, 0xc9, 0x96, 0x40, 0x18, 0xe5, 0x41,
+	0x88, 0x51, 0x92, 0x2d, 0x5b, 0xec, 0xda, 0x81, 0x3c, 0xcf, 0x6a, 0xd3, 0x7c, 0x0c, 0x07, 0x18,
+	0x17, 0x07, 0x00, 0x86, 0x0c, 0xf9, 0x24, 0xc4, 0x6e, 0x3e, 0xff, 0xe1, 0x83, 0x12, 0xba, 0xff,
+	0xa0, 0x84, 0xfe, 0xfe, 0xa0, 0x84, 0x7e, 0xfc, 0xb0, 0x34, 0x76, 0xff, 0x61, 0x69, 0xec, 0xaf,
+	0x0f, 0x4b, 0x63, 0x2f, 0x5d, 0x8a, 0xd5, 0x3d, 0xf9, 0xfc, 0x55, 0xc2, 0x18, 0x0d, 0x58, 0xd7,
+	0x32, 0xeb, 0xa2, 0x00, 0xda, 0x18, 0xe7, 0x7f, 0x96, 0xb1, 0xf1, 0xdf, 0x01, 0x00, 0x21, 0x95,
+	0xaa, 0x87, 0x20, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
+	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error)
+	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error)
+	StatsByAccount(ctx context.Context, in *QueryStatsByAccount, opts ...grpc.CallOption) (*QueryStatsByAccountResponse, error)
+	StatsByChain(ctx context.Context, in *QueryStatsByChain, opts ...grpc.CallOption) (*QueryStatsByChainResponse, error)
+	StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error)
+	GetMemo(ctx context.Context, in *QueryMemo, opts ...grpc.CallOption) (*QueryMemoResponse, error)
+	GetMemos(ctx context.Context, in *QueryMemos, opts ...grpc.CallOption) (*QueryMemosResponse, error)
+	Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	AccountsByChannel(ctx context.Context, in *QueryAccountsByChannel, opts ...grpc.CallOption) (*QueryAccountsByChannelResponse, error)
+	AccountsByRecipient(ctx context.Context, in *QueryAccountsByRecipient, opts ...grpc.CallOption) (*QueryAccountsByRecipientResponse, error)
+	AccountsByFallback(ctx context.Context, in *QueryAccountsByFallback, opts ...grpc.CallOption) (*QueryAccountsByFallbackResponse, error)
+	ForwardHistory(ctx context.Context, in *QueryForwardHistory, opts ...grpc.CallOption) (*QueryForwardHistoryResponse, error)
+	Chains(ctx context.Context, in *QueryChains, opts ...grpc.CallOption) (*QueryChainsResponse, error)
+	Audit(ctx context.Context, in *QueryAudit, opts ...grpc.CallOption) (*QueryAuditResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
+	out := new(QueryDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Denoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
+	out := new(QueryAddressResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error) {
+	out := new(QueryStatsByChannelResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/StatsByChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsByAccount(ctx context.Context, in *QueryStatsByAccount, opts ...grpc.CallOption) (*QueryStatsByAccountResponse, error) {
+	out := new(QueryStatsByAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/StatsByAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsByChain(ctx context.Context, in *QueryStatsByChain, opts ...grpc.CallOption) (*QueryStatsByChainResponse, error) {
+	out := new(QueryStatsByChainResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/StatsByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error) {
+	out := new(QueryStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/StatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMemo(ctx context.Context, in *QueryMemo, opts ...grpc.CallOption) (*QueryMemoResponse, error) {
+	out := new(QueryMemoResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/GetMemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMemos(ctx context.Context, in *QueryMemos, opts ...grpc.CallOption) (*QueryMemosResponse, error) {
+	out := new(QueryMemosResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/GetMemos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByChannel(ctx context.Context, in *QueryAccountsByChannel, opts ...grpc.CallOption) (*QueryAccountsByChannelResponse, error) {
+	out := new(QueryAccountsByChannelResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/AccountsByChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByRecipient(ctx context.Context, in *QueryAccountsByRecipient, opts ...grpc.CallOption) (*QueryAccountsByRecipientResponse, error) {
+	out := new(QueryAccountsByRecipientResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/AccountsByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByFallback(ctx context.Context, in *QueryAccountsByFallback, opts ...grpc.CallOption) (*QueryAccountsByFallbackResponse, error) {
+	out := new(QueryAccountsByFallbackResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/AccountsByFallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardHistory(ctx context.Context, in *QueryForwardHistory, opts ...grpc.CallOption) (*QueryForwardHistoryResponse, error) {
+	out := new(QueryForwardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/ForwardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Chains(ctx context.Context, in *QueryChains, opts ...grpc.CallOption) (*QueryChainsResponse, error) {
+	out := new(QueryChainsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Chains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Audit(ctx context.Context, in *QueryAudit, opts ...grpc.CallOption) (*QueryAuditResponse, error) {
+	out := new(QueryAuditResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Audit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error)
+	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
+	Account(context.Context, *QueryAccount) (*QueryAccountResponse, error)
+	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
+	StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error)
+	StatsByAccount(context.Context, *QueryStatsByAccount) (*QueryStatsByAccountResponse, error)
+	StatsByChain(context.Context, *QueryStatsByChain) (*QueryStatsByChainResponse, error)
+	StatsHistory(context.Context, *QueryStatsHistory) (*QueryStatsHistoryResponse, error)
+	GetMemo(context.Context, *QueryMemo) (*QueryMemoResponse, error)
+	GetMemos(context.Context, *QueryMemos) (*QueryMemosResponse, error)
+	Accounts(context.Context, *QueryAccounts) (*QueryAccountsResponse, error)
+	AccountsByChannel(context.Context, *QueryAccountsByChannel) (*QueryAccountsByChannelResponse, error)
+	AccountsByRecipient(context.Context, *QueryAccountsByRecipient) (*QueryAccountsByRecipientResponse, error)
+	AccountsByFallback(context.Context, *QueryAccountsByFallback) (*QueryAccountsByFallbackResponse, error)
+	ForwardHistory(context.Context, *QueryForwardHistory) (*QueryForwardHistoryResponse, error)
+	Chains(context.Context, *QueryChains) (*QueryChainsResponse, error)
+	Audit(context.Context, *QueryAudit) (*QueryAuditResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Denoms(ctx context.Context, req *QueryDenoms) (*QueryDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+}
+func (*UnimplementedQueryServer) Address(ctx context.Context, req *QueryAddress) (*QueryAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
+}
+func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccount) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) StatsByChannel(ctx context.Context, req *QueryStatsByChannel) (*QueryStatsByChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsByChannel not implemented")
+}
+func (*UnimplementedQueryServer) StatsByAccount(ctx context.Context, req *QueryStatsByAccount) (*QueryStatsByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsByAccount not implemented")
+}
+func (*UnimplementedQueryServer) StatsByChain(ctx context.Context, req *QueryStatsByChain) (*QueryStatsByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsByChain not implemented")
+}
+func (*UnimplementedQueryServer) StatsHistory(ctx context.Context, req *QueryStatsHistory) (*QueryStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetMemo(ctx context.Context, req *QueryMemo) (*QueryMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
+}
+func (*UnimplementedQueryServer) GetMemos(ctx context.Context, req *QueryMemos) (*QueryMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemos not implemented")
+}
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccounts) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedQueryServer) AccountsByChannel(ctx context.Context, req *QueryAccountsByChannel) (*QueryAccountsByChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByChannel not implemented")
+}
+func (*UnimplementedQueryServer) AccountsByRecipient(ctx context.Context, req *QueryAccountsByRecipient) (*QueryAccountsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByRecipient not implemented")
+}
+func (*UnimplementedQueryServer) AccountsByFallback(ctx context.Context, req *QueryAccountsByFallback) (*QueryAccountsByFallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByFallback not implemented")
+}
+func (*UnimplementedQueryServer) ForwardHistory(ctx context.Context, req *QueryForwardHistory) (*QueryForwardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardHistory not implemented")
+}
+func (*UnimplementedQueryServer) Chains(ctx context.Context, req *QueryChains) (*QueryChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chains not implemented")
+}
+func (*UnimplementedQueryServer) Audit(ctx context.Context, req *QueryAudit) (*QueryAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Denoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Denoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Denoms(ctx, req.(*QueryDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Address(ctx, req.(*QueryAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsByChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/StatsByChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsByChannel(ctx, req.(*QueryStatsByChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsByAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/StatsByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsByAccount(ctx, req.(*QueryStatsByAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsByChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/StatsByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsByChain(ctx, req.(*QueryStatsByChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/StatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsHistory(ctx, req.(*QueryStatsHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/GetMemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMemo(ctx, req.(*QueryMemo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/GetMemos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMemos(ctx, req.(*QueryMemos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/AccountsByChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByChannel(ctx, req.(*QueryAccountsByChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/AccountsByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByRecipient(ctx, req.(*QueryAccountsByRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByFallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByFallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByFallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/AccountsByFallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByFallback(ctx, req.(*QueryAccountsByFallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/ForwardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardHistory(ctx, req.(*QueryForwardHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Chains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChains)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Chains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Chains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Chains(ctx, req.(*QueryChains))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAudit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Audit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Audit(ctx, req.(*QueryAudit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.forwarding.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Denoms",
+			Handler:    _Query_Denoms_Handler,
+		},
+		{
+			MethodName: "Address",
+			Handler:    _Query_Address_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "StatsByChannel",
+			Handler:    _Query_StatsByChannel_Handler,
+		},
+		{
+			MethodName: "StatsByAccount",
+			Handler:    _Query_StatsByAccount_Handler,
+		},
+		{
+			MethodName: "StatsByChain",
+			Handler:    _Query_StatsByChain_Handler,
+		},
+		{
+			MethodName: "StatsHistory",
+			Handler:    _Query_StatsHistory_Handler,
+		},
+		{
+			MethodName: "GetMemo",
+			Handler:    _Query_GetMemo_Handler,
+		},
+		{
+			MethodName: "GetMemos",
+			Handler:    _Query_GetMemos_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "AccountsByChannel",
+			Handler:    _Query_AccountsByChannel_Handler,
+		},
+		{
+			MethodName: "AccountsByRecipient",
+			Handler:    _Query_AccountsByRecipient_Handler,
+		},
+		{
+			MethodName: "AccountsByFallback",
+			Handler:    _Query_AccountsByFallback_Handler,
+		},
+		{
+			MethodName: "ForwardHistory",
+			Handler:    _Query_ForwardHistory_Handler,
+		},
+		{
+			MethodName: "Chains",
+			Handler:    _Query_Chains_Handler,
+		},
+		{
+			MethodName: "Audit",
+			Handler:    _Query_Audit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/forwarding/v1/query.proto",
+}
+
+func (m *QueryDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddressVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ChannelState) > 0 {
+		i -= len(m.ChannelState)
+		copy(dAtA[i:], m.ChannelState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelState)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Memos) > 0 {
+		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for k := range m.Stats {
+			v := m.Stats[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfRegistrationFailures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfRegistrationFailures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumOfAccounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfRegistrationFailures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfRegistrationFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumOfAccounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastForwardHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastForwardHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FirstForwardHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FirstForwardHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndDay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartDay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartDay))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfRegistrationFailures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfRegistrationFailures))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumOfAccounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Memos) > 0 {
+		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByFallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByFallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByFallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByFallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByFallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByFallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainChannels) > 0 {
+		for k := range m.ChainChannels {
+			v := m.ChainChannels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for iNdEx := len(m.Discrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Discrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Discrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Discrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Invariant) > 0 {
+		i -= len(m.Invariant)
+		copy(dAtA[i:], m.Invariant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Invariant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovQuery(uint64(m.AddressVersion))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Exists {
+		n += 2
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovQuery(uint64(m.AddressVersion))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedAt))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Memos) > 0 {
+		for _, e := range m.Memos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovQuery(uint64(m.AddressVersion))
+	}
+	return n
+}
+
+func (m *QueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for k, v := range m.Stats {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatsByChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsByChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfAccounts != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfAccounts))
+	}
+	if m.NumOfForwards != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NumOfRegistrationFailures != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfRegistrationFailures))
+	}
+	return n
+}
+
+func (m *QueryStatsByChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfAccounts != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfAccounts))
+	}
+	if m.NumOfForwards != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NumOfRegistrationFailures != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfRegistrationFailures))
+	}
+	return n
+}
+
+func (m *QueryStatsByAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsByAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfForwards != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FirstForwardHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FirstForwardHeight))
+	}
+	if m.LastForwardHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastForwardHeight))
+	}
+	return n
+}
+
+func (m *QueryStatsHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDay != 0 {
+		n += 1 + sovQuery(uint64(m.StartDay))
+	}
+	if m.EndDay != 0 {
+		n += 1 + sovQuery(uint64(m.EndDay))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Stats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumOfAccounts != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfAccounts))
+	}
+	if m.NumOfForwards != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumOfRegistrationFailures != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfRegistrationFailures))
+	}
+	return n
+}
+
+func (m *QueryMemo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Memos) > 0 {
+		for _, e := range m.Memos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByFallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByFallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainChannels) > 0 {
+		for k, v := range m.ChainChannels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Broken {
+		n += 2
+	}
+	if len(m.Discrepancies) > 0 {
+		for _, e := range m.Discrepancies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Discrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Invariant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= AddressVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= AddressVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memos = append(m.Memos, MemoEntry{})
+			if err := m.Memos[len(m.Memos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= AddressVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = make(map[string]Stats)
+			}
+			var mapkey string
+			mapvalue := &Stats{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Stats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stats[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			}
+			m.NumOfAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
+			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfRegistrationFailures", wireType)
+			}
+			m.NumOfRegistrationFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfRegistrationFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			}
+			m.NumOfAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
+			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfRegistrationFailures", wireType)
+			}
+			m.NumOfRegistrationFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfRegistrationFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
+			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstForwardHeight", wireType)
+			}
+			m.FirstForwardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstForwardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastForwardHeight", wireType)
+			}
+			m.LastForwardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastForwardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDay", wireType)
+			}
+			m.StartDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDay", wireType)
+			}
+			m.EndDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, StatsBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			}
+			m.NumOfAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
+			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfRegistrationFailures", wireType)
+			}
+			m.NumOfRegistrationFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfRegistrationFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memos = append(m.Memos, MemoEntry{})
+			if err := m.Memos[len(m.Memos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryMemo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryMemoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryMemos) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryMemosResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Memos) > 0 {
-		for _, e := range m.Memos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1723,12 +7810,82 @@ func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenoms: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ForwardingAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1750,7 +7907,7 @@ func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1773,15 +7930,15 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1802,14 +7959,50 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1832,7 +8025,7 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1855,17 +8048,17 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1875,29 +8068,31 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, ForwardingAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1907,55 +8102,27 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Fallback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1978,7 +8145,7 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2001,15 +8168,15 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2037,13 +8204,13 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2053,62 +8220,28 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exists = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2130,7 +8263,7 @@ func (m *QueryStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByRecipientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2153,15 +8286,15 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByRecipientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2188,105 +8321,46 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = make(map[string]Stats)
+			m.Accounts = append(m.Accounts, ForwardingAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &Stats{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Stats{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Stats[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2309,7 +8383,7 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByFallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2332,15 +8406,15 @@ func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByFallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByFallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2368,7 +8442,43 @@ func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2391,7 +8501,7 @@ func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByFallbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2414,17 +8524,17 @@ func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByFallbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByFallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			m.NumOfAccounts = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2434,33 +8544,29 @@ func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumOfAccounts |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.NumOfForwards = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumOfForwards |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ForwardingAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2487,8 +8593,10 @@ func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
-			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2513,7 +8621,7 @@ func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Stats) Unmarshal(dAtA []byte) error {
+func (m *QueryForwardHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2536,15 +8644,15 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForwardHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForwardHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2572,13 +8680,13 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.NumOfAccounts = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2588,16 +8696,83 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumOfAccounts |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.NumOfForwards = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2607,14 +8782,29 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumOfForwards |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ForwardRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2641,11 +8831,63 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
-			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChains) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChains: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChains: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2667,7 +8909,7 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemo) Unmarshal(dAtA []byte) error {
+func (m *QueryChainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2690,17 +8932,17 @@ func (m *QueryMemo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2710,55 +8952,118 @@ func (m *QueryMemo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if m.ChainChannels == nil {
+				m.ChainChannels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChainChannels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2781,7 +9086,7 @@ func (m *QueryMemo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAudit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2804,44 +9109,12 @@ func (m *QueryMemoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAudit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAudit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2863,7 +9136,7 @@ func (m *QueryMemoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemos) Unmarshal(dAtA []byte) error {
+func (m *QueryAuditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2886,17 +9159,17 @@ func (m *QueryMemos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemos: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2906,27 +9179,15 @@ func (m *QueryMemos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Broken = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2953,10 +9214,8 @@ func (m *QueryMemos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Discrepancies = append(m.Discrepancies, Discrepancy{})
+			if err := m.Discrepancies[len(m.Discrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2981,7 +9240,7 @@ func (m *QueryMemos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemosResponse) Unmarshal(dAtA []byte) error {
+func (m *Discrepancy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,17 +9263,17 @@ func (m *QueryMemosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Discrepancy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Discrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3024,31 +9283,29 @@ func (m *QueryMemosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memos = append(m.Memos, MemoEntry{})
-			if err := m.Memos[len(m.Memos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Invariant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3058,27 +9315,55 @@ func (m *QueryMemosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
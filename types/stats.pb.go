@@ -0,0 +1,806 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/forwarding/v1/stats.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountStats contains the cumulative forwarding statistics of a forwarding account.
+type AccountStats struct {
+	// num_of_forwards is the number of successful forwards.
+	NumOfForwards uint64 `protobuf:"varint,1,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+	// total_forwarded is the total amount forwarded, delineated by denom.
+	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	// first_forward_height is the block height of the first successful forward.
+	FirstForwardHeight int64 `protobuf:"varint,3,opt,name=first_forward_height,json=firstForwardHeight,proto3" json:"first_forward_height,omitempty"`
+	// last_forward_height is the block height of the last successful forward.
+	LastForwardHeight int64 `protobuf:"varint,4,opt,name=last_forward_height,json=lastForwardHeight,proto3" json:"last_forward_height,omitempty"`
+}
+
+func (m *AccountStats) Reset()         { *m = AccountStats{} }
+func (m *AccountStats) String() string { return proto.CompactTextString(m) }
+func (*AccountStats) ProtoMessage()    {}
+func (*AccountStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fc6e74fc1bf469f, []int{0}
+}
+func (m *AccountStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountStats.Merge(m, src)
+}
+func (m *AccountStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountStats proto.InternalMessageInfo
+
+func (m *AccountStats) GetNumOfForwards() uint64 {
+	if m != nil {
+		return m.NumOfForwards
+	}
+	return 0
+}
+
+func (m *AccountStats) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalForwarded
+	}
+	return nil
+}
+
+func (m *AccountStats) GetFirstForwardHeight() int64 {
+	if m != nil {
+		return m.FirstForwardHeight
+	}
+	return 0
+}
+
+func (m *AccountStats) GetLastForwardHeight() int64 {
+	if m != nil {
+		return m.LastForwardHeight
+	}
+	return 0
+}
+
+// StatsBucket contains the forwarding statistics of a channel and denom for a
+// single day, keyed by block time.
+type StatsBucket struct {
+	// day is the number of days since the Unix epoch.
+	Day uint64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	// channel is the channel id that funds were forwarded through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// denom is the denom that was forwarded.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// num_of_forwards is the number of successful forwards.
+	NumOfForwards uint64 `protobuf:"varint,4,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+	// total_forwarded is the total amount forwarded.
+	TotalForwarded cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_forwarded,json=totalForwarded,proto3,customtype=cosmossdk.io/math.Int" json:"total_forwarded"`
+}
+
+func (m *StatsBucket) Reset()         { *m = StatsBucket{} }
+func (m *StatsBucket) String() string { return proto.CompactTextString(m) }
+func (*StatsBucket) ProtoMessage()    {}
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fc6e74fc1bf469f, []int{1}
+}
+func (m *StatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsBucket.Merge(m, src)
+}
+func (m *StatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsBucket proto.InternalMessageInfo
+
+func (m *StatsBucket) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *StatsBucket) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *StatsBucket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *StatsBucket) GetNumOfForwards() uint64 {
+	if m != nil {
+		return m.NumOfForwards
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AccountStats)(nil), "noble.forwarding.v1.AccountStats")
+	proto.RegisterType((*StatsBucket)(nil), "noble.forwarding.v1.StatsBucket")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/stats.proto", fileDescriptor_9fc6e74fc1bf469f) }
+
+var fileDescriptor_9fc6e74fc1bf469f = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x1c, 0xcd, 0xe5, 0x0f, 0xa8, 0xd7, 0x42, 0xd5, 0x6b, 0x10, 0x6e, 0x07, 0x27, 0xea, 0x64, 0x15,
+	0xe5, 0x8e, 0x14, 0x21, 0x66, 0x8c, 0x88, 0xe8, 0x84, 0x14, 0x26, 0x58, 0xac, 0xb3, 0x7d, 0xb1,
+	0xad, 0xd8, 0x77, 0x55, 0xee, 0x1c, 0x94, 0xaf, 0xc0, 0xc4, 0xcc, 0x27, 0x40, 0x4c, 0x1d, 0x98,
+	0xf8, 0x04, 0x1d, 0x2b, 0x26, 0xc4, 0x50, 0xaa, 0x64, 0xe8, 0xd7, 0x40, 0xbe, 0x3b, 0x8b, 0x96,
+	0x46, 0x5d, 0x6c, 0xff, 0xee, 0xf7, 0x9e, 0x7f, 0xef, 0xde, 0xfb, 0xc1, 0x1e, 0x17, 0x61, 0xce,
+	0xc8, 0x44, 0xcc, 0x3e, 0xd2, 0x59, 0x9c, 0xf1, 0x84, 0xcc, 0x87, 0x44, 0x2a, 0xaa, 0x24, 0x3e,
+	0x99, 0x09, 0x25, 0xd0, 0xae, 0x06, 0xe0, 0x7f, 0x00, 0x3c, 0x1f, 0xee, 0xef, 0xd0, 0x22, 0xe3,
+	0x82, 0xe8, 0xa7, 0xc1, 0xed, 0xbb, 0x91, 0x90, 0x85, 0x90, 0x24, 0xa4, 0x92, 0x91, 0xf9, 0x30,
+	0x64, 0x8a, 0x0e, 0x49, 0x24, 0x32, 0x6e, 0xfb, 0x7b, 0xa6, 0x1f, 0xe8, 0x8a, 0x98, 0xc2, 0xb6,
+	0xba, 0x89, 0x48, 0x84, 0x39, 0xaf, 0xbe, 0xcc, 0xe9, 0xc1, 0x8f, 0x26, 0xdc, 0x7a, 0x19, 0x45,
+	0xa2, 0xe4, 0xea, 0x5d, 0xa5, 0x07, 0x0d, 0xe0, 0x36, 0x2f, 0x8b, 0x40, 0x4c, 0x02, 0x2b, 0x46,
+	0x3a, 0xa0, 0x0f, 0xbc, 0xb6, 0xdf, 0xf9, 0x7a, 0x75, 0x7a, 0x08, 0xc6, 0x0f, 0x78, 0x59, 0xbc,
+	0x9d, 0x8c, 0x6c, 0x0f, 0x7d, 0x02, 0x70, 0x5b, 0x09, 0x45, 0xf3, 0x1a, 0xce, 0x62, 0xa7, 0xd9,
+	0x6f, 0x79, 0x9b, 0x47, 0x7b, 0xd8, 0x8e, 0xaf, 0xb4, 0x62, 0xab, 0x15, 0xbf, 0x12, 0x19, 0xf7,
+	0x47, 0x67, 0x17, 0xbd, 0xc6, 0xb7, 0x3f, 0x3d, 0x2f, 0xc9, 0x54, 0x5a, 0x86, 0x38, 0x12, 0x85,
+	0xd5, 0x6a, 0x5f, 0x03, 0x19, 0x4f, 0x89, 0x5a, 0x9c, 0x30, 0xa9, 0x09, 0xf2, 0xcb, 0xd5, 0xe9,
+	0xe1, 0x56, 0xce, 0x12, 0x1a, 0x2d, 0x82, 0xea, 0xb6, 0xd2, 0x68, 0x79, 0xa8, 0x27, 0x8f, 0xea,
+	0xc1, 0xe8, 0x05, 0xec, 0x4e, 0xb2, 0x99, 0x54, 0xb5, 0x96, 0x20, 0x65, 0x59, 0x92, 0x2a, 0xa7,
+	0xd5, 0x07, 0x5e, 0xab, 0xbe, 0x00, 0xd2, 0x10, 0x4b, 0x7a, 0xa3, 0x01, 0xe8, 0x39, 0xdc, 0xcd,
+	0xe9, 0x6d, 0x5e, 0xfb, 0x3a, 0x6f, 0x27, 0xa7, 0xff, 0xd1, 0x0e, 0x2e, 0x01, 0xdc, 0xd4, 0xae,
+	0xf9, 0x65, 0x34, 0x65, 0x0a, 0x3d, 0x86, 0xad, 0x98, 0x2e, 0x6e, 0xfa, 0x55, 0x9d, 0x20, 0x07,
+	0xde, 0x8f, 0x52, 0xca, 0x39, 0xcb, 0x9d, 0x66, 0x1f, 0x78, 0x1b, 0xe3, 0xba, 0x44, 0x5d, 0xd8,
+	0x89, 0x19, 0x17, 0x85, 0xd6, 0xb8, 0x31, 0x36, 0xc5, 0xba, 0x10, 0xda, 0x77, 0x84, 0xf0, 0xfe,
+	0x76, 0x06, 0x9d, 0xea, 0x77, 0xfe, 0xd3, 0xca, 0xe8, 0xdf, 0x17, 0xbd, 0x47, 0xc6, 0x56, 0x19,
+	0x4f, 0x71, 0x26, 0x48, 0x41, 0x55, 0x8a, 0x8f, 0xb9, 0xfa, 0xf9, 0x7d, 0x00, 0x6d, 0x46, 0xc7,
+	0x5c, 0xad, 0xb5, 0xd4, 0x7f, 0x7d, 0xb6, 0x74, 0xc1, 0xf9, 0xd2, 0x05, 0x97, 0x4b, 0x17, 0x7c,
+	0x5e, 0xb9, 0x8d, 0xf3, 0x95, 0xdb, 0xf8, 0xb5, 0x72, 0x1b, 0x1f, 0x9e, 0x5c, 0x0b, 0x4f, 0x6f,
+	0xef, 0x80, 0x4a, 0xc9, 0x94, 0xbc, 0xb1, 0xe5, 0x47, 0x26, 0xc5, 0xf0, 0x9e, 0xde, 0xb6, 0x67,
+	0x7f, 0x07, 0x00, 0xc7, 0x1f, 0x96, 0xbb, 0x09, 0x03, 0x00, 0x00,
+}
+
+func (m *AccountStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastForwardHeight != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.LastForwardHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FirstForwardHeight != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.FirstForwardHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalForwarded.Size()
+		i -= size
+		if _, err := m.TotalForwarded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NumOfForwards != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Day != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfForwards != 0 {
+		n += 1 + sovStats(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if m.FirstForwardHeight != 0 {
+		n += 1 + sovStats(uint64(m.FirstForwardHeight))
+	}
+	if m.LastForwardHeight != 0 {
+		n += 1 + sovStats(uint64(m.LastForwardHeight))
+	}
+	return n
+}
+
+func (m *StatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovStats(uint64(m.Day))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if m.NumOfForwards != 0 {
+		n += 1 + sovStats(uint64(m.NumOfForwards))
+	}
+	l = m.TotalForwarded.Size()
+	n += 1 + l + sovStats(uint64(l))
+	return n
+}
+
+func sovStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStats(x uint64) (n int) {
+	return sovStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
+			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstForwardHeight", wireType)
+			}
+			m.FirstForwardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstForwardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastForwardHeight", wireType)
+			}
+			m.LastForwardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastForwardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalForwarded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStats = fmt.Errorf("proto: unexpected end of group")
+)
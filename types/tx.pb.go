@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -37,6 +38,12 @@ type MsgRegisterAccount struct {
 	Channel   string      `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
 	Fallback  string      `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
 	Memos     []MemoEntry `protobuf:"bytes,5,rep,name=memos,proto3" json:"memos"`
+	// address_version is the scheme used to derive the account's address,
+	// defaulting to the latest version if unspecified.
+	AddressVersion AddressVersion `protobuf:"varint,6,opt,name=address_version,json=addressVersion,proto3,enum=noble.forwarding.v1.AddressVersion" json:"address_version,omitempty"`
+	// chain_id optionally identifies the counterparty chain, whose registered
+	// channel is used if the channel is unspecified.
+	ChainId string `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *MsgRegisterAccount) Reset()         { *m = MsgRegisterAccount{} }
@@ -273,6 +280,10 @@ type MsgSetMemo struct {
 	Fallback  string `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
 	Denom     string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 	Memo      string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// address_version is the scheme used to derive the account's address. If
+	// unspecified, the latest version is used if such an account exists, and v1
+	// otherwise.
+	AddressVersion AddressVersion `protobuf:"varint,7,opt,name=address_version,json=addressVersion,proto3,enum=noble.forwarding.v1.AddressVersion" json:"address_version,omitempty"`
 }
 
 func (m *MsgSetMemo) Reset()         { *m = MsgSetMemo{} }
@@ -344,728 +355,3336 @@ func (m *MsgSetMemoResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetMemoResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterAccount)(nil), "noble.forwarding.v1.MsgRegisterAccount")
-	proto.RegisterType((*MsgRegisterAccountResponse)(nil), "noble.forwarding.v1.MsgRegisterAccountResponse")
-	proto.RegisterType((*MsgClearAccount)(nil), "noble.forwarding.v1.MsgClearAccount")
-	proto.RegisterType((*MsgClearAccountResponse)(nil), "noble.forwarding.v1.MsgClearAccountResponse")
-	proto.RegisterType((*MsgSetAllowedDenoms)(nil), "noble.forwarding.v1.MsgSetAllowedDenoms")
-	proto.RegisterType((*MsgSetAllowedDenomsResponse)(nil), "noble.forwarding.v1.MsgSetAllowedDenomsResponse")
-	proto.RegisterType((*MsgSetMemo)(nil), "noble.forwarding.v1.MsgSetMemo")
-	proto.RegisterType((*MsgSetMemoResponse)(nil), "noble.forwarding.v1.MsgSetMemoResponse")
+// set the number of forward history records kept per forwarding account
+type MsgSetHistoryRetention struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Retention uint64 `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
 }
 
-func init() { proto.RegisterFile("noble/forwarding/v1/tx.proto", fileDescriptor_e8a8b8337aa6ea1a) }
-
-var fileDescriptor_e8a8b8337aa6ea1a = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x93, 0x26, 0x6d, 0x0f, 0xa4, 0xc2, 0x35, 0xa2, 0xae, 0x69, 0x9d, 0x60, 0x21, 0x35,
-	0x0a, 0xd4, 0x4e, 0x03, 0x48, 0x90, 0x2d, 0x81, 0x8e, 0x91, 0x90, 0xb3, 0xb1, 0x20, 0xc7, 0xb9,
-	0x5e, 0xad, 0xda, 0x77, 0x91, 0xcf, 0x6d, 0xe9, 0x86, 0x98, 0x2a, 0x26, 0x66, 0xa6, 0x8a, 0x91,
-	0x29, 0x03, 0x3f, 0xa2, 0x62, 0xaa, 0x98, 0x98, 0x10, 0x4a, 0x86, 0xf0, 0x33, 0x90, 0xcf, 0x76,
-	0x92, 0xc6, 0x09, 0x89, 0x60, 0x61, 0x89, 0xfc, 0xee, 0xfb, 0xde, 0x7b, 0xdf, 0x7d, 0x79, 0xcf,
-	0x06, 0x5b, 0x84, 0xb6, 0x1c, 0xa4, 0x1f, 0x50, 0xef, 0xd4, 0xf4, 0xda, 0x36, 0xc1, 0xfa, 0xc9,
-	0x9e, 0xee, 0xbf, 0xd1, 0x3a, 0x1e, 0xf5, 0x29, 0x5c, 0xe7, 0xa8, 0x36, 0x42, 0xb5, 0x93, 0x3d,
-	0xf9, 0xb6, 0xe9, 0xda, 0x84, 0xea, 0xfc, 0x37, 0xe4, 0xc9, 0x1b, 0x16, 0x65, 0x2e, 0x65, 0xba,
-	0xcb, 0x78, 0xbe, 0xcb, 0x70, 0x04, 0x6c, 0x86, 0xc0, 0x6b, 0x1e, 0xe9, 0x61, 0x10, 0x41, 0x39,
-	0x4c, 0x31, 0x0d, 0xcf, 0x83, 0xa7, 0xe8, 0x54, 0x99, 0xa6, 0xc7, 0x45, 0x6e, 0x84, 0xab, 0x9f,
-	0x53, 0x00, 0x36, 0x18, 0x36, 0x10, 0xb6, 0x99, 0x8f, 0xbc, 0x9a, 0x65, 0xd1, 0x63, 0xe2, 0xc3,
-	0x32, 0xc8, 0x32, 0x1b, 0x13, 0xe4, 0x49, 0x62, 0x41, 0x2c, 0xae, 0xd6, 0xa5, 0x6f, 0x5f, 0x76,
-	0x73, 0x51, 0xbb, 0x5a, 0xbb, 0xed, 0x21, 0xc6, 0x9a, 0xbe, 0x67, 0x13, 0x6c, 0x44, 0x3c, 0xb8,
-	0x05, 0x56, 0x3d, 0x64, 0xd9, 0x1d, 0x1b, 0x11, 0x5f, 0x4a, 0x05, 0x49, 0xc6, 0xe8, 0x00, 0x4a,
-	0x60, 0xd9, 0x3a, 0x34, 0x09, 0x41, 0x8e, 0x94, 0xe6, 0x58, 0x1c, 0xc2, 0xc7, 0x60, 0xe5, 0xc0,
-	0x74, 0x9c, 0x96, 0x69, 0x1d, 0x49, 0x4b, 0x73, 0x7a, 0x0d, 0x99, 0xb0, 0x0a, 0x32, 0xc1, 0x25,
-	0x98, 0x94, 0x29, 0xa4, 0x8b, 0x37, 0x2a, 0x8a, 0x36, 0xc5, 0x58, 0xad, 0x81, 0x5c, 0xba, 0x4f,
-	0x7c, 0xef, 0xac, 0xbe, 0x74, 0xf9, 0x23, 0x2f, 0x18, 0x61, 0x4a, 0xf5, 0xe9, 0xf9, 0x45, 0x5e,
-	0xf8, 0x75, 0x91, 0x17, 0xde, 0x0d, 0xba, 0xa5, 0x48, 0xfe, 0xfb, 0x41, 0xb7, 0x54, 0x48, 0x58,
-	0x35, 0xe1, 0x8a, 0xfa, 0x12, 0xc8, 0x49, 0xaf, 0x0c, 0xc4, 0x3a, 0x94, 0x30, 0x04, 0x2b, 0x60,
-	0xd9, 0x0c, 0xe5, 0xce, 0x35, 0x2d, 0x26, 0xaa, 0x5f, 0x45, 0xb0, 0xd6, 0x60, 0xf8, 0xb9, 0x83,
-	0xcc, 0x7f, 0xf0, 0x7e, 0xac, 0x73, 0x6a, 0xc1, 0xce, 0x50, 0x1e, 0xf3, 0x3d, 0xf8, 0x4b, 0x56,
-	0x46, 0xee, 0x56, 0x9f, 0xcc, 0x70, 0x68, 0x3b, 0xe1, 0xd0, 0xb8, 0x70, 0x75, 0x13, 0x6c, 0x4c,
-	0xdc, 0x25, 0xf6, 0x46, 0xfd, 0x28, 0x82, 0xf5, 0x06, 0xc3, 0x4d, 0xe4, 0xd7, 0x1c, 0x87, 0x9e,
-	0xa2, 0xf6, 0x0b, 0x44, 0xa8, 0xcb, 0xfe, 0xe2, 0xae, 0x77, 0x40, 0xb6, 0xcd, 0x73, 0xa5, 0x54,
-	0x21, 0x5d, 0x5c, 0x35, 0xa2, 0xa8, 0xfa, 0x6c, 0x86, 0xe6, 0x7b, 0x09, 0xcd, 0x93, 0x22, 0xd4,
-	0x6d, 0x70, 0x77, 0x8a, 0xb6, 0xa1, 0xf6, 0xf3, 0x14, 0x00, 0x21, 0x1e, 0x0c, 0xd4, 0x7f, 0xbf,
-	0x1a, 0x39, 0x90, 0xe1, 0x96, 0x48, 0x19, 0x5e, 0x2d, 0x0c, 0x20, 0x04, 0x4b, 0xc1, 0xf4, 0x4b,
-	0x59, 0x7e, 0xc8, 0x9f, 0xab, 0xe5, 0x19, 0x96, 0x49, 0xd3, 0x2c, 0x0b, 0xee, 0xae, 0xe6, 0xf8,
-	0xcb, 0x22, 0x8a, 0x62, 0x83, 0x2a, 0x9f, 0xd2, 0x20, 0xdd, 0x60, 0x18, 0x1e, 0x81, 0xb5, 0xc9,
-	0xf7, 0xc8, 0xce, 0xf4, 0xc5, 0x4c, 0x2c, 0x91, 0xac, 0x2f, 0x48, 0x1c, 0x6e, 0x5b, 0x0b, 0xdc,
-	0xbc, 0xb6, 0x35, 0xf7, 0x67, 0x15, 0x18, 0x67, 0xc9, 0x0f, 0x17, 0x61, 0x0d, 0x7b, 0x10, 0x70,
-	0x2b, 0x31, 0xb1, 0xc5, 0x59, 0x15, 0x26, 0x99, 0x72, 0x79, 0x51, 0xe6, 0xb0, 0x5f, 0x13, 0x2c,
-	0xc7, 0x53, 0x96, 0xff, 0x43, 0x72, 0x40, 0x90, 0x77, 0xe6, 0x10, 0xe2, 0xa2, 0x72, 0xe6, 0xed,
-	0xa0, 0x5b, 0x12, 0xeb, 0xfb, 0x97, 0x3d, 0x45, 0xbc, 0xea, 0x29, 0xe2, 0xcf, 0x9e, 0x22, 0x7e,
-	0xe8, 0x2b, 0xc2, 0x55, 0x5f, 0x11, 0xbe, 0xf7, 0x15, 0xe1, 0xd5, 0x03, 0x6c, 0xfb, 0x87, 0xc7,
-	0x2d, 0xcd, 0xa2, 0xae, 0xce, 0x6b, 0xee, 0x9a, 0x8c, 0x21, 0x9f, 0x5d, 0xfb, 0x68, 0x54, 0x74,
-	0xff, 0xac, 0x83, 0x58, 0x2b, 0xcb, 0x3f, 0x1b, 0x8f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xdb,
-	0x60, 0x80, 0xd7, 0xe8, 0x06, 0x00, 0x00,
+func (m *MsgSetHistoryRetention) Reset()         { *m = MsgSetHistoryRetention{} }
+func (m *MsgSetHistoryRetention) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHistoryRetention) ProtoMessage()    {}
+func (*MsgSetHistoryRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{8}
+}
+func (m *MsgSetHistoryRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHistoryRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHistoryRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHistoryRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHistoryRetention.Merge(m, src)
+}
+func (m *MsgSetHistoryRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHistoryRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHistoryRetention.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetHistoryRetention proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
-	ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error)
-	SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error)
-	SetMemo(ctx context.Context, in *MsgSetMemo, opts ...grpc.CallOption) (*MsgSetMemoResponse, error)
+type MsgSetHistoryRetentionResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetHistoryRetentionResponse) Reset()         { *m = MsgSetHistoryRetentionResponse{} }
+func (m *MsgSetHistoryRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHistoryRetentionResponse) ProtoMessage()    {}
+func (*MsgSetHistoryRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{9}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetHistoryRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error) {
-	out := new(MsgRegisterAccountResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/RegisterAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetHistoryRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHistoryRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetHistoryRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHistoryRetentionResponse.Merge(m, src)
+}
+func (m *MsgSetHistoryRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHistoryRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHistoryRetentionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error) {
-	out := new(MsgClearAccountResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/ClearAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetHistoryRetentionResponse proto.InternalMessageInfo
+
+// set the number of days of time-bucketed stats that are kept
+type MsgSetStatsRetention struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Retention uint64 `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
 }
 
-func (c *msgClient) SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error) {
-	out := new(MsgSetAllowedDenomsResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetAllowedDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetStatsRetention) Reset()         { *m = MsgSetStatsRetention{} }
+func (m *MsgSetStatsRetention) String() string { return proto.CompactTextString(m) }
+func (*MsgSetStatsRetention) ProtoMessage()    {}
+func (*MsgSetStatsRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{10}
+}
+func (m *MsgSetStatsRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetStatsRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetStatsRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetStatsRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetStatsRetention.Merge(m, src)
+}
+func (m *MsgSetStatsRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetStatsRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetStatsRetention.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetMemo(ctx context.Context, in *MsgSetMemo, opts ...grpc.CallOption) (*MsgSetMemoResponse, error) {
-	out := new(MsgSetMemoResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetMemo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetStatsRetention proto.InternalMessageInfo
+
+type MsgSetStatsRetentionResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
-	ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error)
-	SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error)
-	SetMemo(context.Context, *MsgSetMemo) (*MsgSetMemoResponse, error)
+func (m *MsgSetStatsRetentionResponse) Reset()         { *m = MsgSetStatsRetentionResponse{} }
+func (m *MsgSetStatsRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetStatsRetentionResponse) ProtoMessage()    {}
+func (*MsgSetStatsRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{11}
+}
+func (m *MsgSetStatsRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetStatsRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetStatsRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetStatsRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetStatsRetentionResponse.Merge(m, src)
+}
+func (m *MsgSetStatsRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetStatsRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetStatsRetentionResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSetStatsRetentionResponse proto.InternalMessageInfo
+
+type MsgSetChainChannel struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// channel is the canonical transfer channel to the chain. If empty, the
+	// chain is removed from the registry.
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (*UnimplementedMsgServer) RegisterAccount(ctx context.Context, req *MsgRegisterAccount) (*MsgRegisterAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount not implemented")
+func (m *MsgSetChainChannel) Reset()         { *m = MsgSetChainChannel{} }
+func (m *MsgSetChainChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChainChannel) ProtoMessage()    {}
+func (*MsgSetChainChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{12}
 }
-func (*UnimplementedMsgServer) ClearAccount(ctx context.Context, req *MsgClearAccount) (*MsgClearAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearAccount not implemented")
+func (m *MsgSetChainChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetAllowedDenoms(ctx context.Context, req *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedDenoms not implemented")
+func (m *MsgSetChainChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChainChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetMemo(ctx context.Context, req *MsgSetMemo) (*MsgSetMemoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMemo not implemented")
+func (m *MsgSetChainChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChainChannel.Merge(m, src)
+}
+func (m *MsgSetChainChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChainChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChainChannel.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetChainChannel proto.InternalMessageInfo
+
+type MsgSetChainChannelResponse struct {
 }
 
-func _Msg_RegisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Msg/RegisterAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAccount(ctx, req.(*MsgRegisterAccount))
+func (m *MsgSetChainChannelResponse) Reset()         { *m = MsgSetChainChannelResponse{} }
+func (m *MsgSetChainChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChainChannelResponse) ProtoMessage()    {}
+func (*MsgSetChainChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{13}
+}
+func (m *MsgSetChainChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChainChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChainChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetChainChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChainChannelResponse.Merge(m, src)
+}
+func (m *MsgSetChainChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChainChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChainChannelResponse.DiscardUnknown(m)
 }
 
-func _Msg_ClearAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClearAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClearAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Msg/ClearAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClearAccount(ctx, req.(*MsgClearAccount))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetChainChannelResponse proto.InternalMessageInfo
+
+// set how failed registrations through transfer memos are handled
+type MsgSetRegistrationMode struct {
+	Signer string           `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Mode   RegistrationMode `protobuf:"varint,2,opt,name=mode,proto3,enum=noble.forwarding.v1.RegistrationMode" json:"mode,omitempty"`
 }
 
-func _Msg_SetAllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAllowedDenoms)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAllowedDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Msg/SetAllowedDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAllowedDenoms(ctx, req.(*MsgSetAllowedDenoms))
+func (m *MsgSetRegistrationMode) Reset()         { *m = MsgSetRegistrationMode{} }
+func (m *MsgSetRegistrationMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRegistrationMode) ProtoMessage()    {}
+func (*MsgSetRegistrationMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{14}
+}
+func (m *MsgSetRegistrationMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRegistrationMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRegistrationMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetRegistrationMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRegistrationMode.Merge(m, src)
+}
+func (m *MsgSetRegistrationMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRegistrationMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRegistrationMode.DiscardUnknown(m)
 }
 
-func _Msg_SetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetMemo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetMemo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Msg/SetMemo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetMemo(ctx, req.(*MsgSetMemo))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetRegistrationMode proto.InternalMessageInfo
+
+type MsgSetRegistrationModeResponse struct {
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.forwarding.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterAccount",
-			Handler:    _Msg_RegisterAccount_Handler,
-		},
-		{
-			MethodName: "ClearAccount",
-			Handler:    _Msg_ClearAccount_Handler,
-		},
-		{
-			MethodName: "SetAllowedDenoms",
-			Handler:    _Msg_SetAllowedDenoms_Handler,
-		},
-		{
-			MethodName: "SetMemo",
-			Handler:    _Msg_SetMemo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/forwarding/v1/tx.proto",
+func (m *MsgSetRegistrationModeResponse) Reset()         { *m = MsgSetRegistrationModeResponse{} }
+func (m *MsgSetRegistrationModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRegistrationModeResponse) ProtoMessage()    {}
+func (*MsgSetRegistrationModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{15}
 }
-
-func (m *MsgRegisterAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRegistrationModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRegistrationModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRegistrationModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetRegistrationModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRegistrationModeResponse.Merge(m, src)
+}
+func (m *MsgSetRegistrationModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRegistrationModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRegistrationModeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRegistrationModeResponse proto.InternalMessageInfo
+
+// set when funds received by forwarding accounts over IBC are forwarded
+type MsgSetForwardMode struct {
+	Signer string      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Mode   ForwardMode `protobuf:"varint,2,opt,name=mode,proto3,enum=noble.forwarding.v1.ForwardMode" json:"mode,omitempty"`
 }
 
-func (m *MsgRegisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Memos) > 0 {
-		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Memos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgSetForwardMode) Reset()         { *m = MsgSetForwardMode{} }
+func (m *MsgSetForwardMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwardMode) ProtoMessage()    {}
+func (*MsgSetForwardMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{16}
+}
+func (m *MsgSetForwardMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForwardMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwardMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Fallback) > 0 {
-		i -= len(m.Fallback)
-		copy(dAtA[i:], m.Fallback)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Fallback)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRegisterAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetForwardMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwardMode.Merge(m, src)
 }
-
-func (m *MsgRegisterAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetForwardMode) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgRegisterAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetForwardMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwardMode.DiscardUnknown(m)
 }
 
-func (m *MsgClearAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetForwardMode proto.InternalMessageInfo
 
-func (m *MsgClearAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetForwardModeResponse struct {
 }
 
-func (m *MsgClearAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fallback {
-		i--
-		if m.Fallback {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSetForwardModeResponse) Reset()         { *m = MsgSetForwardModeResponse{} }
+func (m *MsgSetForwardModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwardModeResponse) ProtoMessage()    {}
+func (*MsgSetForwardModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{17}
+}
+func (m *MsgSetForwardModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForwardModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwardModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgClearAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetForwardModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwardModeResponse.Merge(m, src)
 }
-
-func (m *MsgClearAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetForwardModeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgClearAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetForwardModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwardModeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetAllowedDenoms) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetForwardModeResponse proto.InternalMessageInfo
 
-func (m *MsgSetAllowedDenoms) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// set the fee deducted from forwarding accounts registering signerlessly
+type MsgSetSignerlessFee struct {
+	Signer string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Fee    types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
 }
 
-func (m *MsgSetAllowedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetSignerlessFee) Reset()         { *m = MsgSetSignerlessFee{} }
+func (m *MsgSetSignerlessFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSignerlessFee) ProtoMessage()    {}
+func (*MsgSetSignerlessFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{18}
+}
+func (m *MsgSetSignerlessFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSignerlessFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSignerlessFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetSignerlessFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSignerlessFee.Merge(m, src)
 }
-
-func (m *MsgSetAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSignerlessFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSignerlessFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSignerlessFee.DiscardUnknown(m)
 }
 
-func (m *MsgSetAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetSignerlessFee proto.InternalMessageInfo
+
+type MsgSetSignerlessFeeResponse struct {
 }
 
-func (m *MsgSetMemo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetSignerlessFeeResponse) Reset()         { *m = MsgSetSignerlessFeeResponse{} }
+func (m *MsgSetSignerlessFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSignerlessFeeResponse) ProtoMessage()    {}
+func (*MsgSetSignerlessFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{19}
+}
+func (m *MsgSetSignerlessFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSignerlessFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSignerlessFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetSignerlessFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSignerlessFeeResponse.Merge(m, src)
+}
+func (m *MsgSetSignerlessFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSignerlessFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSignerlessFeeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetMemo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetSignerlessFeeResponse proto.InternalMessageInfo
+
+// set the fee burned from the balance of newly registered forwarding accounts
+type MsgSetRegistrationFee struct {
+	Signer string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Fee    types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
 }
 
-func (m *MsgSetMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Fallback) > 0 {
-		i -= len(m.Fallback)
-		copy(dAtA[i:], m.Fallback)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Fallback)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetRegistrationFee) Reset()         { *m = MsgSetRegistrationFee{} }
+func (m *MsgSetRegistrationFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRegistrationFee) ProtoMessage()    {}
+func (*MsgSetRegistrationFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{20}
+}
+func (m *MsgSetRegistrationFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRegistrationFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRegistrationFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetRegistrationFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRegistrationFee.Merge(m, src)
+}
+func (m *MsgSetRegistrationFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRegistrationFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRegistrationFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRegistrationFee proto.InternalMessageInfo
+
+type MsgSetRegistrationFeeResponse struct {
+}
+
+func (m *MsgSetRegistrationFeeResponse) Reset()         { *m = MsgSetRegistrationFeeResponse{} }
+func (m *MsgSetRegistrationFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRegistrationFeeResponse) ProtoMessage()    {}
+func (*MsgSetRegistrationFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8a8b8337aa6ea1a, []int{21}
+}
+func (m *MsgSetRegistrationFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRegistrationFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRegistrationFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetRegistrationFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRegistrationFeeResponse.Merge(m, src)
+}
+func (m *MsgSetRegistrationFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRegistrationFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRegistrationFeeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetMemoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRegistrationFeeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterAccount)(nil), "noble.forwarding.v1.MsgRegisterAccount")
+	proto.RegisterType((*MsgRegisterAccountResponse)(nil), "noble.forwarding.v1.MsgRegisterAccountResponse")
+	proto.RegisterType((*MsgClearAccount)(nil), "noble.forwarding.v1.MsgClearAccount")
+	proto.RegisterType((*MsgClearAccountResponse)(nil), "noble.forwarding.v1.MsgClearAccountResponse")
+	proto.RegisterType((*MsgSetAllowedDenoms)(nil), "noble.forwarding.v1.MsgSetAllowedDenoms")
+	proto.RegisterType((*MsgSetAllowedDenomsResponse)(nil), "noble.forwarding.v1.MsgSetAllowedDenomsResponse")
+	proto.RegisterType((*MsgSetMemo)(nil), "noble.forwarding.v1.MsgSetMemo")
+	proto.RegisterType((*MsgSetMemoResponse)(nil), "noble.forwarding.v1.MsgSetMemoResponse")
+	proto.RegisterType((*MsgSetHistoryRetention)(nil), "noble.forwarding.v1.MsgSetHistoryRetention")
+	proto.RegisterType((*MsgSetHistoryRetentionResponse)(nil), "noble.forwarding.v1.MsgSetHistoryRetentionResponse")
+	proto.RegisterType((*MsgSetStatsRetention)(nil), "noble.forwarding.v1.MsgSetStatsRetention")
+	proto.RegisterType((*MsgSetStatsRetentionResponse)(nil), "noble.forwarding.v1.MsgSetStatsRetentionResponse")
+	proto.RegisterType((*MsgSetChainChannel)(nil), "noble.forwarding.v1.MsgSetChainChannel")
+	proto.RegisterType((*MsgSetChainChannelResponse)(nil), "noble.forwarding.v1.MsgSetChainChannelResponse")
+	proto.RegisterType((*MsgSetRegistrationMode)(nil), "noble.forwarding.v1.MsgSetRegistrationMode")
+	proto.RegisterType((*MsgSetRegistrationModeResponse)(nil), "noble.forwarding.v1.MsgSetRegistrationModeResponse")
+	proto.RegisterType((*MsgSetForwardMode)(nil), "noble.forwarding.v1.MsgSetForwardMode")
+	proto.RegisterType((*MsgSetForwardModeResponse)(nil), "noble.forwarding.v1.MsgSetForwardModeResponse")
+	proto.RegisterType((*MsgSetSignerlessFee)(nil), "noble.forwarding.v1.MsgSetSignerlessFee")
+	proto.RegisterType((*MsgSetSignerlessFeeResponse)(nil), "noble.forwarding.v1.MsgSetSignerlessFeeResponse")
+	proto.RegisterType((*MsgSetRegistrationFee)(nil), "noble.forwarding.v1.MsgSetRegistrationFee")
+	proto.RegisterType((*MsgSetRegistrationFeeResponse)(nil), "noble.forwarding.v1.MsgSetRegistrationFeeResponse")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/tx.proto", fileDescriptor_e8a8b8337aa6ea1a) }
+
+var fileDescriptor_e8a8b8337aa6ea1a = []byte{
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xf3, 0xd1, 0x34, 0x6f, 0x51, 0x4b, 0xdd, 0xb0, 0xeb, 0x78, 0x5b, 0x27, 0x78, 0x17,
+	0x36, 0x74, 0x59, 0xbb, 0x49, 0x97, 0x8f, 0x0d, 0x5c, 0xda, 0xb2, 0x2b, 0x90, 0x88, 0x84, 0x1c,
+	0x89, 0x03, 0x97, 0xca, 0x71, 0xa6, 0xae, 0xd5, 0xd8, 0x13, 0x3c, 0xde, 0x96, 0xde, 0x10, 0x27,
+	0xc4, 0x09, 0x89, 0x1b, 0xa7, 0x15, 0x07, 0xc4, 0xb1, 0x87, 0xe5, 0xc2, 0x81, 0x0b, 0x1c, 0x56,
+	0x9c, 0x2a, 0x0e, 0x88, 0x13, 0x42, 0xed, 0xa1, 0xfc, 0x19, 0xc8, 0x9f, 0x89, 0xbf, 0x52, 0x27,
+	0x08, 0xc4, 0x25, 0xca, 0xcc, 0xfc, 0xe6, 0xbd, 0xdf, 0xef, 0xbd, 0x79, 0x6f, 0xc6, 0xb0, 0x66,
+	0xe0, 0xfe, 0x10, 0x89, 0xfb, 0xd8, 0x3c, 0x96, 0xcd, 0x81, 0x66, 0xa8, 0xe2, 0x51, 0x4b, 0xb4,
+	0x3e, 0x11, 0x46, 0x26, 0xb6, 0x30, 0xbd, 0xea, 0xac, 0x0a, 0xe3, 0x55, 0xe1, 0xa8, 0xc5, 0xae,
+	0xc8, 0xba, 0x66, 0x60, 0xd1, 0xf9, 0x75, 0x71, 0x2c, 0xa7, 0x60, 0xa2, 0x63, 0x22, 0xf6, 0x65,
+	0x82, 0xc4, 0xa3, 0x56, 0x1f, 0x59, 0x72, 0x4b, 0x54, 0xb0, 0x66, 0x78, 0xeb, 0x37, 0xbc, 0x75,
+	0x9d, 0x38, 0xf6, 0x75, 0xa2, 0x7a, 0x0b, 0x35, 0x77, 0x61, 0xcf, 0x19, 0x89, 0xee, 0xc0, 0x5b,
+	0xaa, 0xaa, 0x58, 0xc5, 0xee, 0xbc, 0xfd, 0xcf, 0x9b, 0x7d, 0x31, 0x89, 0xaf, 0xac, 0x28, 0xf8,
+	0xb1, 0x61, 0xf9, 0x64, 0x92, 0x20, 0x3a, 0xd2, 0x7d, 0x13, 0x8d, 0xa4, 0xf5, 0x91, 0xac, 0x1c,
+	0x22, 0xcf, 0x02, 0xff, 0x55, 0x01, 0xe8, 0x2e, 0x51, 0x25, 0xa4, 0x6a, 0xc4, 0x42, 0xe6, 0xb6,
+	0x6b, 0x9e, 0xde, 0x84, 0x05, 0xa2, 0xa9, 0x06, 0x32, 0x19, 0xaa, 0x41, 0x35, 0x2b, 0x3b, 0xcc,
+	0xaf, 0x4f, 0xef, 0x55, 0x3d, 0xce, 0xdb, 0x83, 0x81, 0x89, 0x08, 0xe9, 0x59, 0xa6, 0x66, 0xa8,
+	0x92, 0x87, 0xa3, 0xd7, 0xa0, 0x62, 0x22, 0x45, 0x1b, 0x69, 0xc8, 0xb0, 0x98, 0xbc, 0xbd, 0x49,
+	0x1a, 0x4f, 0xd0, 0x0c, 0x94, 0x95, 0x03, 0xd9, 0x30, 0xd0, 0x90, 0x29, 0x38, 0x6b, 0xfe, 0x90,
+	0xbe, 0x0f, 0x8b, 0xfb, 0xf2, 0x70, 0xd8, 0x97, 0x95, 0x43, 0xa6, 0x78, 0x85, 0xaf, 0x00, 0x49,
+	0x77, 0xa0, 0x64, 0xcb, 0x24, 0x4c, 0xa9, 0x51, 0x68, 0x5e, 0x6b, 0x73, 0x42, 0x42, 0xf6, 0x84,
+	0x2e, 0xd2, 0xf1, 0x43, 0xc3, 0x32, 0x4f, 0x76, 0x8a, 0xcf, 0xfe, 0xa8, 0xe7, 0x24, 0x77, 0x0b,
+	0xfd, 0x3e, 0x2c, 0xcb, 0xae, 0xd9, 0xbd, 0x23, 0x64, 0x12, 0x0d, 0x1b, 0xcc, 0x42, 0x83, 0x6a,
+	0x2e, 0xb5, 0x6f, 0x25, 0x5a, 0xf1, 0x28, 0x7c, 0xe8, 0x42, 0xa5, 0x25, 0x39, 0x34, 0xa6, 0x6b,
+	0xb0, 0xa8, 0x1c, 0xc8, 0x9a, 0xb1, 0xa7, 0x0d, 0x98, 0x72, 0x20, 0x4d, 0x33, 0xde, 0x1b, 0x74,
+	0xde, 0xfc, 0xfc, 0x49, 0x3d, 0xf7, 0xd7, 0x93, 0x7a, 0xee, 0xb3, 0xcb, 0xd3, 0x0d, 0x2f, 0x4e,
+	0x5f, 0x5c, 0x9e, 0x6e, 0xc4, 0xb3, 0x12, 0x09, 0x3f, 0xff, 0x01, 0xb0, 0xf1, 0xa4, 0x48, 0x88,
+	0x8c, 0xb0, 0x41, 0x10, 0xdd, 0x86, 0xb2, 0x47, 0xe2, 0xca, 0xec, 0xf8, 0x40, 0xfe, 0x17, 0x0a,
+	0x96, 0xbb, 0x44, 0xdd, 0x1d, 0x22, 0xf9, 0x1f, 0x24, 0x79, 0xc2, 0x73, 0x3e, 0xa3, 0x67, 0x9a,
+	0x9d, 0x48, 0xb0, 0x9d, 0xfb, 0xc5, 0x71, 0x1a, 0x3b, 0xaf, 0xa5, 0x44, 0x68, 0x3d, 0x16, 0xa1,
+	0x49, 0xe2, 0x7c, 0x0d, 0x6e, 0x44, 0xb4, 0xf8, 0xb1, 0xe1, 0xbf, 0xa6, 0x60, 0xb5, 0x4b, 0xd4,
+	0x1e, 0xb2, 0xb6, 0x87, 0x43, 0x7c, 0x8c, 0x06, 0xef, 0x20, 0x03, 0xeb, 0x64, 0x0e, 0xad, 0xd7,
+	0x61, 0x61, 0xe0, 0xec, 0x65, 0xf2, 0x8d, 0x42, 0xb3, 0x22, 0x79, 0xa3, 0xce, 0x83, 0x14, 0xce,
+	0xf1, 0x72, 0x8d, 0x92, 0xe0, 0xd7, 0xe1, 0x66, 0x02, 0xb7, 0x80, 0xfb, 0x6f, 0x79, 0x00, 0x77,
+	0xdd, 0x3e, 0xb9, 0xff, 0xfb, 0x1a, 0xac, 0x42, 0xc9, 0x09, 0x09, 0x53, 0x72, 0xac, 0xb9, 0x03,
+	0x9a, 0x86, 0xa2, 0x5d, 0x66, 0x4e, 0x49, 0x55, 0x24, 0xe7, 0x7f, 0x52, 0xc5, 0x95, 0xe7, 0xae,
+	0xb8, 0xce, 0x66, 0x4a, 0x02, 0x98, 0xa4, 0x04, 0xd8, 0x91, 0xe4, 0xab, 0x4e, 0x8f, 0xf3, 0x46,
+	0x41, 0xb8, 0xbf, 0xa5, 0xe0, 0xba, 0x3b, 0xfd, 0xae, 0x46, 0x2c, 0x6c, 0x9e, 0x48, 0xc8, 0x42,
+	0x86, 0x65, 0x17, 0xf5, 0x9c, 0xa1, 0xf7, 0xb6, 0x3b, 0xa1, 0x2f, 0x4a, 0xe3, 0x89, 0xce, 0xdb,
+	0x29, 0x94, 0x6f, 0x27, 0x51, 0x8e, 0xb2, 0xe1, 0x1b, 0xc0, 0x25, 0xf3, 0x0c, 0xa4, 0x7c, 0x43,
+	0x41, 0xd5, 0x85, 0xf4, 0x2c, 0xd9, 0x22, 0xff, 0x9e, 0x90, 0x4e, 0x8a, 0x10, 0x3e, 0x49, 0x48,
+	0x98, 0x0b, 0xcf, 0xc1, 0x5a, 0x12, 0xc7, 0x40, 0xc4, 0xf7, 0x94, 0x9f, 0xa6, 0x5d, 0xbb, 0x81,
+	0xee, 0x7a, 0x87, 0x73, 0x76, 0x09, 0x93, 0x2d, 0x39, 0x1f, 0x6a, 0xc9, 0xe9, 0x35, 0x30, 0x43,
+	0xb3, 0x8e, 0x10, 0xe4, 0xd7, 0x9c, 0x66, 0x1d, 0x99, 0x0d, 0x54, 0xfd, 0x14, 0x9c, 0x32, 0xb7,
+	0x9d, 0x9b, 0xb2, 0x2d, 0xba, 0x8b, 0x07, 0x68, 0x0e, 0x65, 0x0f, 0xa0, 0xa8, 0xe3, 0x01, 0x72,
+	0x54, 0x2d, 0xb5, 0x5f, 0x4a, 0xac, 0x9e, 0xa8, 0x1b, 0xc9, 0xd9, 0x32, 0xdb, 0x11, 0x8c, 0xda,
+	0x18, 0x1f, 0xc1, 0x98, 0x75, 0x5f, 0xe7, 0x53, 0x0a, 0x56, 0x5c, 0xc8, 0x23, 0xd7, 0xd4, 0x9c,
+	0x12, 0xef, 0x87, 0x24, 0x36, 0x12, 0x25, 0x4e, 0x78, 0xf0, 0xd4, 0xbd, 0x91, 0xa2, 0xae, 0x9e,
+	0xa4, 0x6e, 0x62, 0x3b, 0x7f, 0x13, 0x6a, 0x31, 0xd6, 0x81, 0xa6, 0x1f, 0x82, 0xcb, 0xa4, 0xe7,
+	0x58, 0x1c, 0x22, 0x42, 0x1e, 0xa1, 0x79, 0x54, 0xbd, 0x0e, 0x85, 0x7d, 0xe4, 0x8a, 0xba, 0xd6,
+	0xae, 0x09, 0x1e, 0xd6, 0x7e, 0x43, 0x0a, 0xde, 0x1b, 0x52, 0xd8, 0xc5, 0x9a, 0xb1, 0x53, 0xb1,
+	0x1f, 0x2a, 0xdf, 0x5d, 0x9e, 0x6e, 0x50, 0x92, 0xbd, 0x61, 0xb6, 0xcb, 0x26, 0x44, 0x72, 0x7c,
+	0xd9, 0x84, 0xa6, 0x03, 0x6d, 0x3f, 0x52, 0xf0, 0x42, 0x3c, 0xa5, 0xff, 0xad, 0xba, 0xb7, 0x52,
+	0xd4, 0xdd, 0xba, 0xea, 0x4c, 0xda, 0xfa, 0xea, 0xb0, 0x9e, 0xc8, 0xdf, 0x57, 0xd8, 0xfe, 0xb9,
+	0x02, 0x85, 0x2e, 0x51, 0xe9, 0x43, 0x58, 0x8e, 0x3e, 0x6f, 0xef, 0x24, 0xbf, 0x17, 0x63, 0x4f,
+	0x2e, 0x56, 0xcc, 0x08, 0x0c, 0xde, 0x66, 0x7d, 0x78, 0x2e, 0xf4, 0xc6, 0xba, 0x9d, 0x66, 0x60,
+	0x12, 0xc5, 0xbe, 0x9a, 0x05, 0x15, 0xf8, 0x30, 0xe0, 0xf9, 0xd8, 0xfb, 0xa6, 0x99, 0x66, 0x21,
+	0x8a, 0x64, 0x37, 0xb3, 0x22, 0x03, 0x7f, 0x3d, 0x28, 0xfb, 0x6f, 0x92, 0xfa, 0x94, 0xcd, 0x36,
+	0x80, 0xbd, 0x73, 0x05, 0x20, 0x30, 0x7a, 0x0c, 0xab, 0x49, 0x37, 0xef, 0xdd, 0x29, 0xfb, 0xa3,
+	0x60, 0x76, 0x6b, 0x06, 0x70, 0xe0, 0xf8, 0x63, 0x58, 0x89, 0xdf, 0x93, 0xaf, 0x4c, 0xb1, 0x14,
+	0x86, 0xb2, 0xad, 0xcc, 0xd0, 0xc0, 0xe5, 0x21, 0x2c, 0x47, 0x6f, 0xb5, 0x69, 0x71, 0x9a, 0x04,
+	0xb2, 0x62, 0x46, 0x60, 0x24, 0xb0, 0xb1, 0xcb, 0x66, 0x5a, 0x60, 0xa3, 0x60, 0x76, 0x6b, 0x06,
+	0x70, 0xe0, 0xf8, 0x00, 0x96, 0x22, 0xdd, 0xff, 0xe5, 0x29, 0x66, 0x26, 0x70, 0xac, 0x90, 0x0d,
+	0x17, 0x29, 0x80, 0x70, 0x4f, 0x9e, 0x56, 0x00, 0x21, 0x24, 0xbb, 0x99, 0x15, 0x19, 0xf8, 0xb3,
+	0x80, 0x4e, 0xe8, 0x93, 0x1b, 0x19, 0x83, 0x64, 0xfb, 0x6c, 0x67, 0xc7, 0xfa, 0x5e, 0xd9, 0xd2,
+	0xa7, 0x76, 0xa7, 0xdc, 0x79, 0xf8, 0xec, 0x9c, 0xa3, 0xce, 0xce, 0x39, 0xea, 0xcf, 0x73, 0x8e,
+	0xfa, 0xf2, 0x82, 0xcb, 0x9d, 0x5d, 0x70, 0xb9, 0xdf, 0x2f, 0xb8, 0xdc, 0x47, 0x77, 0x55, 0xcd,
+	0x3a, 0x78, 0xdc, 0x17, 0x14, 0xac, 0x8b, 0x8e, 0xf9, 0x7b, 0x32, 0x21, 0xc8, 0x22, 0xa1, 0xef,
+	0xfd, 0xb6, 0x68, 0x9d, 0x8c, 0x10, 0xe9, 0x2f, 0x38, 0xdf, 0xfb, 0x5b, 0x7f, 0x0f, 0x00, 0x04,
+	0x7c, 0xea, 0xb1, 0x06, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
+	ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error)
+	SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error)
+	SetMemo(ctx context.Context, in *MsgSetMemo, opts ...grpc.CallOption) (*MsgSetMemoResponse, error)
+	SetHistoryRetention(ctx context.Context, in *MsgSetHistoryRetention, opts ...grpc.CallOption) (*MsgSetHistoryRetentionResponse, error)
+	SetStatsRetention(ctx context.Context, in *MsgSetStatsRetention, opts ...grpc.CallOption) (*MsgSetStatsRetentionResponse, error)
+	SetChainChannel(ctx context.Context, in *MsgSetChainChannel, opts ...grpc.CallOption) (*MsgSetChainChannelResponse, error)
+	SetRegistrationMode(ctx context.Context, in *MsgSetRegistrationMode, opts ...grpc.CallOption) (*MsgSetRegistrationModeResponse, error)
+	SetForwardMode(ctx context.Context, in *MsgSetForwardMode, opts ...grpc.CallOption) (*MsgSetForwardModeResponse, error)
+	SetSignerlessFee(ctx context.Context, in *MsgSetSignerlessFee, opts ...grpc.CallOption) (*MsgSetSignerlessFeeResponse, error)
+	SetRegistrationFee(ctx context.Context, in *MsgSetRegistrationFee, opts ...grpc.CallOption) (*MsgSetRegistrationFeeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error) {
+	out := new(MsgRegisterAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/RegisterAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetMemoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error) {
+	out := new(MsgClearAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/ClearAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetMemoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error) {
+	out := new(MsgSetAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetAllowedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) SetMemo(ctx context.Context, in *MsgSetMemo, opts ...grpc.CallOption) (*MsgSetMemoResponse, error) {
+	out := new(MsgSetMemoResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetMemo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgRegisterAccount) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) SetHistoryRetention(ctx context.Context, in *MsgSetHistoryRetention, opts ...grpc.CallOption) (*MsgSetHistoryRetentionResponse, error) {
+	out := new(MsgSetHistoryRetentionResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetHistoryRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetStatsRetention(ctx context.Context, in *MsgSetStatsRetention, opts ...grpc.CallOption) (*MsgSetStatsRetentionResponse, error) {
+	out := new(MsgSetStatsRetentionResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetStatsRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetChainChannel(ctx context.Context, in *MsgSetChainChannel, opts ...grpc.CallOption) (*MsgSetChainChannelResponse, error) {
+	out := new(MsgSetChainChannelResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetChainChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetRegistrationMode(ctx context.Context, in *MsgSetRegistrationMode, opts ...grpc.CallOption) (*MsgSetRegistrationModeResponse, error) {
+	out := new(MsgSetRegistrationModeResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetRegistrationMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Fallback)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetForwardMode(ctx context.Context, in *MsgSetForwardMode, opts ...grpc.CallOption) (*MsgSetForwardModeResponse, error) {
+	out := new(MsgSetForwardModeResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetForwardMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Memos) > 0 {
-		for _, e := range m.Memos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) SetSignerlessFee(ctx context.Context, in *MsgSetSignerlessFee, opts ...grpc.CallOption) (*MsgSetSignerlessFeeResponse, error) {
+	out := new(MsgSetSignerlessFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetSignerlessFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRegisterAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetRegistrationFee(ctx context.Context, in *MsgSetRegistrationFee, opts ...grpc.CallOption) (*MsgSetRegistrationFeeResponse, error) {
+	out := new(MsgSetRegistrationFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Msg/SetRegistrationFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
+	ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error)
+	SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error)
+	SetMemo(context.Context, *MsgSetMemo) (*MsgSetMemoResponse, error)
+	SetHistoryRetention(context.Context, *MsgSetHistoryRetention) (*MsgSetHistoryRetentionResponse, error)
+	SetStatsRetention(context.Context, *MsgSetStatsRetention) (*MsgSetStatsRetentionResponse, error)
+	SetChainChannel(context.Context, *MsgSetChainChannel) (*MsgSetChainChannelResponse, error)
+	SetRegistrationMode(context.Context, *MsgSetRegistrationMode) (*MsgSetRegistrationModeResponse, error)
+	SetForwardMode(context.Context, *MsgSetForwardMode) (*MsgSetForwardModeResponse, error)
+	SetSignerlessFee(context.Context, *MsgSetSignerlessFee) (*MsgSetSignerlessFeeResponse, error)
+	SetRegistrationFee(context.Context, *MsgSetRegistrationFee) (*MsgSetRegistrationFeeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAccount(ctx context.Context, req *MsgRegisterAccount) (*MsgRegisterAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount not implemented")
+}
+func (*UnimplementedMsgServer) ClearAccount(ctx context.Context, req *MsgClearAccount) (*MsgClearAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAccount not implemented")
+}
+func (*UnimplementedMsgServer) SetAllowedDenoms(ctx context.Context, req *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedDenoms not implemented")
+}
+func (*UnimplementedMsgServer) SetMemo(ctx context.Context, req *MsgSetMemo) (*MsgSetMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemo not implemented")
+}
+func (*UnimplementedMsgServer) SetHistoryRetention(ctx context.Context, req *MsgSetHistoryRetention) (*MsgSetHistoryRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHistoryRetention not implemented")
+}
+func (*UnimplementedMsgServer) SetStatsRetention(ctx context.Context, req *MsgSetStatsRetention) (*MsgSetStatsRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStatsRetention not implemented")
+}
+func (*UnimplementedMsgServer) SetChainChannel(ctx context.Context, req *MsgSetChainChannel) (*MsgSetChainChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChainChannel not implemented")
+}
+func (*UnimplementedMsgServer) SetRegistrationMode(ctx context.Context, req *MsgSetRegistrationMode) (*MsgSetRegistrationModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRegistrationMode not implemented")
+}
+func (*UnimplementedMsgServer) SetForwardMode(ctx context.Context, req *MsgSetForwardMode) (*MsgSetForwardModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForwardMode not implemented")
+}
+func (*UnimplementedMsgServer) SetSignerlessFee(ctx context.Context, req *MsgSetSignerlessFee) (*MsgSetSignerlessFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSignerlessFee not implemented")
+}
+func (*UnimplementedMsgServer) SetRegistrationFee(ctx context.Context, req *MsgSetRegistrationFee) (*MsgSetRegistrationFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRegistrationFee not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/RegisterAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccount(ctx, req.(*MsgRegisterAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClearAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClearAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ClearAccount(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/ClearAccount",
 	}
-	if m.Fallback {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearAccount(ctx, req.(*MsgClearAccount))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClearAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetAllowedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, req.(*MsgSetAllowedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAllowedDenoms) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMemo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetMemo(ctx, in)
 	}
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetMemo",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMemo(ctx, req.(*MsgSetMemo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAllowedDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetHistoryRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHistoryRetention)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetHistoryRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetHistoryRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHistoryRetention(ctx, req.(*MsgSetHistoryRetention))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetMemo) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetStatsRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStatsRetention)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetStatsRetention(ctx, in)
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetStatsRetention",
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStatsRetention(ctx, req.(*MsgSetStatsRetention))
 	}
-	l = len(m.Fallback)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChainChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChainChannel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetChainChannel(ctx, in)
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetChainChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChainChannel(ctx, req.(*MsgSetChainChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRegistrationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRegistrationMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRegistrationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetRegistrationMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRegistrationMode(ctx, req.(*MsgSetRegistrationMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetForwardMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForwardMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForwardMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetForwardMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForwardMode(ctx, req.(*MsgSetForwardMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSignerlessFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSignerlessFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSignerlessFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetSignerlessFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSignerlessFee(ctx, req.(*MsgSetSignerlessFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRegistrationFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRegistrationFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRegistrationFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Msg/SetRegistrationFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRegistrationFee(ctx, req.(*MsgSetRegistrationFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.forwarding.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAccount",
+			Handler:    _Msg_RegisterAccount_Handler,
+		},
+		{
+			MethodName: "ClearAccount",
+			Handler:    _Msg_ClearAccount_Handler,
+		},
+		{
+			MethodName: "SetAllowedDenoms",
+			Handler:    _Msg_SetAllowedDenoms_Handler,
+		},
+		{
+			MethodName: "SetMemo",
+			Handler:    _Msg_SetMemo_Handler,
+		},
+		{
+			MethodName: "SetHistoryRetention",
+			Handler:    _Msg_SetHistoryRetention_Handler,
+		},
+		{
+			MethodName: "SetStatsRetention",
+			Handler:    _Msg_SetStatsRetention_Handler,
+		},
+		{
+			MethodName: "SetChainChannel",
+			Handler:    _Msg_SetChainChannel_Handler,
+		},
+		{
+			MethodName: "SetRegistrationMode",
+			Handler:    _Msg_SetRegistrationMode_Handler,
+		},
+		{
+			MethodName: "SetForwardMode",
+			Handler:    _Msg_SetForwardMode_Handler,
+		},
+		{
+			MethodName: "SetSignerlessFee",
+			Handler:    _Msg_SetSignerlessFee_Handler,
+		},
+		{
+			MethodName: "SetRegistrationFee",
+			Handler:    _Msg_SetRegistrationFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/forwarding/v1/tx.proto",
+}
+
+func (m *MsgRegisterAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Memos) > 0 {
+		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fallback {
+		i--
+		if m.Fallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllowedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMemo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMemo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddressVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMemoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMemoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMemoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHistoryRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHistoryRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHistoryRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Retention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHistoryRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHistoryRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHistoryRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetStatsRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetStatsRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetStatsRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Retention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetStatsRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetStatsRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetStatsRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChainChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChainChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChainChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChainChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChainChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChainChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRegistrationMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRegistrationMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegistrationMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRegistrationModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRegistrationModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegistrationModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForwardMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForwardMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForwardMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForwardModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForwardModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForwardModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSignerlessFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSignerlessFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSignerlessFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSignerlessFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSignerlessFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSignerlessFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRegistrationFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRegistrationFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegistrationFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRegistrationFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRegistrationFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegistrationFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Memos) > 0 {
+		for _, e := range m.Memos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovTx(uint64(m.AddressVersion))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fallback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgClearAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAllowedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAllowedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMemo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovTx(uint64(m.AddressVersion))
+	}
+	return n
+}
+
+func (m *MsgSetMemoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetHistoryRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Retention != 0 {
+		n += 1 + sovTx(uint64(m.Retention))
+	}
+	return n
+}
+
+func (m *MsgSetHistoryRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetStatsRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Retention != 0 {
+		n += 1 + sovTx(uint64(m.Retention))
+	}
+	return n
+}
+
+func (m *MsgSetStatsRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetChainChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetChainChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRegistrationMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgSetRegistrationModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetForwardMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgSetForwardModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSignerlessFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSignerlessFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRegistrationFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRegistrationFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memos = append(m.Memos, MemoEntry{})
+			if err := m.Memos[len(m.Memos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= AddressVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fallback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllowedDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllowedDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllowedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllowedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMemo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= AddressVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMemoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMemoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMemoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHistoryRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHistoryRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHistoryRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetMemoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSetHistoryRetentionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHistoryRetentionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHistoryRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSetStatsRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1088,10 +3707,10 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetStatsRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetStatsRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1127,10 +3746,10 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
-			var stringLen uint64
+			m.Retention = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1140,27 +3759,114 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Retention |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetStatsRetentionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetStatsRetentionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetStatsRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChainChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChainChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChainChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1188,11 +3894,11 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1220,13 +3926,13 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fallback = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1236,25 +3942,23 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memos = append(m.Memos, MemoEntry{})
-			if err := m.Memos[len(m.Memos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1277,7 +3981,7 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChainChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,57 +3991,25 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChainChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChainChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1359,7 +4031,7 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRegistrationMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1382,10 +4054,10 @@ func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRegistrationMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRegistrationMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1421,42 +4093,10 @@ func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var v int
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1466,12 +4106,11 @@ func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Mode |= RegistrationMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Fallback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1493,7 +4132,7 @@ func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRegistrationModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1516,10 +4155,10 @@ func (m *MsgClearAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRegistrationModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRegistrationModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1543,7 +4182,7 @@ func (m *MsgClearAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllowedDenoms) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForwardMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,10 +4205,10 @@ func (m *MsgSetAllowedDenoms) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllowedDenoms: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForwardMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllowedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForwardMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1605,10 +4244,10 @@ func (m *MsgSetAllowedDenoms) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var stringLen uint64
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1618,24 +4257,11 @@ func (m *MsgSetAllowedDenoms) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Mode |= ForwardMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1657,7 +4283,7 @@ func (m *MsgSetAllowedDenoms) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForwardModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1680,10 +4306,10 @@ func (m *MsgSetAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllowedDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForwardModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForwardModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1707,7 +4333,7 @@ func (m *MsgSetAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSignerlessFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1730,10 +4356,10 @@ func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMemo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSignerlessFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSignerlessFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1770,9 +4396,9 @@ func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1782,91 +4408,128 @@ func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSignerlessFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSignerlessFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSignerlessFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRegistrationFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fallback = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRegistrationFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRegistrationFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1894,13 +4557,13 @@ func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1910,23 +4573,24 @@ func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1949,7 +4613,7 @@ func (m *MsgSetMemo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMemoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRegistrationFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1972,10 +4636,10 @@ func (m *MsgSetMemoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMemoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRegistrationFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMemoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRegistrationFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: